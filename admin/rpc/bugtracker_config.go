@@ -0,0 +1,246 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothershipadmin_rpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/openela/mothership/base"
+	mothership_db "github.com/openela/mothership/db"
+	mshipadminpb "github.com/openela/mothership/proto/admin/v1"
+	mothership_worker_server "github.com/openela/mothership/worker_server"
+	"go.ciq.dev/pika"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// keepSecrets copies the secrets of existing into config if they are masked
+// or empty in config, and both configs are of the same type.
+func keepSecrets(config *mshipadminpb.BugTrackerConfig, existing *mshipadminpb.BugTrackerConfig) {
+	isMasked := func(secret string) bool {
+		return secret == "" || secret == mothership_db.MaskedSecret
+	}
+
+	if mantis, existingMantis := config.GetMantis(), existing.GetMantis(); mantis != nil && existingMantis != nil {
+		if isMasked(mantis.ApiKey) {
+			mantis.ApiKey = existingMantis.ApiKey
+		}
+	}
+	if gitlab, existingGitlab := config.GetGitlab(), existing.GetGitlab(); gitlab != nil && existingGitlab != nil {
+		if isMasked(gitlab.Token) {
+			gitlab.Token = existingGitlab.Token
+		}
+	}
+	if jira, existingJira := config.GetJira(), existing.GetJira(); jira != nil && existingJira != nil {
+		if isMasked(jira.ApiToken) {
+			jira.ApiToken = existingJira.ApiToken
+		}
+	}
+}
+
+// applyUpdateMask returns existing with the fields in paths set from config.
+// All fields are set if paths is empty.
+func applyUpdateMask(config *mshipadminpb.BugTrackerConfig, existing *mshipadminpb.BugTrackerConfig, paths []string) (*mshipadminpb.BugTrackerConfig, error) {
+	if len(paths) == 0 {
+		return config, nil
+	}
+
+	updated := proto.Clone(existing).(*mshipadminpb.BugTrackerConfig)
+	for _, path := range paths {
+		switch path {
+		case "type":
+			updated.Type = config.Type
+		case "uri":
+			updated.Uri = config.Uri
+		case "mantis", "gitlab", "jira":
+			updated.Config = config.Config
+		case "active":
+			updated.Active = config.Active
+		case "ticket_template":
+			updated.TicketTemplate = config.TicketTemplate
+		case "entry_tickets":
+			updated.EntryTickets = config.EntryTickets
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update mask path %s", path)
+		}
+	}
+
+	return updated, nil
+}
+
+// validateBugTrackerConfig returns an error if config can't be used to
+// create tickets.
+func validateBugTrackerConfig(config *mshipadminpb.BugTrackerConfig) error {
+	_, err := mothership_worker_server.NewBugtracker(config)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid bug tracker config: %v", err)
+	}
+
+	if config.TicketTemplate != nil {
+		_, err = mothership_worker_server.NewTicketTemplate(config.TicketTemplate)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid ticket template: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// deactivateBugTrackerConfigs deactivates the active bug tracker config,
+// unless it is named except.
+func (s *Server) deactivateBugTrackerConfigs(except string) error {
+	active, err := base.Q[mothership_db.BugTrackerConfig](s.db).F("active", true).All()
+	if err != nil {
+		base.LogErrorf("failed to get active bug tracker configs: %v", err)
+		return status.Error(codes.Internal, "failed to get active bug tracker config")
+	}
+
+	for _, config := range active {
+		if config.Name == except {
+			continue
+		}
+
+		config.Active = false
+		config.UpdateTime = time.Now()
+		err = base.Q[mothership_db.BugTrackerConfig](s.db).U(config)
+		if err != nil {
+			base.LogErrorf("failed to deactivate bug tracker config: %v", err)
+			return status.Error(codes.Internal, "failed to deactivate bug tracker config")
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) ListBugTrackerConfigs(_ context.Context, req *mshipadminpb.ListBugTrackerConfigsRequest) (*mshipadminpb.ListBugTrackerConfigsResponse, error) {
+	aipOptions := pika.ProtoReflect(&mshipadminpb.BugTrackerConfig{})
+
+	page, nt, err := base.Q[mothership_db.BugTrackerConfig](s.db).GetPage(req, aipOptions)
+	if err != nil {
+		base.LogErrorf("failed to get bug tracker config page: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get bug tracker config page")
+	}
+
+	return &mshipadminpb.ListBugTrackerConfigsResponse{
+		BugTrackerConfigs: base.SliceToPB[*mshipadminpb.BugTrackerConfig, *mothership_db.BugTrackerConfig](page),
+		NextPageToken:     nt,
+	}, nil
+}
+
+func (s *Server) CreateBugTrackerConfig(_ context.Context, req *mshipadminpb.CreateBugTrackerConfigRequest) (*mshipadminpb.BugTrackerConfig, error) {
+	if req.BugTrackerConfig == nil {
+		return nil, status.Error(codes.InvalidArgument, "bug tracker config is required")
+	}
+
+	err := validateBugTrackerConfig(req.BugTrackerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &mothership_db.BugTrackerConfig{
+		Name:   base.NameGen("bugTrackerConfigs"),
+		Active: req.BugTrackerConfig.Active,
+	}
+	err = config.SetConfig(req.BugTrackerConfig)
+	if err != nil {
+		base.LogErrorf("failed to marshal bug tracker config: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create bug tracker config")
+	}
+
+	if config.Active {
+		err = s.deactivateBugTrackerConfigs(config.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = base.Q[mothership_db.BugTrackerConfig](s.db).Create(config)
+	if err != nil {
+		base.LogErrorf("failed to create bug tracker config: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create bug tracker config")
+	}
+
+	return config.ToPB(), nil
+}
+
+func (s *Server) UpdateBugTrackerConfig(_ context.Context, req *mshipadminpb.UpdateBugTrackerConfigRequest) (*mshipadminpb.BugTrackerConfig, error) {
+	if req.BugTrackerConfig == nil {
+		return nil, status.Error(codes.InvalidArgument, "bug tracker config is required")
+	}
+
+	config, err := base.Q[mothership_db.BugTrackerConfig](s.db).F("name", req.BugTrackerConfig.Name).GetOrNil()
+	if err != nil {
+		base.LogErrorf("failed to get bug tracker config: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get bug tracker config")
+	}
+	if config == nil {
+		return nil, status.Error(codes.NotFound, "bug tracker config not found")
+	}
+
+	existing, err := config.Proto()
+	if err != nil {
+		base.LogErrorf("failed to unmarshal bug tracker config: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get bug tracker config")
+	}
+
+	updated, err := applyUpdateMask(req.BugTrackerConfig, existing, req.UpdateMask.GetPaths())
+	if err != nil {
+		return nil, err
+	}
+	keepSecrets(updated, existing)
+
+	err = validateBugTrackerConfig(updated)
+	if err != nil {
+		return nil, err
+	}
+
+	err = config.SetConfig(updated)
+	if err != nil {
+		base.LogErrorf("failed to marshal bug tracker config: %v", err)
+		return nil, status.Error(codes.Internal, "failed to update bug tracker config")
+	}
+	config.Active = updated.Active
+	config.UpdateTime = time.Now()
+
+	if config.Active {
+		err = s.deactivateBugTrackerConfigs(config.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = base.Q[mothership_db.BugTrackerConfig](s.db).U(config)
+	if err != nil {
+		base.LogErrorf("failed to update bug tracker config: %v", err)
+		return nil, status.Error(codes.Internal, "failed to update bug tracker config")
+	}
+
+	return config.ToPB(), nil
+}
+
+func (s *Server) DeleteBugTrackerConfig(_ context.Context, req *mshipadminpb.DeleteBugTrackerConfigRequest) (*emptypb.Empty, error) {
+	config, err := base.Q[mothership_db.BugTrackerConfig](s.db).F("name", req.Name).GetOrNil()
+	if err != nil {
+		base.LogErrorf("failed to get bug tracker config: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get bug tracker config")
+	}
+	if config == nil {
+		return nil, status.Error(codes.NotFound, "bug tracker config not found")
+	}
+
+	if config.Active {
+		return nil, status.Error(codes.FailedPrecondition, "the active bug tracker config cannot be deleted")
+	}
+
+	err = base.Q[mothership_db.BugTrackerConfig](s.db).D(config)
+	if err != nil {
+		base.LogErrorf("failed to delete bug tracker config: %v", err)
+		return nil, status.Error(codes.Internal, "failed to delete bug tracker config")
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,186 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothershipadmin_rpc
+
+import (
+	"github.com/openela/mothership/base"
+	mothership_db "github.com/openela/mothership/db"
+	mshipadminpb "github.com/openela/mothership/proto/admin/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"testing"
+)
+
+func testBugTrackerConfig(active bool) *mshipadminpb.BugTrackerConfig {
+	return &mshipadminpb.BugTrackerConfig{
+		Type: mshipadminpb.BugTrackerConfig_MANTIS,
+		Uri:  "https://mantis.example.com",
+		Config: &mshipadminpb.BugTrackerConfig_Mantis{
+			Mantis: &mshipadminpb.BugTrackerConfig_MantisConfig{
+				ApiKey:     "secret",
+				ProjectIds: map[int32]int64{8: 1, 9: 2},
+			},
+		},
+		Active: active,
+	}
+}
+
+func TestCreateBugTrackerConfig(t *testing.T) {
+	require.Nil(t, base.Q[mothership_db.BugTrackerConfig](s.db).Delete())
+	config, err := s.CreateBugTrackerConfig(testContext(), &mshipadminpb.CreateBugTrackerConfigRequest{
+		BugTrackerConfig: testBugTrackerConfig(true),
+	})
+	require.Nil(t, err)
+	require.NotEmpty(t, config.Name)
+	require.True(t, config.Active)
+	require.Equal(t, "https://mantis.example.com", config.Uri)
+	require.Equal(t, mothership_db.MaskedSecret, config.GetMantis().ApiKey)
+	require.Equal(t, map[int32]int64{8: 1, 9: 2}, config.GetMantis().ProjectIds)
+
+	// The secret is stored as is
+	stored, err := base.Q[mothership_db.BugTrackerConfig](s.db).F("name", config.Name).Get()
+	require.Nil(t, err)
+	storedPb, err := stored.Proto()
+	require.Nil(t, err)
+	require.Equal(t, "secret", storedPb.GetMantis().ApiKey)
+	require.Nil(t, base.Q[mothership_db.BugTrackerConfig](s.db).Delete())
+}
+
+func TestCreateBugTrackerConfig_Invalid(t *testing.T) {
+	require.Nil(t, base.Q[mothership_db.BugTrackerConfig](s.db).Delete())
+	config := testBugTrackerConfig(false)
+	config.Config = nil
+	_, err := s.CreateBugTrackerConfig(testContext(), &mshipadminpb.CreateBugTrackerConfigRequest{
+		BugTrackerConfig: config,
+	})
+	require.NotNil(t, err)
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+}
+
+func TestCreateBugTrackerConfig_InvalidTicketTemplate(t *testing.T) {
+	require.Nil(t, base.Q[mothership_db.BugTrackerConfig](s.db).Delete())
+	config := testBugTrackerConfig(false)
+	config.TicketTemplate = &mshipadminpb.TicketTemplate{
+		CloseCondition: "{{.Batch.Name}}",
+	}
+	_, err := s.CreateBugTrackerConfig(testContext(), &mshipadminpb.CreateBugTrackerConfigRequest{
+		BugTrackerConfig: config,
+	})
+	require.NotNil(t, err)
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+
+	config.TicketTemplate.CloseCondition = "{{.AllArchived}}"
+	created, err := s.CreateBugTrackerConfig(testContext(), &mshipadminpb.CreateBugTrackerConfigRequest{
+		BugTrackerConfig: config,
+	})
+	require.Nil(t, err)
+	require.Equal(t, "{{.AllArchived}}", created.TicketTemplate.CloseCondition)
+	require.Nil(t, base.Q[mothership_db.BugTrackerConfig](s.db).Delete())
+}
+
+func TestCreateBugTrackerConfig_DeactivatesOthers(t *testing.T) {
+	require.Nil(t, base.Q[mothership_db.BugTrackerConfig](s.db).Delete())
+	first, err := s.CreateBugTrackerConfig(testContext(), &mshipadminpb.CreateBugTrackerConfigRequest{
+		BugTrackerConfig: testBugTrackerConfig(true),
+	})
+	require.Nil(t, err)
+	second, err := s.CreateBugTrackerConfig(testContext(), &mshipadminpb.CreateBugTrackerConfigRequest{
+		BugTrackerConfig: testBugTrackerConfig(true),
+	})
+	require.Nil(t, err)
+
+	configs, err := s.ListBugTrackerConfigs(testContext(), &mshipadminpb.ListBugTrackerConfigsRequest{})
+	require.Nil(t, err)
+	require.Len(t, configs.BugTrackerConfigs, 2)
+	for _, config := range configs.BugTrackerConfigs {
+		require.Equal(t, config.Name == second.Name, config.Active, config.Name)
+		require.Equal(t, mothership_db.MaskedSecret, config.GetMantis().ApiKey)
+	}
+	require.NotEqual(t, first.Name, second.Name)
+	require.Nil(t, base.Q[mothership_db.BugTrackerConfig](s.db).Delete())
+}
+
+func TestUpdateBugTrackerConfig_KeepsSecret(t *testing.T) {
+	require.Nil(t, base.Q[mothership_db.BugTrackerConfig](s.db).Delete())
+	config, err := s.CreateBugTrackerConfig(testContext(), &mshipadminpb.CreateBugTrackerConfigRequest{
+		BugTrackerConfig: testBugTrackerConfig(false),
+	})
+	require.Nil(t, err)
+
+	// Write back the masked config read through the API
+	config.Uri = "https://bugs.example.com"
+	updated, err := s.UpdateBugTrackerConfig(testContext(), &mshipadminpb.UpdateBugTrackerConfigRequest{
+		BugTrackerConfig: config,
+	})
+	require.Nil(t, err)
+	require.Equal(t, "https://bugs.example.com", updated.Uri)
+
+	stored, err := base.Q[mothership_db.BugTrackerConfig](s.db).F("name", config.Name).Get()
+	require.Nil(t, err)
+	storedPb, err := stored.Proto()
+	require.Nil(t, err)
+	require.Equal(t, "secret", storedPb.GetMantis().ApiKey)
+	require.Nil(t, base.Q[mothership_db.BugTrackerConfig](s.db).Delete())
+}
+
+func TestUpdateBugTrackerConfig_UpdateMask(t *testing.T) {
+	require.Nil(t, base.Q[mothership_db.BugTrackerConfig](s.db).Delete())
+	config, err := s.CreateBugTrackerConfig(testContext(), &mshipadminpb.CreateBugTrackerConfigRequest{
+		BugTrackerConfig: testBugTrackerConfig(false),
+	})
+	require.Nil(t, err)
+
+	updated, err := s.UpdateBugTrackerConfig(testContext(), &mshipadminpb.UpdateBugTrackerConfigRequest{
+		BugTrackerConfig: &mshipadminpb.BugTrackerConfig{
+			Name:   config.Name,
+			Uri:    "https://bugs.example.com",
+			Active: true,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"active"}},
+	})
+	require.Nil(t, err)
+	require.True(t, updated.Active)
+	require.Equal(t, "https://mantis.example.com", updated.Uri)
+
+	_, err = s.UpdateBugTrackerConfig(testContext(), &mshipadminpb.UpdateBugTrackerConfigRequest{
+		BugTrackerConfig: config,
+		UpdateMask:       &fieldmaskpb.FieldMask{Paths: []string{"create_time"}},
+	})
+	require.NotNil(t, err)
+	require.Equal(t, codes.InvalidArgument.String(), status.Code(err).String())
+	require.Nil(t, base.Q[mothership_db.BugTrackerConfig](s.db).Delete())
+}
+
+func TestDeleteBugTrackerConfig(t *testing.T) {
+	require.Nil(t, base.Q[mothership_db.BugTrackerConfig](s.db).Delete())
+	config, err := s.CreateBugTrackerConfig(testContext(), &mshipadminpb.CreateBugTrackerConfigRequest{
+		BugTrackerConfig: testBugTrackerConfig(true),
+	})
+	require.Nil(t, err)
+
+	// The active config can't be deleted
+	_, err = s.DeleteBugTrackerConfig(testContext(), &mshipadminpb.DeleteBugTrackerConfigRequest{
+		Name: config.Name,
+	})
+	require.NotNil(t, err)
+	require.Equal(t, codes.FailedPrecondition.String(), status.Code(err).String())
+
+	config.Active = false
+	_, err = s.UpdateBugTrackerConfig(testContext(), &mshipadminpb.UpdateBugTrackerConfigRequest{
+		BugTrackerConfig: config,
+	})
+	require.Nil(t, err)
+	_, err = s.DeleteBugTrackerConfig(testContext(), &mshipadminpb.DeleteBugTrackerConfigRequest{
+		Name: config.Name,
+	})
+	require.Nil(t, err)
+
+	_, err = s.DeleteBugTrackerConfig(testContext(), &mshipadminpb.DeleteBugTrackerConfigRequest{
+		Name: config.Name,
+	})
+	require.NotNil(t, err)
+	require.Equal(t, codes.NotFound.String(), status.Code(err).String())
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothershipadmin_rpc
+
+import (
+	"context"
+	"github.com/openela/mothership/base"
+	mothership_db "github.com/openela/mothership/db"
+	mshipadminpb "github.com/openela/mothership/proto/admin/v1"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	mothership_worker_server "github.com/openela/mothership/worker_server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"strings"
+)
+
+func (s *Server) ResolveEntryConflict(ctx context.Context, req *mshipadminpb.ResolveEntryConflictRequest) (*emptypb.Empty, error) {
+	entry, err := base.Q[mothership_db.Entry](s.db).F("name", req.Name).GetOrNil()
+	if err != nil {
+		base.LogErrorf("failed to get entry: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get entry")
+	}
+
+	if entry == nil {
+		return nil, status.Error(codes.NotFound, "entry not found")
+	}
+
+	// Make sure the entry is in conflict.
+	if entry.State != mothershippb.Entry_CONFLICT {
+		return nil, status.Error(codes.FailedPrecondition, "entry is not in conflict")
+	}
+
+	conflict, err := base.Q[mothership_db.EntryConflict](s.db).F("name", mothership_db.EntryConflictName(entry.Name)).GetOrNil()
+	if err != nil {
+		base.LogErrorf("failed to get entry conflict: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get entry conflict")
+	}
+
+	if conflict == nil {
+		return nil, status.Error(codes.NotFound, "entry conflict not found")
+	}
+
+	if req.ChosenEntry != conflict.EntryName && req.ChosenEntry != conflict.ConflictingEntryName {
+		return nil, status.Error(codes.InvalidArgument, "chosen entry must be the entry or the entry it conflicts with")
+	}
+
+	// Choosing the entry retracts the entry it conflicts with, which is
+	// only possible once that entry is archived. Otherwise, its import must
+	// be cancelled first.
+	if req.ChosenEntry == entry.Name {
+		conflictingEntry, err := base.Q[mothership_db.Entry](s.db).F("name", conflict.ConflictingEntryName).GetOrNil()
+		if err != nil {
+			base.LogErrorf("failed to get conflicting entry: %v", err)
+			return nil, status.Error(codes.Internal, "failed to get conflicting entry")
+		}
+		if conflictingEntry != nil {
+			switch conflictingEntry.State {
+			case mothershippb.Entry_ARCHIVING, mothershippb.Entry_ON_HOLD, mothershippb.Entry_AWAITING_QUORUM:
+				return nil, status.Error(codes.FailedPrecondition, "conflicting entry is still being imported, cancel its import first")
+			}
+		}
+	}
+
+	// Signal the workflow with the chosen entry.
+	err = s.temporal.SignalWorkflow(ctx, "operations/"+entry.Sha256Sum, "", mothership_worker_server.ResolveConflictSignal, req.ChosenEntry)
+	if err != nil {
+		if strings.Contains(err.Error(), "already completed") {
+			return nil, status.Error(codes.FailedPrecondition, "entry import is no longer running")
+		}
+		base.LogErrorf("failed to signal workflow: %v", err)
+		return nil, status.Error(codes.Internal, "failed to signal workflow")
+	}
+
+	return &emptypb.Empty{}, nil
+}
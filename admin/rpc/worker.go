@@ -54,9 +54,10 @@ func (s *Server) CreateWorker(_ context.Context, req *mshipadminpb.CreateWorkerR
 	// Create the worker.
 	name := base.NameGen("workers")
 	worker := &mothership_db.Worker{
-		Name:      name,
-		WorkerID:  req.WorkerId,
-		ApiSecret: base.NameGen(name),
+		Name:         name,
+		WorkerID:     req.WorkerId,
+		ApiSecret:    base.NameGen(name),
+		Organization: req.Organization,
 	}
 
 	err := base.Q[mothership_db.Worker](s.db).Create(worker)
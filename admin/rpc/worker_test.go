@@ -71,10 +71,12 @@ func TestListWorkers_One(t *testing.T) {
 func TestCreateWorker(t *testing.T) {
 	require.Nil(t, base.Q[mothership_db.Worker](s.db).Delete())
 	worker, err := s.CreateWorker(testContext(), &mshipadminpb.CreateWorkerRequest{
-		WorkerId: "test-id",
+		WorkerId:     "test-id",
+		Organization: "test-org",
 	})
 	require.Nil(t, err)
 	require.Equal(t, "test-id", worker.WorkerId)
+	require.Equal(t, "test-org", worker.Organization)
 	require.NotEmpty(t, worker.Name)
 	require.NotEmpty(t, worker.ApiSecret)
 	require.Nil(t, base.Q[mothership_db.Worker](s.db).Delete())
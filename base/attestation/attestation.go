@@ -0,0 +1,94 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attestation
+
+import "time"
+
+const (
+	// StatementType is the type of in-toto statements.
+	StatementType = "https://in-toto.io/Statement/v0.1"
+
+	// PredicateTypeSLSAProvenance is the type of SLSA provenance predicates.
+	PredicateTypeSLSAProvenance = "https://slsa.dev/provenance/v0.2"
+
+	// PayloadType is the DSSE payload type of in-toto statements.
+	PayloadType = "application/vnd.in-toto+json"
+)
+
+// DigestSet maps a hash algorithm to the hex encoded digest, e.g. "sha256"
+// or "gitCommit".
+type DigestSet map[string]string
+
+// Subject is an artifact a statement is about.
+type Subject struct {
+	Name   string    `json:"name"`
+	Digest DigestSet `json:"digest"`
+}
+
+// Statement is an in-toto statement.
+type Statement struct {
+	Type          string     `json:"_type"`
+	Subject       []*Subject `json:"subject"`
+	PredicateType string     `json:"predicateType"`
+	Predicate     any        `json:"predicate"`
+}
+
+// Builder identifies the entity that produced the subjects.
+type Builder struct {
+	ID string `json:"id"`
+}
+
+// Invocation describes how the build was started.
+type Invocation struct {
+	Parameters  any `json:"parameters,omitempty"`
+	Environment any `json:"environment,omitempty"`
+}
+
+// Completeness tells whether the provenance claims to be complete.
+type Completeness struct {
+	Parameters  bool `json:"parameters"`
+	Environment bool `json:"environment"`
+	Materials   bool `json:"materials"`
+}
+
+// Metadata holds information about the build invocation.
+type Metadata struct {
+	BuildInvocationID string        `json:"buildInvocationId,omitempty"`
+	BuildStartedOn    *time.Time    `json:"buildStartedOn,omitempty"`
+	BuildFinishedOn   *time.Time    `json:"buildFinishedOn,omitempty"`
+	Completeness      *Completeness `json:"completeness,omitempty"`
+	Reproducible      bool          `json:"reproducible"`
+}
+
+// Material is an input artifact of the build.
+type Material struct {
+	URI    string    `json:"uri"`
+	Digest DigestSet `json:"digest,omitempty"`
+}
+
+// Provenance is an SLSA provenance predicate.
+type Provenance struct {
+	Builder    *Builder    `json:"builder"`
+	BuildType  string      `json:"buildType"`
+	Invocation *Invocation `json:"invocation,omitempty"`
+	Metadata   *Metadata   `json:"metadata,omitempty"`
+	Materials  []*Material `json:"materials,omitempty"`
+}
+
+// NewProvenanceStatement returns an in-toto statement with an SLSA provenance
+// predicate about subjects.
+func NewProvenanceStatement(provenance *Provenance, subjects ...*Subject) *Statement {
+	return &Statement{
+		Type:          StatementType,
+		Subject:       subjects,
+		PredicateType: PredicateTypeSLSAProvenance,
+		Predicate:     provenance,
+	}
+}
+
+// ObjectName returns the name of the storage object that holds the
+// attestation of a resource, e.g. "attestations/entries/123.intoto.json".
+func ObjectName(resource string) string {
+	return "attestations/" + resource + ".intoto.json"
+}
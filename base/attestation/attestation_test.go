@@ -0,0 +1,111 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attestation
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/openela/mothership/base/signing"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"testing"
+)
+
+func testStatement() *Statement {
+	return NewProvenanceStatement(&Provenance{
+		Builder:   &Builder{ID: "https://mship.example.com/worker_server"},
+		BuildType: "https://mship.example.com/import/v1",
+		Materials: []*Material{
+			{URI: "efi-rpm-macros-3-3.el8.src.rpm", Digest: DigestSet{"sha256": "518a9418"}},
+		},
+	}, &Subject{Name: "efi-rpm-macros-3-3.el8.src.rpm", Digest: DigestSet{"sha256": "518a9418"}})
+}
+
+func TestPAE(t *testing.T) {
+	// Test vector from the DSSE specification
+	require.Equal(
+		t,
+		"DSSEv1 29 http://example.com/HelloWorld 11 hello world",
+		string(PAE("http://example.com/HelloWorld", []byte("hello world"))),
+	)
+}
+
+func TestObjectName(t *testing.T) {
+	require.Equal(t, "attestations/entries/123.intoto.json", ObjectName("entries/123"))
+}
+
+func TestSign_NoSigner(t *testing.T) {
+	_, err := Sign(testStatement(), nil)
+	require.Equal(t, ErrNoSigner, err)
+}
+
+func TestSign_OpenPGP(t *testing.T) {
+	entity, err := openpgp.NewEntity("Mship Bot", "", "mship@openela.org", nil)
+	require.Nil(t, err)
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PrivateKeyType, nil)
+	require.Nil(t, err)
+	require.Nil(t, entity.SerializePrivate(w, nil))
+	require.Nil(t, w.Close())
+
+	signer, err := signing.New(buf.Bytes(), "", nil)
+	require.Nil(t, err)
+
+	envelope, err := Sign(testStatement(), signer)
+	require.Nil(t, err)
+	require.Len(t, envelope.Signatures, 1)
+	require.Equal(t, signer.Key().ID, envelope.Signatures[0].KeyID)
+
+	// The signature is a raw detached signature of the PAE
+	_, err = openpgp.CheckDetachedSignature(
+		openpgp.EntityList{entity},
+		bytes.NewReader(PAE(envelope.PayloadType, envelope.Payload)),
+		bytes.NewReader(envelope.Signatures[0].Sig),
+		nil,
+	)
+	require.Nil(t, err)
+}
+
+func TestSign_SSH(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+	block, err := ssh.MarshalPrivateKey(priv, "")
+	require.Nil(t, err)
+
+	signer, err := signing.New(pem.EncodeToMemory(block), "", nil)
+	require.Nil(t, err)
+
+	envelope, err := Sign(testStatement(), signer)
+	require.Nil(t, err)
+	require.Len(t, envelope.Signatures, 1)
+	require.Equal(t, signer.Key().ID, envelope.Signatures[0].KeyID)
+	require.True(t, bytes.HasPrefix(envelope.Signatures[0].Sig, []byte("SSHSIG")))
+
+	encoded, err := json.Marshal(envelope)
+	require.Nil(t, err)
+
+	var raw map[string]any
+	require.Nil(t, json.Unmarshal(encoded, &raw))
+	require.Equal(t, PayloadType, raw["payloadType"])
+	require.Len(t, raw["signatures"], 1)
+
+	payload, err := base64.StdEncoding.DecodeString(raw["payload"].(string))
+	require.Nil(t, err)
+
+	var statement map[string]any
+	require.Nil(t, json.Unmarshal(payload, &statement))
+	require.Equal(t, StatementType, statement["_type"])
+	require.Equal(t, PredicateTypeSLSAProvenance, statement["predicateType"])
+}
+
+func TestDearmor_Unknown(t *testing.T) {
+	_, err := dearmor("not a signature")
+	require.NotNil(t, err)
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attestation
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/openela/mothership/base/signing"
+	"github.com/pkg/errors"
+	"strings"
+)
+
+// Signature is a DSSE signature.
+// Sig is the raw OpenPGP or SSHSIG signature, depending on the format of the
+// key identified by KeyID.
+type Signature struct {
+	KeyID string `json:"keyid"`
+	Sig   []byte `json:"sig"`
+}
+
+// Envelope is a DSSE envelope.
+// Payload and signatures are base64 encoded in JSON, as DSSE requires.
+type Envelope struct {
+	PayloadType string       `json:"payloadType"`
+	Payload     []byte       `json:"payload"`
+	Signatures  []*Signature `json:"signatures"`
+}
+
+// PAE returns the pre-authentication encoding of a payload, which is what
+// DSSE signatures sign.
+func PAE(payloadType string, payload []byte) []byte {
+	var buf bytes.Buffer
+	_, _ = fmt.Fprintf(&buf, "DSSEv1 %d %s %d ", len(payloadType), payloadType, len(payload))
+	buf.Write(payload)
+
+	return buf.Bytes()
+}
+
+// dearmor returns the raw signature of an armored signature.
+func dearmor(armored string) ([]byte, error) {
+	if strings.HasPrefix(armored, "-----BEGIN PGP SIGNATURE-----") {
+		block, err := armor.Decode(strings.NewReader(armored))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode OpenPGP signature")
+		}
+		var buf bytes.Buffer
+		_, err = buf.ReadFrom(block.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode OpenPGP signature")
+		}
+		return buf.Bytes(), nil
+	}
+
+	// SSH signatures are PEM encoded
+	block, _ := pem.Decode([]byte(armored))
+	if block == nil || block.Type != "SSH SIGNATURE" {
+		return nil, errors.New("unknown signature format")
+	}
+
+	return block.Bytes, nil
+}
+
+// ErrNoSigner is returned when signing without a signer.
+// Unsigned envelopes prove nothing, so they are never created.
+var ErrNoSigner = errors.New("attestations require a signing key")
+
+// Sign wraps statement in a DSSE envelope signed by signer.
+// Returns ErrNoSigner if signer is nil.
+// OpenPGP signatures are verified with `gpg --verify` against the PAE of the
+// payload, SSH signatures with `ssh-keygen -Y verify -n git`, the namespace
+// git objects are signed in.
+func Sign(statement *Statement, signer signing.Signer) (*Envelope, error) {
+	if signer == nil {
+		return nil, ErrNoSigner
+	}
+
+	payload, err := json.Marshal(statement)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal statement")
+	}
+
+	envelope := &Envelope{
+		PayloadType: PayloadType,
+		Payload:     payload,
+		Signatures:  []*Signature{},
+	}
+
+	armored, err := signer.Sign(bytes.NewReader(PAE(PayloadType, payload)))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign statement")
+	}
+	sig, err := dearmor(armored)
+	if err != nil {
+		return nil, err
+	}
+	envelope.Signatures = append(envelope.Signatures, &Signature{
+		KeyID: signer.Key().ID,
+		Sig:   sig,
+	})
+
+	return envelope, nil
+}
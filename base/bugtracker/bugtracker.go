@@ -11,6 +11,11 @@ type Options struct {
 	// Labels are the labels to apply to the ticket.
 	// All bug trackers might not support labels, but it's a common feature.
 	Labels []string
+
+	// MajorVersion is the major version of the OS release the ticket is for.
+	// Bug trackers with a project per major version use it to pick the
+	// project, others ignore it.
+	MajorVersion int32
 }
 
 type Bugtracker interface {
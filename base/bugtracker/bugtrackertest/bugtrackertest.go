@@ -0,0 +1,75 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package bugtrackertest has helpers for testing bug tracker backends against
+// a mocked HTTP API.
+package bugtrackertest
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/openela/mothership/base/bugtracker"
+	"github.com/openela/mothership/base/forge"
+	"github.com/stretchr/testify/require"
+)
+
+// Activate mocks the default HTTP transport until the test ends.
+func Activate(t *testing.T) {
+	httpmock.Activate()
+	t.Cleanup(httpmock.DeactivateAndReset)
+}
+
+// Authenticator returns the authenticator of b.
+func Authenticator(t *testing.T, b bugtracker.Bugtracker) *forge.Authenticator {
+	auth, err := b.GetAuthenticator()
+	require.Nil(t, err)
+
+	return auth
+}
+
+// CheckAuth fails the test if a request doesn't carry the credentials a
+// backend sends.
+type CheckAuth func(t *testing.T, req *http.Request)
+
+// HeaderAuth requires the Authorization header to be value.
+func HeaderAuth(value string) CheckAuth {
+	return func(t *testing.T, req *http.Request) {
+		require.Equal(t, value, req.Header.Get("Authorization"))
+	}
+}
+
+// BasicAuth requires HTTP basic authentication with the given credentials.
+func BasicAuth(username string, password string) CheckAuth {
+	return func(t *testing.T, req *http.Request) {
+		reqUsername, reqPassword, ok := req.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, username, reqUsername)
+		require.Equal(t, password, reqPassword)
+	}
+}
+
+// Responder wraps responder and fails the request if checkAuth fails.
+// The JSON request body is decoded into body, if not nil.
+func Responder(t *testing.T, checkAuth CheckAuth, body *map[string]any, responder httpmock.Responder) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		checkAuth(t, req)
+		if body != nil {
+			require.Nil(t, json.NewDecoder(req.Body).Decode(body))
+		}
+		return responder(req)
+	}
+}
+
+// TicketURI requires ticketID and uri to convert to each other.
+func TicketURI(t *testing.T, b bugtracker.Bugtracker, ticketID string, uri string) {
+	got, err := b.TicketURI(ticketID)
+	require.Nil(t, err)
+	require.Equal(t, uri, got)
+
+	id, err := b.URIToTicket(uri)
+	require.Nil(t, err)
+	require.Equal(t, ticketID, id)
+}
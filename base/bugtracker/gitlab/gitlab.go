@@ -0,0 +1,203 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab_bugtracker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	transport_http "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/openela/mothership/base/bugtracker"
+	"github.com/openela/mothership/base/forge"
+)
+
+var issueURIRegex = regexp.MustCompile(`/-/issues/(\d+)/?$`)
+
+type Bugtracker struct {
+	uri     string
+	project string
+	token   string
+}
+
+// New returns a GitLab issues bug tracker.
+// uri is the base URI of the GitLab instance, and project the path of the
+// project issues are created in.
+func New(uri string, project string, token string) (*Bugtracker, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, fmt.Errorf("invalid URI: %s", uri)
+	}
+	project = strings.Trim(project, "/")
+	if project == "" {
+		return nil, fmt.Errorf("project is required")
+	}
+	if token == "" {
+		return nil, fmt.Errorf("token is required")
+	}
+
+	return &Bugtracker{
+		uri:     strings.TrimSuffix(uri, "/"),
+		project: project,
+		token:   token,
+	}, nil
+}
+
+// do sends a request to the issues API of the project and decodes the
+// response into respBody, if not nil.
+func (b *Bugtracker) do(auth *forge.Authenticator, method string, path string, reqBody any, wantStatus int, respBody any) error {
+	// Cast AuthMethod to BasicAuth
+	basicAuth := auth.AuthMethod.(*transport_http.BasicAuth)
+	token := basicAuth.Password
+
+	client := &http.Client{
+		Timeout: time.Second * 10,
+	}
+
+	var body io.Reader
+	if reqBody != nil {
+		encoded, err := json.Marshal(reqBody)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(encoded)
+	}
+
+	endpoint := fmt.Sprintf("%s/api/v4/projects/%s/issues", b.uri, url.PathEscape(b.project))
+	if path != "" {
+		endpoint += "/" + path
+	}
+	req, err := http.NewRequest(method, endpoint, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+token)
+	req.Header.Add("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != wantStatus {
+		return fmt.Errorf("%s issues/%s: %s", method, path, resp.Status)
+	}
+
+	if respBody != nil {
+		return json.NewDecoder(resp.Body).Decode(respBody)
+	}
+
+	return nil
+}
+
+// CreateTicket creates a ticket in the bug tracker.
+// Returns the ticket ID or an error.
+func (b *Bugtracker) CreateTicket(auth *forge.Authenticator, title string, body string, opts bugtracker.Options) (string, error) {
+	mapBody := map[string]any{
+		"title":       title,
+		"description": body,
+	}
+	if len(opts.Labels) > 0 {
+		mapBody["labels"] = strings.Join(opts.Labels, ",")
+	}
+
+	var respBody struct {
+		IID int64 `json:"iid"`
+	}
+	err := b.do(auth, "POST", "", mapBody, http.StatusCreated, &respBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to create ticket: %w", err)
+	}
+	if respBody.IID == 0 {
+		return "", fmt.Errorf("iid not found in response")
+	}
+
+	return strconv.FormatInt(respBody.IID, 10), nil
+}
+
+// EditTicket edits the ticket in the bug tracker.
+// Returns an error if the ticket could not be edited.
+func (b *Bugtracker) EditTicket(auth *forge.Authenticator, ticketID string, title string, body string, opts bugtracker.Options) error {
+	mapBody := map[string]any{
+		"title":       title,
+		"description": body,
+	}
+	if len(opts.Labels) > 0 {
+		mapBody["labels"] = strings.Join(opts.Labels, ",")
+	}
+
+	err := b.do(auth, "PUT", url.PathEscape(ticketID), mapBody, http.StatusOK, nil)
+	if err != nil {
+		return fmt.Errorf("failed to edit ticket: %w", err)
+	}
+
+	return nil
+}
+
+// CloseTicket closes the ticket in the bug tracker.
+// Returns an error if the ticket could not be closed.
+func (b *Bugtracker) CloseTicket(auth *forge.Authenticator, ticketID string) error {
+	mapBody := map[string]any{
+		"state_event": "close",
+	}
+	err := b.do(auth, "PUT", url.PathEscape(ticketID), mapBody, http.StatusOK, nil)
+	if err != nil {
+		return fmt.Errorf("failed to close ticket: %w", err)
+	}
+
+	return nil
+}
+
+// TicketURI returns the URI to the ticket in the bug tracker.
+// Returns an error if the URI could not be generated.
+func (b *Bugtracker) TicketURI(ticketID string) (string, error) {
+	if _, err := strconv.ParseInt(ticketID, 10, 64); err != nil {
+		return "", fmt.Errorf("invalid ticket ID: %s", ticketID)
+	}
+
+	return fmt.Sprintf("%s/%s/-/issues/%s", b.uri, b.project, ticketID), nil
+}
+
+// URIToTicket returns the ticket ID from the URI.
+// Returns an error if the ticket ID could not be extracted.
+func (b *Bugtracker) URIToTicket(uri string) (string, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("invalid URI: %s", uri)
+	}
+
+	match := issueURIRegex.FindStringSubmatch(parsed.Path)
+	if match == nil {
+		return "", fmt.Errorf("invalid URI: %s", uri)
+	}
+
+	return match[1], nil
+}
+
+// GetAuthenticator returns an authenticator for the bug tracker.
+func (b *Bugtracker) GetAuthenticator() (*forge.Authenticator, error) {
+	transporter := &transport_http.BasicAuth{
+		Password: b.token,
+	}
+
+	// We're assuming never expiring tokens for now
+	// Set it to 100 years from now
+	expires := time.Now().AddDate(100, 0, 0)
+
+	return &forge.Authenticator{
+		AuthMethod: transporter,
+		Expires:    expires,
+	}, nil
+}
@@ -0,0 +1,167 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab_bugtracker
+
+import (
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/openela/mothership/base/bugtracker"
+	"github.com/openela/mothership/base/bugtracker/bugtrackertest"
+	"github.com/openela/mothership/base/forge"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIssuesURL = "https://gitlab.example.com/api/v4/projects/openela%2Fbugs/issues"
+	testIssueURL  = "https://gitlab.example.com/api/v4/projects/openela%2Fbugs/issues/42"
+)
+
+// checkAuth requires the token GitLab accepts as a bearer token.
+var checkAuth = bugtrackertest.HeaderAuth("Bearer test_token")
+
+func newTestBugtracker(t *testing.T) (*Bugtracker, *forge.Authenticator) {
+	b, err := New("https://gitlab.example.com/", "openela/bugs", "test_token")
+	require.Nil(t, err)
+
+	return b, bugtrackertest.Authenticator(t, b)
+}
+
+func TestNew_Project(t *testing.T) {
+	_, err := New("https://gitlab.example.com", "/", "test_token")
+	require.ErrorContains(t, err, "project is required")
+
+	b, err := New("https://gitlab.example.com", "/openela/bugs/", "test_token")
+	require.Nil(t, err)
+	bugtrackertest.TicketURI(t, b, "42", "https://gitlab.example.com/openela/bugs/-/issues/42")
+}
+
+func TestNew_NestedProject(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, err := New("https://gitlab.example.com", "openela/sub/bugs", "test_token")
+	require.Nil(t, err)
+
+	httpmock.RegisterResponder("POST", "https://gitlab.example.com/api/v4/projects/openela%2Fsub%2Fbugs/issues",
+		bugtrackertest.Responder(t, checkAuth, nil, httpmock.NewJsonResponderOrPanic(201, map[string]any{
+			"iid": 42,
+		})))
+
+	id, err := b.CreateTicket(bugtrackertest.Authenticator(t, b), "Test", "Test body", bugtracker.Options{})
+	require.Nil(t, err)
+	require.Equal(t, "42", id)
+
+	bugtrackertest.TicketURI(t, b, "42", "https://gitlab.example.com/openela/sub/bugs/-/issues/42")
+}
+
+func TestCreateTicket(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, auth := newTestBugtracker(t)
+
+	var created map[string]any
+	httpmock.RegisterResponder("POST", testIssuesURL,
+		bugtrackertest.Responder(t, checkAuth, &created, httpmock.NewJsonResponderOrPanic(201, map[string]any{
+			"id":  1234,
+			"iid": 42,
+		})))
+
+	id, err := b.CreateTicket(auth, "Test", "Test body", bugtracker.Options{
+		Labels: []string{"all-successful", "import-batch"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, "42", id)
+	require.Equal(t, "Test", created["title"])
+	require.Equal(t, "Test body", created["description"])
+	require.Equal(t, "all-successful,import-batch", created["labels"])
+}
+
+func TestCreateTicket_Error(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, auth := newTestBugtracker(t)
+
+	httpmock.RegisterResponder("POST", testIssuesURL, httpmock.NewStringResponder(401, `{"message":"401 Unauthorized"}`))
+
+	_, err := b.CreateTicket(auth, "Test", "Test body", bugtracker.Options{})
+	require.NotNil(t, err)
+}
+
+func TestCreateTicket_NoLabels(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, auth := newTestBugtracker(t)
+
+	var created map[string]any
+	httpmock.RegisterResponder("POST", testIssuesURL,
+		bugtrackertest.Responder(t, checkAuth, &created, httpmock.NewJsonResponderOrPanic(201, map[string]any{
+			"iid": 42,
+		})))
+
+	_, err := b.CreateTicket(auth, "Test", "Test body", bugtracker.Options{})
+	require.Nil(t, err)
+	require.NotContains(t, created, "labels")
+}
+
+func TestCreateTicket_MissingIID(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, auth := newTestBugtracker(t)
+
+	// The global ID isn't the ID shown in the project
+	httpmock.RegisterResponder("POST", testIssuesURL,
+		bugtrackertest.Responder(t, checkAuth, nil, httpmock.NewJsonResponderOrPanic(201, map[string]any{
+			"id": 1234,
+		})))
+
+	_, err := b.CreateTicket(auth, "Test", "Test body", bugtracker.Options{})
+	require.ErrorContains(t, err, "iid not found in response")
+}
+
+func TestEditTicket(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, auth := newTestBugtracker(t)
+
+	var edited map[string]any
+	httpmock.RegisterResponder("PUT", testIssueURL,
+		bugtrackertest.Responder(t, checkAuth, &edited, httpmock.NewJsonResponderOrPanic(200, map[string]any{"iid": 42})))
+
+	err := b.EditTicket(auth, "42", "Test", "New body", bugtracker.Options{
+		Labels: []string{"failed-entry", "import-batch"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, "Test", edited["title"])
+	require.Equal(t, "New body", edited["description"])
+	require.Equal(t, "failed-entry,import-batch", edited["labels"])
+}
+
+func TestCloseTicket(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, auth := newTestBugtracker(t)
+
+	var closed map[string]any
+	httpmock.RegisterResponder("PUT", testIssueURL,
+		bugtrackertest.Responder(t, checkAuth, &closed, httpmock.NewJsonResponderOrPanic(200, map[string]any{"iid": 42})))
+
+	require.Nil(t, b.CloseTicket(auth, "42"))
+	require.Equal(t, map[string]any{"state_event": "close"}, closed)
+}
+
+func TestTicketURI(t *testing.T) {
+	b, _ := newTestBugtracker(t)
+
+	bugtrackertest.TicketURI(t, b, "42", "https://gitlab.example.com/openela/bugs/-/issues/42")
+
+	id, err := b.URIToTicket("https://gitlab.example.com/openela/bugs/-/issues/42/")
+	require.Nil(t, err)
+	require.Equal(t, "42", id)
+
+	_, err = b.TicketURI("abc")
+	require.NotNil(t, err)
+
+	_, err = b.URIToTicket("https://gitlab.example.com/openela/bugs/-/merge_requests/42")
+	require.NotNil(t, err)
+}
@@ -0,0 +1,282 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jira_bugtracker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	transport_http "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/openela/mothership/base/bugtracker"
+	"github.com/openela/mothership/base/forge"
+)
+
+const (
+	// DefaultIssueType is the issue type of created issues if none is
+	// configured.
+	DefaultIssueType = "Bug"
+
+	// DefaultCloseTransition is the transition that closes issues if none
+	// is configured.
+	DefaultCloseTransition = "Done"
+)
+
+var (
+	issueKeyRegex = regexp.MustCompile(`^[A-Z][A-Z0-9_]*-\d+$`)
+	issueURIRegex = regexp.MustCompile(`/browse/([A-Z][A-Z0-9_]*-\d+)/?$`)
+)
+
+type Bugtracker struct {
+	uri             string
+	email           string
+	apiToken        string
+	projectKey      string
+	issueType       string
+	closeTransition string
+	components      map[string]bool
+}
+
+// New returns a Jira bug tracker.
+// uri is the base URI of the Jira instance, and projectKey the key of the
+// project issues are created in.
+// Labels with the name of one of components set the component instead.
+func New(uri string, email string, apiToken string, projectKey string, issueType string, closeTransition string, components []string) (*Bugtracker, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, fmt.Errorf("invalid URI: %s", uri)
+	}
+	if email == "" || apiToken == "" {
+		return nil, fmt.Errorf("email and api token are required")
+	}
+	if projectKey == "" {
+		return nil, fmt.Errorf("project key is required")
+	}
+	if issueType == "" {
+		issueType = DefaultIssueType
+	}
+	if closeTransition == "" {
+		closeTransition = DefaultCloseTransition
+	}
+
+	componentSet := map[string]bool{}
+	for _, component := range components {
+		componentSet[component] = true
+	}
+
+	return &Bugtracker{
+		uri:             strings.TrimSuffix(uri, "/"),
+		email:           email,
+		apiToken:        apiToken,
+		projectKey:      projectKey,
+		issueType:       issueType,
+		closeTransition: closeTransition,
+		components:      componentSet,
+	}, nil
+}
+
+// labelFields maps labels to the labels and components fields of an issue.
+func (b *Bugtracker) labelFields(labels []string, fields map[string]any) {
+	if len(labels) == 0 {
+		return
+	}
+
+	jiraLabels := []string{}
+	components := []map[string]any{}
+	for _, label := range labels {
+		if b.components[label] {
+			components = append(components, map[string]any{"name": label})
+			continue
+		}
+		// Jira labels can't contain spaces
+		jiraLabels = append(jiraLabels, strings.ReplaceAll(label, " ", "-"))
+	}
+
+	fields["labels"] = jiraLabels
+	if len(components) > 0 {
+		fields["components"] = components
+	}
+}
+
+// do sends a request to the Jira REST API and decodes the response into
+// respBody, if not nil.
+func (b *Bugtracker) do(auth *forge.Authenticator, method string, path string, reqBody any, wantStatus int, respBody any) error {
+	// Cast AuthMethod to BasicAuth
+	basicAuth := auth.AuthMethod.(*transport_http.BasicAuth)
+
+	client := &http.Client{
+		Timeout: time.Second * 10,
+	}
+
+	var body io.Reader
+	if reqBody != nil {
+		encoded, err := json.Marshal(reqBody)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequest(method, b.uri+"/rest/api/2/"+path, body)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(basicAuth.Username, basicAuth.Password)
+	req.Header.Add("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != wantStatus {
+		return fmt.Errorf("%s %s: %s", method, path, resp.Status)
+	}
+
+	if respBody != nil {
+		return json.NewDecoder(resp.Body).Decode(respBody)
+	}
+
+	return nil
+}
+
+// CreateTicket creates a ticket in the bug tracker.
+// Returns the issue key or an error.
+func (b *Bugtracker) CreateTicket(auth *forge.Authenticator, title string, body string, opts bugtracker.Options) (string, error) {
+	fields := map[string]any{
+		"project": map[string]any{
+			"key": b.projectKey,
+		},
+		"issuetype": map[string]any{
+			"name": b.issueType,
+		},
+		"summary":     title,
+		"description": body,
+	}
+	b.labelFields(opts.Labels, fields)
+
+	var respBody struct {
+		Key string `json:"key"`
+	}
+	err := b.do(auth, "POST", "issue", map[string]any{"fields": fields}, http.StatusCreated, &respBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to create ticket: %w", err)
+	}
+	if respBody.Key == "" {
+		return "", fmt.Errorf("key not found in response")
+	}
+
+	return respBody.Key, nil
+}
+
+// EditTicket edits the ticket in the bug tracker.
+// Returns an error if the ticket could not be edited.
+func (b *Bugtracker) EditTicket(auth *forge.Authenticator, ticketID string, title string, body string, opts bugtracker.Options) error {
+	fields := map[string]any{
+		"summary":     title,
+		"description": body,
+	}
+	b.labelFields(opts.Labels, fields)
+
+	err := b.do(auth, "PUT", "issue/"+url.PathEscape(ticketID), map[string]any{"fields": fields}, http.StatusNoContent, nil)
+	if err != nil {
+		return fmt.Errorf("failed to edit ticket: %w", err)
+	}
+
+	return nil
+}
+
+// CloseTicket closes the ticket in the bug tracker, with the close
+// transition of the workflow of the issue.
+// Returns an error if the ticket could not be closed.
+func (b *Bugtracker) CloseTicket(auth *forge.Authenticator, ticketID string) error {
+	path := "issue/" + url.PathEscape(ticketID) + "/transitions"
+
+	var transitions struct {
+		Transitions []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"transitions"`
+	}
+	err := b.do(auth, "GET", path, nil, http.StatusOK, &transitions)
+	if err != nil {
+		return fmt.Errorf("failed to get transitions: %w", err)
+	}
+
+	transitionID := ""
+	for _, transition := range transitions.Transitions {
+		if strings.EqualFold(transition.Name, b.closeTransition) {
+			transitionID = transition.ID
+			break
+		}
+	}
+	if transitionID == "" {
+		return fmt.Errorf("transition %s is not available for %s", b.closeTransition, ticketID)
+	}
+
+	mapBody := map[string]any{
+		"transition": map[string]any{
+			"id": transitionID,
+		},
+	}
+	err = b.do(auth, "POST", path, mapBody, http.StatusNoContent, nil)
+	if err != nil {
+		return fmt.Errorf("failed to close ticket: %w", err)
+	}
+
+	return nil
+}
+
+// TicketURI returns the URI to the ticket in the bug tracker.
+// Returns an error if the URI could not be generated.
+func (b *Bugtracker) TicketURI(ticketID string) (string, error) {
+	if !issueKeyRegex.MatchString(ticketID) {
+		return "", fmt.Errorf("invalid ticket ID: %s", ticketID)
+	}
+
+	return fmt.Sprintf("%s/browse/%s", b.uri, ticketID), nil
+}
+
+// URIToTicket returns the ticket ID from the URI.
+// Returns an error if the ticket ID could not be extracted.
+func (b *Bugtracker) URIToTicket(uri string) (string, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("invalid URI: %s", uri)
+	}
+
+	match := issueURIRegex.FindStringSubmatch(parsed.Path)
+	if match == nil {
+		return "", fmt.Errorf("invalid URI: %s", uri)
+	}
+
+	return match[1], nil
+}
+
+// GetAuthenticator returns an authenticator for the bug tracker.
+func (b *Bugtracker) GetAuthenticator() (*forge.Authenticator, error) {
+	transporter := &transport_http.BasicAuth{
+		Username: b.email,
+		Password: b.apiToken,
+	}
+
+	// We're assuming never expiring tokens for now
+	// Set it to 100 years from now
+	expires := time.Now().AddDate(100, 0, 0)
+
+	return &forge.Authenticator{
+		AuthMethod: transporter,
+		Expires:    expires,
+	}, nil
+}
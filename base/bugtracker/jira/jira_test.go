@@ -0,0 +1,190 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jira_bugtracker
+
+import (
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/openela/mothership/base/bugtracker"
+	"github.com/openela/mothership/base/bugtracker/bugtrackertest"
+	"github.com/openela/mothership/base/forge"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIssuesURL      = "https://jira.example.com/rest/api/2/issue"
+	testIssueURL       = "https://jira.example.com/rest/api/2/issue/REL-42"
+	testTransitionsURL = "https://jira.example.com/rest/api/2/issue/REL-42/transitions"
+)
+
+// checkAuth requires the email and API token Jira Cloud authenticates with.
+var checkAuth = bugtrackertest.BasicAuth("mship@example.com", "test_token")
+
+func newTestBugtracker(t *testing.T, closeTransition string) (*Bugtracker, *forge.Authenticator) {
+	b, err := New("https://jira.example.com/", "mship@example.com", "test_token", "REL", "", closeTransition, []string{"import-batch"})
+	require.Nil(t, err)
+
+	return b, bugtrackertest.Authenticator(t, b)
+}
+
+func TestNew_Credentials(t *testing.T) {
+	_, err := New("https://jira.example.com", "", "test_token", "REL", "", "", nil)
+	require.ErrorContains(t, err, "email and api token are required")
+
+	_, err = New("https://jira.example.com", "mship@example.com", "", "REL", "", "", nil)
+	require.ErrorContains(t, err, "email and api token are required")
+}
+
+func TestNew_Defaults(t *testing.T) {
+	b, err := New("https://jira.example.com", "mship@example.com", "test_token", "REL", "", "", nil)
+	require.Nil(t, err)
+	require.Equal(t, DefaultIssueType, b.issueType)
+	require.Equal(t, DefaultCloseTransition, b.closeTransition)
+
+	b, err = New("https://jira.example.com", "mship@example.com", "test_token", "REL", "Task", "Resolve", nil)
+	require.Nil(t, err)
+	require.Equal(t, "Task", b.issueType)
+	require.Equal(t, "Resolve", b.closeTransition)
+}
+
+func TestCreateTicket(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, auth := newTestBugtracker(t, "")
+
+	var created map[string]any
+	httpmock.RegisterResponder("POST", testIssuesURL,
+		bugtrackertest.Responder(t, checkAuth, &created, httpmock.NewJsonResponderOrPanic(201, map[string]any{
+			"id":  "10042",
+			"key": "REL-42",
+		})))
+
+	id, err := b.CreateTicket(auth, "Test", "Test body", bugtracker.Options{
+		Labels: []string{"all-successful", "import-batch"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, "REL-42", id)
+
+	fields := created["fields"].(map[string]any)
+	require.Equal(t, map[string]any{"key": "REL"}, fields["project"])
+	require.Equal(t, map[string]any{"name": DefaultIssueType}, fields["issuetype"])
+	require.Equal(t, "Test", fields["summary"])
+	require.Equal(t, "Test body", fields["description"])
+	// import-batch is a component
+	require.Equal(t, []any{"all-successful"}, fields["labels"])
+	require.Equal(t, []any{map[string]any{"name": "import-batch"}}, fields["components"])
+}
+
+func TestCreateTicket_OnlyComponents(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, auth := newTestBugtracker(t, "")
+
+	var created map[string]any
+	httpmock.RegisterResponder("POST", testIssuesURL,
+		bugtrackertest.Responder(t, checkAuth, &created, httpmock.NewJsonResponderOrPanic(201, map[string]any{
+			"key": "REL-42",
+		})))
+
+	_, err := b.CreateTicket(auth, "Test", "Test body", bugtracker.Options{
+		Labels: []string{"import-batch"},
+	})
+	require.Nil(t, err)
+
+	// The labels field is still sent, empty
+	fields := created["fields"].(map[string]any)
+	require.Equal(t, []any{}, fields["labels"])
+	require.Equal(t, []any{map[string]any{"name": "import-batch"}}, fields["components"])
+}
+
+func TestCreateTicket_MissingKey(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, auth := newTestBugtracker(t, "")
+
+	httpmock.RegisterResponder("POST", testIssuesURL,
+		bugtrackertest.Responder(t, checkAuth, nil, httpmock.NewJsonResponderOrPanic(201, map[string]any{
+			"id": "10042",
+		})))
+
+	_, err := b.CreateTicket(auth, "Test", "Test body", bugtracker.Options{})
+	require.ErrorContains(t, err, "key not found in response")
+}
+
+func TestEditTicket(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, auth := newTestBugtracker(t, "")
+
+	var edited map[string]any
+	httpmock.RegisterResponder("PUT", testIssueURL,
+		bugtrackertest.Responder(t, checkAuth, &edited, httpmock.NewStringResponder(204, "")))
+
+	err := b.EditTicket(auth, "REL-42", "Test", "New body", bugtracker.Options{
+		Labels: []string{"failed entry"},
+	})
+	require.Nil(t, err)
+
+	fields := edited["fields"].(map[string]any)
+	require.Equal(t, "Test", fields["summary"])
+	require.Equal(t, "New body", fields["description"])
+	require.Equal(t, []any{"failed-entry"}, fields["labels"])
+	require.NotContains(t, fields, "components")
+}
+
+func TestCloseTicket(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, auth := newTestBugtracker(t, "Resolve")
+
+	httpmock.RegisterResponder("GET", testTransitionsURL,
+		bugtrackertest.Responder(t, checkAuth, nil, httpmock.NewJsonResponderOrPanic(200, map[string]any{
+			"transitions": []any{
+				map[string]any{"id": "11", "name": "In Progress"},
+				map[string]any{"id": "31", "name": "resolve"},
+			},
+		})))
+	var transitioned map[string]any
+	httpmock.RegisterResponder("POST", testTransitionsURL,
+		bugtrackertest.Responder(t, checkAuth, &transitioned, httpmock.NewStringResponder(204, "")))
+
+	require.Nil(t, b.CloseTicket(auth, "REL-42"))
+	require.Equal(t, map[string]any{"id": "31"}, transitioned["transition"])
+}
+
+func TestCloseTicket_NoTransition(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, auth := newTestBugtracker(t, "")
+
+	httpmock.RegisterResponder("GET", testTransitionsURL,
+		bugtrackertest.Responder(t, checkAuth, nil, httpmock.NewJsonResponderOrPanic(200, map[string]any{
+			"transitions": []any{
+				map[string]any{"id": "11", "name": "In Progress"},
+			},
+		})))
+
+	require.NotNil(t, b.CloseTicket(auth, "REL-42"))
+	require.Equal(t, 0, httpmock.GetCallCountInfo()["POST "+testTransitionsURL])
+}
+
+func TestTicketURI(t *testing.T) {
+	b, _ := newTestBugtracker(t, "")
+
+	bugtrackertest.TicketURI(t, b, "REL-42", "https://jira.example.com/browse/REL-42")
+
+	id, err := b.URIToTicket("https://jira.example.com/browse/REL-42/")
+	require.Nil(t, err)
+	require.Equal(t, "REL-42", id)
+
+	_, err = b.TicketURI("42")
+	require.NotNil(t, err)
+
+	_, err = b.TicketURI("rel-42")
+	require.NotNil(t, err)
+
+	_, err = b.URIToTicket("https://jira.example.com/projects/REL")
+	require.NotNil(t, err)
+}
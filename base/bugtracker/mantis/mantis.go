@@ -0,0 +1,235 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mantis_bugtracker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	transport_http "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/openela/mothership/base/bugtracker"
+	"github.com/openela/mothership/base/forge"
+)
+
+// DefaultCategory is the category of created issues.
+// Mantis requires a category, and "General" exists in a default install.
+const DefaultCategory = "General"
+
+type Bugtracker struct {
+	uri        string
+	apiKey     string
+	projectIDs map[int32]int64
+}
+
+// New returns a Mantis bug tracker.
+// uri is the base URI of the Mantis install, and projectIDs maps major
+// versions to the project ID issues are created in.
+func New(uri string, apiKey string, projectIDs map[int32]int64) (*Bugtracker, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, fmt.Errorf("invalid URI: %s", uri)
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("api key is required")
+	}
+	if len(projectIDs) == 0 {
+		return nil, fmt.Errorf("at least one project ID is required")
+	}
+
+	return &Bugtracker{
+		uri:        strings.TrimSuffix(uri, "/"),
+		apiKey:     apiKey,
+		projectIDs: projectIDs,
+	}, nil
+}
+
+func tags(labels []string) []map[string]any {
+	var t []map[string]any
+	for _, label := range labels {
+		t = append(t, map[string]any{"name": label})
+	}
+	return t
+}
+
+// do sends a request to the Mantis REST API and decodes the response into
+// respBody, if not nil.
+func (b *Bugtracker) do(auth *forge.Authenticator, method string, path string, reqBody any, wantStatus int, respBody any) error {
+	// Cast AuthMethod to BasicAuth
+	basicAuth := auth.AuthMethod.(*transport_http.BasicAuth)
+	token := basicAuth.Password
+
+	client := &http.Client{
+		Timeout: time.Second * 10,
+	}
+
+	var body io.Reader
+	if reqBody != nil {
+		encoded, err := json.Marshal(reqBody)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequest(method, b.uri+"/api/rest/"+path, body)
+	if err != nil {
+		return err
+	}
+	// Mantis API tokens are sent as is
+	req.Header.Add("Authorization", token)
+	req.Header.Add("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != wantStatus {
+		return fmt.Errorf("%s %s: %s", method, path, resp.Status)
+	}
+
+	if respBody != nil {
+		return json.NewDecoder(resp.Body).Decode(respBody)
+	}
+
+	return nil
+}
+
+// CreateTicket creates a ticket in the bug tracker.
+// The ticket is created in the project of opts.MajorVersion.
+// Returns the ticket ID or an error.
+func (b *Bugtracker) CreateTicket(auth *forge.Authenticator, title string, body string, opts bugtracker.Options) (string, error) {
+	projectID, ok := b.projectIDs[opts.MajorVersion]
+	if !ok {
+		return "", fmt.Errorf("no project ID for major version %d", opts.MajorVersion)
+	}
+
+	mapBody := map[string]any{
+		"summary":     title,
+		"description": body,
+		"project": map[string]any{
+			"id": projectID,
+		},
+		"category": map[string]any{
+			"name": DefaultCategory,
+		},
+	}
+	if len(opts.Labels) > 0 {
+		mapBody["tags"] = tags(opts.Labels)
+	}
+
+	var respBody struct {
+		Issue struct {
+			ID int64 `json:"id"`
+		} `json:"issue"`
+	}
+	err := b.do(auth, "POST", "issues", mapBody, http.StatusCreated, &respBody)
+	if err != nil {
+		return "", fmt.Errorf("failed to create ticket: %w", err)
+	}
+	if respBody.Issue.ID == 0 {
+		return "", fmt.Errorf("id not found in response")
+	}
+
+	return strconv.FormatInt(respBody.Issue.ID, 10), nil
+}
+
+// EditTicket edits the ticket in the bug tracker.
+// Tags are added to the ticket, existing tags are kept.
+// Returns an error if the ticket could not be edited.
+func (b *Bugtracker) EditTicket(auth *forge.Authenticator, ticketID string, title string, body string, opts bugtracker.Options) error {
+	mapBody := map[string]any{
+		"summary":     title,
+		"description": body,
+	}
+	err := b.do(auth, "PATCH", "issues/"+url.PathEscape(ticketID), mapBody, http.StatusOK, nil)
+	if err != nil {
+		return fmt.Errorf("failed to edit ticket: %w", err)
+	}
+
+	// Mantis doesn't update tags when patching an issue
+	if len(opts.Labels) > 0 {
+		mapBody = map[string]any{
+			"tags": tags(opts.Labels),
+		}
+		err = b.do(auth, "POST", "issues/"+url.PathEscape(ticketID)+"/tags", mapBody, http.StatusCreated, nil)
+		if err != nil {
+			return fmt.Errorf("failed to tag ticket: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// CloseTicket closes the ticket in the bug tracker.
+// Returns an error if the ticket could not be closed.
+func (b *Bugtracker) CloseTicket(auth *forge.Authenticator, ticketID string) error {
+	mapBody := map[string]any{
+		"status": map[string]any{
+			"name": "closed",
+		},
+		"resolution": map[string]any{
+			"name": "fixed",
+		},
+	}
+	err := b.do(auth, "PATCH", "issues/"+url.PathEscape(ticketID), mapBody, http.StatusOK, nil)
+	if err != nil {
+		return fmt.Errorf("failed to close ticket: %w", err)
+	}
+
+	return nil
+}
+
+// TicketURI returns the URI to the ticket in the bug tracker.
+// Returns an error if the URI could not be generated.
+func (b *Bugtracker) TicketURI(ticketID string) (string, error) {
+	if _, err := strconv.ParseInt(ticketID, 10, 64); err != nil {
+		return "", fmt.Errorf("invalid ticket ID: %s", ticketID)
+	}
+
+	return fmt.Sprintf("%s/view.php?id=%s", b.uri, ticketID), nil
+}
+
+// URIToTicket returns the ticket ID from the URI.
+// Returns an error if the ticket ID could not be extracted.
+func (b *Bugtracker) URIToTicket(uri string) (string, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("invalid URI: %s", uri)
+	}
+
+	id := parsed.Query().Get("id")
+	if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+		return "", fmt.Errorf("invalid URI: %s", uri)
+	}
+
+	return id, nil
+}
+
+// GetAuthenticator returns an authenticator for the bug tracker.
+func (b *Bugtracker) GetAuthenticator() (*forge.Authenticator, error) {
+	transporter := &transport_http.BasicAuth{
+		Password: b.apiKey,
+	}
+
+	// Mantis API tokens don't expire
+	// Set it to 100 years from now
+	expires := time.Now().AddDate(100, 0, 0)
+
+	return &forge.Authenticator{
+		AuthMethod: transporter,
+		Expires:    expires,
+	}, nil
+}
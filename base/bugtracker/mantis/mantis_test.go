@@ -0,0 +1,193 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mantis_bugtracker
+
+import (
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/openela/mothership/base/bugtracker"
+	"github.com/openela/mothership/base/bugtracker/bugtrackertest"
+	"github.com/openela/mothership/base/forge"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIssuesURL = "https://mantis.example.com/api/rest/issues"
+	testIssueURL  = "https://mantis.example.com/api/rest/issues/42"
+	testTagsURL   = "https://mantis.example.com/api/rest/issues/42/tags"
+)
+
+// checkAuth requires the API key Mantis sends without a scheme.
+var checkAuth = bugtrackertest.HeaderAuth("test_token")
+
+func newTestBugtracker(t *testing.T) (*Bugtracker, *forge.Authenticator) {
+	b, err := New("https://mantis.example.com/", "test_token", map[int32]int64{8: 1, 9: 2})
+	require.Nil(t, err)
+
+	return b, bugtrackertest.Authenticator(t, b)
+}
+
+func TestNew_ProjectIDs(t *testing.T) {
+	_, err := New("https://mantis.example.com", "test_token", nil)
+	require.ErrorContains(t, err, "at least one project ID is required")
+
+	_, err = New("https://mantis.example.com", "test_token", map[int32]int64{})
+	require.ErrorContains(t, err, "at least one project ID is required")
+}
+
+func TestNew_Subpath(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, err := New("https://example.com/mantis/", "test_token", map[int32]int64{8: 1})
+	require.Nil(t, err)
+
+	httpmock.RegisterResponder("POST", "https://example.com/mantis/api/rest/issues",
+		bugtrackertest.Responder(t, checkAuth, nil, httpmock.NewJsonResponderOrPanic(201, map[string]any{
+			"issue": map[string]any{"id": 42},
+		})))
+
+	id, err := b.CreateTicket(bugtrackertest.Authenticator(t, b), "Test", "Test body", bugtracker.Options{MajorVersion: 8})
+	require.Nil(t, err)
+	require.Equal(t, "42", id)
+
+	bugtrackertest.TicketURI(t, b, "42", "https://example.com/mantis/view.php?id=42")
+}
+
+func TestCreateTicket(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, auth := newTestBugtracker(t)
+
+	var created map[string]any
+	httpmock.RegisterResponder("POST", testIssuesURL,
+		bugtrackertest.Responder(t, checkAuth, &created, httpmock.NewJsonResponderOrPanic(201, map[string]any{
+			"issue": map[string]any{"id": 42},
+		})))
+
+	id, err := b.CreateTicket(auth, "Test", "Test body", bugtracker.Options{
+		Labels:       []string{"import-batch", "all-successful"},
+		MajorVersion: 9,
+	})
+	require.Nil(t, err)
+	require.Equal(t, "42", id)
+
+	require.Equal(t, "Test", created["summary"])
+	require.Equal(t, "Test body", created["description"])
+	require.Equal(t, map[string]any{"id": float64(2)}, created["project"])
+	require.Equal(t, map[string]any{"name": DefaultCategory}, created["category"])
+	require.Equal(t, []any{
+		map[string]any{"name": "import-batch"},
+		map[string]any{"name": "all-successful"},
+	}, created["tags"])
+}
+
+func TestCreateTicket_UnknownMajorVersion(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, auth := newTestBugtracker(t)
+
+	_, err := b.CreateTicket(auth, "Test", "Test body", bugtracker.Options{MajorVersion: 7})
+	require.NotNil(t, err)
+	require.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestCreateTicket_Error(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, auth := newTestBugtracker(t)
+
+	httpmock.RegisterResponder("POST", testIssuesURL, httpmock.NewStringResponder(403, `{"message":"Access denied"}`))
+
+	_, err := b.CreateTicket(auth, "Test", "Test body", bugtracker.Options{MajorVersion: 8})
+	require.NotNil(t, err)
+}
+
+func TestCreateTicket_NoLabels(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, auth := newTestBugtracker(t)
+
+	var created map[string]any
+	httpmock.RegisterResponder("POST", testIssuesURL,
+		bugtrackertest.Responder(t, checkAuth, &created, httpmock.NewJsonResponderOrPanic(201, map[string]any{
+			"issue": map[string]any{"id": 42},
+		})))
+
+	_, err := b.CreateTicket(auth, "Test", "Test body", bugtracker.Options{MajorVersion: 8})
+	require.Nil(t, err)
+	require.Equal(t, map[string]any{"id": float64(1)}, created["project"])
+	require.NotContains(t, created, "tags")
+}
+
+func TestCreateTicket_MissingID(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, auth := newTestBugtracker(t)
+
+	httpmock.RegisterResponder("POST", testIssuesURL,
+		bugtrackertest.Responder(t, checkAuth, nil, httpmock.NewJsonResponderOrPanic(201, map[string]any{
+			"issue": map[string]any{},
+		})))
+
+	_, err := b.CreateTicket(auth, "Test", "Test body", bugtracker.Options{MajorVersion: 8})
+	require.ErrorContains(t, err, "id not found in response")
+}
+
+func TestEditTicket(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, auth := newTestBugtracker(t)
+
+	var edited map[string]any
+	httpmock.RegisterResponder("PATCH", testIssueURL,
+		bugtrackertest.Responder(t, checkAuth, &edited, httpmock.NewJsonResponderOrPanic(200, map[string]any{
+			"issues": []any{map[string]any{"id": 42}},
+		})))
+	var tagged map[string]any
+	httpmock.RegisterResponder("POST", testTagsURL,
+		bugtrackertest.Responder(t, checkAuth, &tagged, httpmock.NewStringResponder(201, `{}`)))
+
+	err := b.EditTicket(auth, "42", "Test", "New body", bugtracker.Options{
+		Labels: []string{"failed-entry"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, "Test", edited["summary"])
+	require.Equal(t, "New body", edited["description"])
+	require.Equal(t, []any{map[string]any{"name": "failed-entry"}}, tagged["tags"])
+
+	// Without labels, no tags are added
+	err = b.EditTicket(auth, "42", "Test", "New body", bugtracker.Options{})
+	require.Nil(t, err)
+
+	info := httpmock.GetCallCountInfo()
+	require.Equal(t, 2, info["PATCH "+testIssueURL])
+	require.Equal(t, 1, info["POST "+testTagsURL])
+}
+
+func TestCloseTicket(t *testing.T) {
+	bugtrackertest.Activate(t)
+
+	b, auth := newTestBugtracker(t)
+
+	var closed map[string]any
+	httpmock.RegisterResponder("PATCH", testIssueURL,
+		bugtrackertest.Responder(t, checkAuth, &closed, httpmock.NewStringResponder(200, `{}`)))
+
+	require.Nil(t, b.CloseTicket(auth, "42"))
+	require.Equal(t, map[string]any{"name": "closed"}, closed["status"])
+	require.Equal(t, map[string]any{"name": "fixed"}, closed["resolution"])
+}
+
+func TestTicketURI(t *testing.T) {
+	b, _ := newTestBugtracker(t)
+
+	bugtrackertest.TicketURI(t, b, "42", "https://mantis.example.com/view.php?id=42")
+
+	_, err := b.TicketURI("abc")
+	require.NotNil(t, err)
+
+	_, err = b.URIToTicket("https://mantis.example.com/view.php")
+	require.NotNil(t, err)
+}
@@ -25,6 +25,7 @@ const (
 	EnvVarStorageRegion           EnvVar = "STORAGE_REGION"
 	EnvVarStorageSecure           EnvVar = "STORAGE_SECURE"
 	EnvVarStoragePathStyle        EnvVar = "STORAGE_PATH_STYLE"
+	EnvVarStorageEncryptionKeys   EnvVar = "STORAGE_ENCRYPTION_KEYS"
 )
 
 func WithDatabaseFlags(appName string) []cli.Flag {
@@ -114,7 +115,7 @@ func WithStorageFlags() []cli.Flag {
 		},
 		&cli.StringFlag{
 			Name:    "storage-connection-string",
-			Usage:   "storage connection string",
+			Usage:   "storage connection string (s3://bucket, file:///path, memory://, replicated://?quorum=N&replica=<uri>&replica=<uri> or encrypted://?backend=<uri>, nested URIs are query escaped)",
 			EnvVars: []string{string(EnvVarStorageConnectionString)},
 		},
 		&cli.StringFlag{
@@ -136,6 +137,11 @@ func WithStorageFlags() []cli.Flag {
 			EnvVars: []string{string(EnvVarStoragePathStyle)},
 			Value:   false,
 		},
+		&cli.StringSliceFlag{
+			Name:    "storage-encryption-keys",
+			Usage:   "keys for encrypted:// storage as <id>=<base64 AES-256 key>, the first key encrypts new objects",
+			EnvVars: []string{string(EnvVarStorageEncryptionKeys)},
+		},
 	}
 }
 
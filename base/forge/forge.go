@@ -23,6 +23,9 @@ type Forge interface {
 	GetAuthenticator() (*Authenticator, error)
 	GetRemote(repo string) string
 	GetCommitViewerURL(repo string, commit string) string
+	// GetTreeViewerURL returns the web URL of path in repo, at the branch or
+	// tag ref.
+	GetTreeViewerURL(repo string, ref string, path string) string
 	EnsureRepositoryExists(auth *Authenticator, repo string) error
 	WithNamespace(namespace string) Forge
 }
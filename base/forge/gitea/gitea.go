@@ -0,0 +1,163 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea_forge
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	transport_http "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/openela/mothership/base/forge"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Forge is a Gitea or Forgejo forge.
+// Forgejo is a fork of Gitea and keeps the same API, so both are supported.
+type Forge struct {
+	host                 string
+	organization         string
+	username             string
+	token                string
+	authorName           string
+	authorEmail          string
+	shouldMakeRepoPublic bool
+}
+
+// fixName replaces characters Gitea doesn't allow in repository names.
+func fixName(str string) string {
+	return strings.Replace(str, "+", "plus", -1)
+}
+
+func New(host string, organization string, username string, token string, authorName string, authorEmail string, shouldMakeRepoPublic bool) *Forge {
+	return &Forge{
+		host:                 host,
+		organization:         organization,
+		username:             username,
+		token:                token,
+		authorName:           authorName,
+		authorEmail:          authorEmail,
+		shouldMakeRepoPublic: shouldMakeRepoPublic,
+	}
+}
+
+func (f *Forge) GetAuthenticator() (*forge.Authenticator, error) {
+	// Gitea accepts access tokens as the password for git over HTTP
+	transporter := &transport_http.BasicAuth{
+		Username: f.username,
+		Password: f.token,
+	}
+
+	// We're assuming never expiring tokens for now
+	// Set it to 100 years from now
+	expires := time.Now().AddDate(100, 0, 0)
+
+	return &forge.Authenticator{
+		AuthMethod:  transporter,
+		AuthorName:  f.authorName,
+		AuthorEmail: f.authorEmail,
+		Expires:     expires,
+	}, nil
+}
+
+func (f *Forge) GetRemote(repo string) string {
+	return fmt.Sprintf("https://%s/%s/%s", f.host, f.organization, fixName(repo))
+}
+
+func (f *Forge) GetCommitViewerURL(repo string, commit string) string {
+	return fmt.Sprintf(
+		"https://%s/%s/%s/commit/%s",
+		f.host,
+		f.organization,
+		fixName(repo),
+		commit,
+	)
+}
+
+func (f *Forge) GetTreeViewerURL(repo string, ref string, path string) string {
+	return fmt.Sprintf(
+		"https://%s/%s/%s/src/branch/%s/%s",
+		f.host,
+		f.organization,
+		fixName(repo),
+		ref,
+		path,
+	)
+}
+
+func (f *Forge) EnsureRepositoryExists(auth *forge.Authenticator, repo string) error {
+	// Cast AuthMethod to BasicAuth
+	basicAuth := auth.AuthMethod.(*transport_http.BasicAuth)
+	token := basicAuth.Password
+
+	client := &http.Client{
+		Timeout: time.Second * 10,
+	}
+
+	// First let's check if the repo exists
+	endpoint := fmt.Sprintf("https://%s/api/v1/repos/%s/%s", f.host, url.PathEscape(f.organization), url.PathEscape(fixName(repo)))
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "token "+token)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode == 200 {
+		// Repo exists, we're done
+		return nil
+	}
+	if resp.StatusCode != 404 {
+		return fmt.Errorf("failed to get repo %s: got status code %d", repo, resp.StatusCode)
+	}
+
+	mapBody := map[string]any{
+		"name":    fixName(repo),
+		"private": !f.shouldMakeRepoPublic,
+	}
+	body, err := json.Marshal(mapBody)
+	if err != nil {
+		return err
+	}
+
+	endpoint = fmt.Sprintf("https://%s/api/v1/orgs/%s/repos", f.host, url.PathEscape(f.organization))
+	req, err = http.NewRequest("POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Authorization", "token "+token)
+
+	resp, err = client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Another worker may have created the repo in the meantime
+	if resp.StatusCode == 409 {
+		return nil
+	}
+	if resp.StatusCode != 201 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to create repo %s: %s", repo, string(body))
+	}
+
+	return nil
+}
+
+// WithNamespace returns a forge that uses the given organization.
+func (f *Forge) WithNamespace(namespace string) forge.Forge {
+	newF := *f
+	newF.organization = namespace
+	return &newF
+}
@@ -0,0 +1,148 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitea_forge
+
+import (
+	"encoding/json"
+	transport_http "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func newTestForge(public bool) *Forge {
+	return New("codeberg.example.org", "openela", "mship", "test_token", "Mship Bot", "no-reply+mshipbot@openela.org", public)
+}
+
+const (
+	testRepoURL  = "https://codeberg.example.org/api/v1/repos/openela/libstdcplusplus"
+	testReposURL = "https://codeberg.example.org/api/v1/orgs/openela/repos"
+)
+
+func TestGetAuthenticator(t *testing.T) {
+	f := newTestForge(false)
+
+	auth, err := f.GetAuthenticator()
+	require.Nil(t, err)
+	require.Equal(t, "Mship Bot", auth.AuthorName)
+	require.Equal(t, "no-reply+mshipbot@openela.org", auth.AuthorEmail)
+
+	basicAuth, ok := auth.AuthMethod.(*transport_http.BasicAuth)
+	require.True(t, ok)
+	require.Equal(t, "mship", basicAuth.Username)
+	require.Equal(t, "test_token", basicAuth.Password)
+}
+
+func TestGetRemote(t *testing.T) {
+	f := newTestForge(false)
+	require.Equal(t, "https://codeberg.example.org/openela/bash", f.GetRemote("bash"))
+	require.Equal(t, "https://codeberg.example.org/openela/libstdcplusplus", f.GetRemote("libstdc++"))
+}
+
+func TestGetCommitViewerURL(t *testing.T) {
+	f := newTestForge(false)
+	require.Equal(t, "https://codeberg.example.org/openela/bash/commit/123456", f.GetCommitViewerURL("bash", "123456"))
+}
+
+func TestGetTreeViewerURL(t *testing.T) {
+	f := newTestForge(false)
+	require.Equal(t, "https://codeberg.example.org/openela/bash/src/branch/el-9.2/PATCHES", f.GetTreeViewerURL("bash", "el-9.2", "PATCHES"))
+}
+
+func TestWithNamespace(t *testing.T) {
+	f := newTestForge(false)
+	nf := f.WithNamespace("openela-modules")
+	require.Equal(t, "https://codeberg.example.org/openela-modules/bash", nf.GetRemote("bash"))
+	require.Equal(t, "https://codeberg.example.org/openela/bash", f.GetRemote("bash"))
+}
+
+func TestEnsureRepositoryExists_Exists(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	f := newTestForge(false)
+	auth, err := f.GetAuthenticator()
+	require.Nil(t, err)
+
+	httpmock.RegisterResponder("GET", testRepoURL,
+		func(req *http.Request) (*http.Response, error) {
+			require.Equal(t, "token test_token", req.Header.Get("Authorization"))
+			return httpmock.NewJsonResponse(200, map[string]any{"id": 1})
+		})
+
+	require.Nil(t, f.EnsureRepositoryExists(auth, "libstdc++"))
+	require.Equal(t, 0, httpmock.GetCallCountInfo()["POST "+testReposURL])
+}
+
+func TestEnsureRepositoryExists_Create(t *testing.T) {
+	for _, public := range []bool{false, true} {
+		httpmock.Activate()
+
+		f := newTestForge(public)
+		auth, err := f.GetAuthenticator()
+		require.Nil(t, err)
+
+		httpmock.RegisterResponder("GET", testRepoURL, httpmock.NewStringResponder(404, `{"message":"The target couldn't be found."}`))
+
+		var created map[string]any
+		httpmock.RegisterResponder("POST", testReposURL,
+			func(req *http.Request) (*http.Response, error) {
+				require.Equal(t, "token test_token", req.Header.Get("Authorization"))
+				require.Nil(t, json.NewDecoder(req.Body).Decode(&created))
+				return httpmock.NewJsonResponse(201, map[string]any{"id": 1})
+			})
+
+		require.Nil(t, f.EnsureRepositoryExists(auth, "libstdc++"))
+		require.Equal(t, "libstdcplusplus", created["name"])
+		require.Equal(t, !public, created["private"])
+
+		httpmock.DeactivateAndReset()
+	}
+}
+
+func TestEnsureRepositoryExists_Conflict(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	f := newTestForge(false)
+	auth, err := f.GetAuthenticator()
+	require.Nil(t, err)
+
+	httpmock.RegisterResponder("GET", testRepoURL, httpmock.NewStringResponder(404, ""))
+	httpmock.RegisterResponder("POST", testReposURL, httpmock.NewStringResponder(409, `{"message":"The repository with the same name already exists."}`))
+
+	require.Nil(t, f.EnsureRepositoryExists(auth, "libstdc++"))
+}
+
+func TestEnsureRepositoryExists_Unauthorized(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	f := newTestForge(false)
+	auth, err := f.GetAuthenticator()
+	require.Nil(t, err)
+
+	httpmock.RegisterResponder("GET", testRepoURL, httpmock.NewStringResponder(401, ""))
+
+	err = f.EnsureRepositoryExists(auth, "libstdc++")
+	require.NotNil(t, err)
+	require.Equal(t, 0, httpmock.GetCallCountInfo()["POST "+testReposURL])
+}
+
+func TestEnsureRepositoryExists_CreateFailed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	f := newTestForge(false)
+	auth, err := f.GetAuthenticator()
+	require.Nil(t, err)
+
+	httpmock.RegisterResponder("GET", testRepoURL, httpmock.NewStringResponder(404, ""))
+	httpmock.RegisterResponder("POST", testReposURL, httpmock.NewStringResponder(403, `{"message":"user should be an owner or a member of the organization"}`))
+
+	err = f.EnsureRepositoryExists(auth, "libstdc++")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "member of the organization")
+}
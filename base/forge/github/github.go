@@ -196,6 +196,16 @@ func (f *Forge) GetCommitViewerURL(repo string, commit string) string {
 	)
 }
 
+func (f *Forge) GetTreeViewerURL(repo string, ref string, path string) string {
+	return fmt.Sprintf(
+		"https://github.com/%s/%s/tree/%s/%s",
+		f.organization,
+		fixName(repo),
+		ref,
+		path,
+	)
+}
+
 func (f *Forge) EnsureRepositoryExists(auth *forge.Authenticator, repo string) error {
 	// Cast AuthMethod to BasicAuth
 	basicAuth := auth.AuthMethod.(*transport_http.BasicAuth)
@@ -154,6 +154,14 @@ func TestGetCommitViewerURL(t *testing.T) {
 	require.Equal(t, "https://github.com/test-org/test/commit/123456", url)
 }
 
+func TestGetTreeViewerURL(t *testing.T) {
+	forge, err := New("test-org", "123", []byte(testPrivateKey), false)
+	require.Nil(t, err)
+
+	url := forge.GetTreeViewerURL("test", "el-9.2", "PATCHES")
+	require.Equal(t, "https://github.com/test-org/test/tree/el-9.2/PATCHES", url)
+}
+
 func TestGetAuthenticator(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
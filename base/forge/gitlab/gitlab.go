@@ -69,6 +69,17 @@ func (f *Forge) GetCommitViewerURL(repo string, commit string) string {
 	)
 }
 
+func (f *Forge) GetTreeViewerURL(repo string, ref string, path string) string {
+	return fmt.Sprintf(
+		"https://%s/%s/%s/-/tree/%s/%s",
+		f.host,
+		f.group,
+		repo,
+		ref,
+		path,
+	)
+}
+
 func (f *Forge) EnsureRepositoryExists(auth *forge.Authenticator, repo string) error {
 	// Cast AuthMethod to BasicAuth
 	basicAuth := auth.AuthMethod.(*transport_http.BasicAuth)
@@ -91,6 +102,7 @@ func (f *Forge) EnsureRepositoryExists(auth *forge.Authenticator, repo string) e
 	if err != nil {
 		return err
 	}
+	_ = resp.Body.Close()
 
 	if resp.StatusCode == 200 {
 		// Repo exists, we're done
@@ -112,16 +124,21 @@ func (f *Forge) EnsureRepositoryExists(auth *forge.Authenticator, repo string) e
 	}
 
 	if resp.StatusCode != 200 {
+		_ = resp.Body.Close()
 		return fmt.Errorf("namespace %s does not exist", f.group)
 	}
 
 	mapBody := map[string]any{}
 	err = json.NewDecoder(resp.Body).Decode(&mapBody)
+	_ = resp.Body.Close()
 	if err != nil {
 		return err
 	}
 
-	namespaceId := mapBody["id"].(float64)
+	namespaceId, ok := mapBody["id"].(float64)
+	if !ok {
+		return fmt.Errorf("namespace %s has no id", f.group)
+	}
 
 	mapBody = map[string]any{
 		"name":         repo,
@@ -150,6 +167,7 @@ func (f *Forge) EnsureRepositoryExists(auth *forge.Authenticator, repo string) e
 	if err != nil {
 		return err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != 201 && resp.StatusCode != 200 {
 		body, _ := io.ReadAll(resp.Body)
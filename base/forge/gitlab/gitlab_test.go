@@ -0,0 +1,160 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"encoding/json"
+	transport_http "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func newTestForge(public bool) *Forge {
+	return New("gitlab.example.com", "openela/src", "mship", "test_token", "Mship Bot", "no-reply+mshipbot@openela.org", public)
+}
+
+const (
+	testProjectURL   = "https://gitlab.example.com/api/v4/projects/openela%2Fsrc%2Fbash"
+	testNamespaceURL = "https://gitlab.example.com/api/v4/namespaces/openela%2Fsrc"
+	testProjectsURL  = "https://gitlab.example.com/api/v4/projects"
+)
+
+// requireToken wraps a responder and fails the request if the token is missing.
+func requireToken(t *testing.T, responder httpmock.Responder) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, "Bearer test_token", req.Header.Get("Authorization"))
+		return responder(req)
+	}
+}
+
+func TestGetAuthenticator(t *testing.T) {
+	f := newTestForge(false)
+
+	auth, err := f.GetAuthenticator()
+	require.Nil(t, err)
+	require.Equal(t, "Mship Bot", auth.AuthorName)
+	require.Equal(t, "no-reply+mshipbot@openela.org", auth.AuthorEmail)
+
+	basicAuth, ok := auth.AuthMethod.(*transport_http.BasicAuth)
+	require.True(t, ok)
+	require.Equal(t, "mship", basicAuth.Username)
+	require.Equal(t, "test_token", basicAuth.Password)
+}
+
+func TestGetRemote(t *testing.T) {
+	f := newTestForge(false)
+	require.Equal(t, "https://gitlab.example.com/openela/src/bash", f.GetRemote("bash"))
+}
+
+func TestGetCommitViewerURL(t *testing.T) {
+	f := newTestForge(false)
+	require.Equal(t, "https://gitlab.example.com/openela/src/bash/-/commit/123456", f.GetCommitViewerURL("bash", "123456"))
+}
+
+func TestGetTreeViewerURL(t *testing.T) {
+	f := newTestForge(false)
+	require.Equal(t, "https://gitlab.example.com/openela/src/bash/-/tree/el-9.2/PATCHES", f.GetTreeViewerURL("bash", "el-9.2", "PATCHES"))
+}
+
+func TestWithNamespace(t *testing.T) {
+	f := newTestForge(false)
+	nf := f.WithNamespace("openela/modules")
+	require.Equal(t, "https://gitlab.example.com/openela/modules/bash", nf.GetRemote("bash"))
+	// The original forge is left alone
+	require.Equal(t, "https://gitlab.example.com/openela/src/bash", f.GetRemote("bash"))
+}
+
+func TestEnsureRepositoryExists_Exists(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	f := newTestForge(false)
+	auth, err := f.GetAuthenticator()
+	require.Nil(t, err)
+
+	httpmock.RegisterResponder("GET", testProjectURL,
+		requireToken(t, httpmock.NewJsonResponderOrPanic(200, map[string]any{
+			"id":   1,
+			"name": "bash",
+		})))
+
+	require.Nil(t, f.EnsureRepositoryExists(auth, "bash"))
+
+	info := httpmock.GetCallCountInfo()
+	require.Equal(t, 1, info["GET "+testProjectURL])
+	require.Equal(t, 0, info["POST "+testProjectsURL])
+}
+
+func TestEnsureRepositoryExists_Create(t *testing.T) {
+	for _, public := range []bool{false, true} {
+		httpmock.Activate()
+
+		f := newTestForge(public)
+		auth, err := f.GetAuthenticator()
+		require.Nil(t, err)
+
+		httpmock.RegisterResponder("GET", testProjectURL,
+			requireToken(t, httpmock.NewStringResponder(404, `{"message":"404 Project Not Found"}`)))
+		httpmock.RegisterResponder("GET", testNamespaceURL,
+			requireToken(t, httpmock.NewJsonResponderOrPanic(200, map[string]any{
+				"id":        42,
+				"full_path": "openela/src",
+			})))
+
+		var created map[string]any
+		httpmock.RegisterResponder("POST", testProjectsURL,
+			requireToken(t, func(req *http.Request) (*http.Response, error) {
+				require.Nil(t, json.NewDecoder(req.Body).Decode(&created))
+				return httpmock.NewJsonResponse(201, map[string]any{"id": 1})
+			}))
+
+		require.Nil(t, f.EnsureRepositoryExists(auth, "bash"))
+		require.Equal(t, "bash", created["name"])
+		require.Equal(t, float64(42), created["namespace_id"])
+		if public {
+			require.Equal(t, "public", created["visibility"])
+		} else {
+			require.Equal(t, "private", created["visibility"])
+		}
+
+		httpmock.DeactivateAndReset()
+	}
+}
+
+func TestEnsureRepositoryExists_NamespaceNotFound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	f := newTestForge(false)
+	auth, err := f.GetAuthenticator()
+	require.Nil(t, err)
+
+	httpmock.RegisterResponder("GET", testProjectURL, httpmock.NewStringResponder(404, ""))
+	httpmock.RegisterResponder("GET", testNamespaceURL, httpmock.NewStringResponder(404, ""))
+
+	err = f.EnsureRepositoryExists(auth, "bash")
+	require.NotNil(t, err)
+	require.Equal(t, "namespace openela/src does not exist", err.Error())
+}
+
+func TestEnsureRepositoryExists_CreateFailed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	f := newTestForge(false)
+	auth, err := f.GetAuthenticator()
+	require.Nil(t, err)
+
+	httpmock.RegisterResponder("GET", testProjectURL, httpmock.NewStringResponder(404, ""))
+	httpmock.RegisterResponder("GET", testNamespaceURL,
+		httpmock.NewJsonResponderOrPanic(200, map[string]any{"id": 42}))
+	httpmock.RegisterResponder("POST", testProjectsURL,
+		httpmock.NewStringResponder(400, `{"message":"has already been taken"}`))
+
+	err = f.EnsureRepositoryExists(auth, "bash")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "has already been taken")
+}
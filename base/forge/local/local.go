@@ -0,0 +1,121 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package local_forge
+
+import (
+	"fmt"
+	"github.com/go-git/go-git/v5"
+	"github.com/openela/mothership/base/forge"
+	"github.com/pkg/errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Forge is a forge backed by bare repositories in a local directory.
+// There is no hosting API, repositories are pushed to over file:// remotes.
+// This is meant for air-gapped deployments and for running the import
+// pipeline end-to-end without a remote forge.
+type Forge struct {
+	root            string
+	namespace       string
+	authorName      string
+	authorEmail     string
+	commitViewerURL string
+}
+
+// New creates a forge that stores repositories under root.
+// commitViewerURL is the base URL of a web frontend serving the same
+// directory, such as cgit. If it is empty, commits link to the repository.
+func New(root string, authorName string, authorEmail string, commitViewerURL string) (*Forge, error) {
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get absolute path of root")
+	}
+
+	return &Forge{
+		root:            absRoot,
+		authorName:      authorName,
+		authorEmail:     authorEmail,
+		commitViewerURL: strings.TrimSuffix(commitViewerURL, "/"),
+	}, nil
+}
+
+// repoPath returns the path of the repository relative to root.
+func (f *Forge) repoPath(repo string) string {
+	return filepath.Join(f.namespace, repo)
+}
+
+// repoDir returns the directory of the repository.
+// Repository names that would escape root are rejected.
+func (f *Forge) repoDir(repo string) (string, error) {
+	dir := filepath.Join(f.root, f.repoPath(repo))
+	rel, err := filepath.Rel(f.root, dir)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", errors.Errorf("invalid repository name %s", repo)
+	}
+
+	return dir, nil
+}
+
+func (f *Forge) GetAuthenticator() (*forge.Authenticator, error) {
+	// Local repositories don't need authentication
+	// Set it to 100 years from now
+	expires := time.Now().AddDate(100, 0, 0)
+
+	return &forge.Authenticator{
+		AuthorName:  f.authorName,
+		AuthorEmail: f.authorEmail,
+		Expires:     expires,
+	}, nil
+}
+
+func (f *Forge) GetRemote(repo string) string {
+	return "file://" + filepath.Join(f.root, f.repoPath(repo))
+}
+
+func (f *Forge) GetCommitViewerURL(repo string, commit string) string {
+	if f.commitViewerURL == "" {
+		return fmt.Sprintf("%s/commit/%s", f.GetRemote(repo), commit)
+	}
+
+	return fmt.Sprintf("%s/%s/commit/%s", f.commitViewerURL, filepath.ToSlash(f.repoPath(repo)), commit)
+}
+
+func (f *Forge) GetTreeViewerURL(repo string, ref string, path string) string {
+	if f.commitViewerURL == "" {
+		return fmt.Sprintf("%s/tree/%s/%s", f.GetRemote(repo), ref, path)
+	}
+
+	return fmt.Sprintf("%s/%s/tree/%s/%s", f.commitViewerURL, filepath.ToSlash(f.repoPath(repo)), ref, path)
+}
+
+// EnsureRepositoryExists creates a bare repository if it doesn't exist yet.
+func (f *Forge) EnsureRepositoryExists(_ *forge.Authenticator, repo string) error {
+	dir, err := f.repoDir(repo)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(dir), 0755)
+	if err != nil {
+		return errors.Wrap(err, "failed to create namespace directory")
+	}
+
+	_, err = git.PlainInit(dir, true)
+	if err != nil && !errors.Is(err, git.ErrRepositoryAlreadyExists) {
+		return errors.Wrapf(err, "failed to create repository %s", repo)
+	}
+
+	return nil
+}
+
+// WithNamespace returns a forge that stores repositories in a subdirectory
+// of root.
+func (f *Forge) WithNamespace(namespace string) forge.Forge {
+	newF := *f
+	newF.namespace = namespace
+	return &newF
+}
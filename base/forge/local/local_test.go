@@ -0,0 +1,139 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package local_forge
+
+import (
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/stretchr/testify/require"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestForge(t *testing.T, commitViewerURL string) *Forge {
+	f, err := New(t.TempDir(), "Mship Bot", "no-reply+mshipbot@openela.org", commitViewerURL)
+	require.Nil(t, err)
+	return f
+}
+
+func TestGetAuthenticator(t *testing.T) {
+	f := newTestForge(t, "")
+
+	auth, err := f.GetAuthenticator()
+	require.Nil(t, err)
+	require.Nil(t, auth.AuthMethod)
+	require.Equal(t, "Mship Bot", auth.AuthorName)
+	require.Equal(t, "no-reply+mshipbot@openela.org", auth.AuthorEmail)
+}
+
+func TestGetRemote(t *testing.T) {
+	f := newTestForge(t, "")
+	require.Equal(t, "file://"+filepath.Join(f.root, "bash"), f.GetRemote("bash"))
+	require.Equal(t, "file://"+filepath.Join(f.root, "modules", "bash"), f.WithNamespace("modules").GetRemote("bash"))
+}
+
+func TestGetCommitViewerURL(t *testing.T) {
+	f := newTestForge(t, "")
+	require.Equal(t, "file://"+filepath.Join(f.root, "bash")+"/commit/123456", f.GetCommitViewerURL("bash", "123456"))
+
+	f = newTestForge(t, "https://git.example.org/")
+	require.Equal(t, "https://git.example.org/bash/commit/123456", f.GetCommitViewerURL("bash", "123456"))
+	require.Equal(t, "https://git.example.org/modules/bash/commit/123456", f.WithNamespace("modules").GetCommitViewerURL("bash", "123456"))
+}
+
+func TestGetTreeViewerURL(t *testing.T) {
+	f := newTestForge(t, "")
+	require.Equal(t, "file://"+filepath.Join(f.root, "bash")+"/tree/el-9.2/PATCHES", f.GetTreeViewerURL("bash", "el-9.2", "PATCHES"))
+
+	f = newTestForge(t, "https://git.example.org/")
+	require.Equal(t, "https://git.example.org/bash/tree/el-9.2/PATCHES", f.GetTreeViewerURL("bash", "el-9.2", "PATCHES"))
+}
+
+func TestEnsureRepositoryExists(t *testing.T) {
+	f := newTestForge(t, "")
+	auth, err := f.GetAuthenticator()
+	require.Nil(t, err)
+
+	require.Nil(t, f.EnsureRepositoryExists(auth, "bash"))
+	// Existing repositories are left alone
+	require.Nil(t, f.EnsureRepositoryExists(auth, "bash"))
+
+	repo, err := git.PlainOpen(filepath.Join(f.root, "bash"))
+	require.Nil(t, err)
+	cfg, err := repo.Config()
+	require.Nil(t, err)
+	require.True(t, cfg.Core.IsBare)
+}
+
+func TestEnsureRepositoryExists_Namespace(t *testing.T) {
+	f := newTestForge(t, "")
+	nf := f.WithNamespace("modules")
+	auth, err := nf.GetAuthenticator()
+	require.Nil(t, err)
+
+	require.Nil(t, nf.EnsureRepositoryExists(auth, "nginx"))
+	_, err = git.PlainOpen(filepath.Join(f.root, "modules", "nginx"))
+	require.Nil(t, err)
+}
+
+func TestEnsureRepositoryExists_InvalidName(t *testing.T) {
+	f := newTestForge(t, "")
+	auth, err := f.GetAuthenticator()
+	require.Nil(t, err)
+
+	require.NotNil(t, f.EnsureRepositoryExists(auth, "../bash"))
+	require.NotNil(t, f.EnsureRepositoryExists(auth, ""))
+}
+
+func TestPushAndClone(t *testing.T) {
+	f := newTestForge(t, "")
+	auth, err := f.GetAuthenticator()
+	require.Nil(t, err)
+	require.Nil(t, f.EnsureRepositoryExists(auth, "bash"))
+
+	repo, err := git.Init(memory.NewStorage(), memfs.New())
+	require.Nil(t, err)
+	wt, err := repo.Worktree()
+	require.Nil(t, err)
+
+	file, err := wt.Filesystem.Create("bash.spec")
+	require.Nil(t, err)
+	_, err = file.Write([]byte("Name: bash\n"))
+	require.Nil(t, err)
+	require.Nil(t, file.Close())
+	_, err = wt.Add("bash.spec")
+	require.Nil(t, err)
+	hash, err := wt.Commit("import bash-5.1.8-6.el9", &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  auth.AuthorName,
+			Email: auth.AuthorEmail,
+			When:  time.Now(),
+		},
+	})
+	require.Nil(t, err)
+
+	_, err = repo.CreateRemote(&config.RemoteConfig{
+		Name: "origin",
+		URLs: []string{f.GetRemote("bash")},
+	})
+	require.Nil(t, err)
+	require.Nil(t, repo.Push(&git.PushOptions{
+		Auth:     auth.AuthMethod,
+		RefSpecs: []config.RefSpec{"refs/heads/master:refs/heads/el-9.2"},
+	}))
+
+	cloned, err := git.Clone(memory.NewStorage(), nil, &git.CloneOptions{
+		URL:           f.GetRemote("bash"),
+		Auth:          auth.AuthMethod,
+		ReferenceName: "refs/heads/el-9.2",
+	})
+	require.Nil(t, err)
+	head, err := cloned.Head()
+	require.Nil(t, err)
+	require.Equal(t, hash, head.Hash())
+}
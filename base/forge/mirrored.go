@@ -0,0 +1,198 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package forge
+
+import (
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/pkg/errors"
+)
+
+// Mirror is a forge that repositories are mirrored to.
+type Mirror struct {
+	Forge
+
+	// Name identifies the mirror, results are recorded under it.
+	Name string
+}
+
+// MirrorResult is the result of pushing to a mirror.
+type MirrorResult struct {
+	// Name is the name of the mirror
+	Name string
+
+	// Err is nil if the mirror is up to date
+	Err error
+}
+
+// Mirrored is a forge that mirrors the repositories of a primary forge.
+// The primary forge is authoritative and serves all Forge methods, so
+// remotes and commit URLs always point to the primary.
+// Mirrors are pushed to with PushMirrors.
+type Mirrored struct {
+	Forge
+
+	mirrors []*Mirror
+}
+
+func NewMirrored(primary Forge, mirrors ...*Mirror) *Mirrored {
+	return &Mirrored{
+		Forge:   primary,
+		mirrors: mirrors,
+	}
+}
+
+// Mirrors returns the mirrors of the forge.
+func (m *Mirrored) Mirrors() []*Mirror {
+	return m.mirrors
+}
+
+// WithNamespace switches the namespace of the primary and all mirrors.
+func (m *Mirrored) WithNamespace(namespace string) Forge {
+	mirrors := make([]*Mirror, 0, len(m.mirrors))
+	for _, mirror := range m.mirrors {
+		mirrors = append(mirrors, &Mirror{
+			Forge: mirror.WithNamespace(namespace),
+			Name:  mirror.Name,
+		})
+	}
+
+	return NewMirrored(m.Forge.WithNamespace(namespace), mirrors...)
+}
+
+// MirrorsOf returns the mirrors of f, or nil if f isn't mirrored.
+func MirrorsOf(f Forge) []*Mirror {
+	if c, ok := f.(*Cacher); ok {
+		f = c.Forge
+	}
+	if m, ok := f.(*Mirrored); ok {
+		return m.mirrors
+	}
+
+	return nil
+}
+
+// pushMirror creates the repository on the mirror if needed and pushes to it.
+// If prune is set, refs on the mirror that match refSpecs but don't exist in
+// repo are deleted.
+func pushMirror(repo *git.Repository, mirror *Mirror, repoName string, refSpecs []config.RefSpec, prune bool) error {
+	auth, err := mirror.GetAuthenticator()
+	if err != nil {
+		return errors.Wrap(err, "failed to get authenticator")
+	}
+
+	err = mirror.EnsureRepositoryExists(auth, repoName)
+	if err != nil {
+		return errors.Wrap(err, "failed to ensure repository exists")
+	}
+
+	// The remote is only used for this push, it isn't added to the
+	// repository configuration
+	remote := git.NewRemote(repo.Storer, &config.RemoteConfig{
+		Name: mirror.Name,
+		URLs: []string{mirror.GetRemote(repoName)},
+	})
+	err = remote.Push(&git.PushOptions{
+		RemoteName: mirror.Name,
+		// Push modifies the refspecs of a forced push in place
+		RefSpecs: append([]config.RefSpec(nil), refSpecs...),
+		Auth:     auth.AuthMethod,
+		// The primary is authoritative, mirrors follow it even after a
+		// retraction rewrote a branch
+		Force: true,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return errors.Wrap(err, "failed to push")
+	}
+
+	if !prune {
+		return nil
+	}
+
+	// go-git can't prune a forced push, it matches the refs to prune
+	// against the forced refspecs, so stale refs are deleted separately
+	deletes, err := staleRefSpecs(repo, remote, auth, refSpecs)
+	if err != nil {
+		return err
+	}
+	if len(deletes) == 0 {
+		return nil
+	}
+	err = remote.Push(&git.PushOptions{
+		RemoteName: mirror.Name,
+		RefSpecs:   deletes,
+		Auth:       auth.AuthMethod,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return errors.Wrap(err, "failed to delete stale refs")
+	}
+
+	return nil
+}
+
+// staleRefSpecs returns the refspecs that delete the refs on remote that
+// match refSpecs, but don't exist in repo.
+func staleRefSpecs(repo *git.Repository, remote *git.Remote, auth *Authenticator, refSpecs []config.RefSpec) ([]config.RefSpec, error) {
+	refs, err := remote.List(&git.ListOptions{Auth: auth.AuthMethod})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list refs")
+	}
+
+	var deletes []config.RefSpec
+	for _, ref := range refs {
+		if ref.Type() != plumbing.HashReference {
+			continue
+		}
+
+		for _, rs := range refSpecs {
+			reverse := config.RefSpec(strings.TrimPrefix(rs.String(), "+")).Reverse()
+			if !reverse.Match(ref.Name()) {
+				continue
+			}
+
+			_, err := repo.Reference(reverse.Dst(ref.Name()), false)
+			if errors.Is(err, plumbing.ErrReferenceNotFound) {
+				deletes = append(deletes, config.RefSpec(":"+ref.Name().String()))
+			} else if err != nil {
+				return nil, errors.Wrap(err, "failed to get reference")
+			}
+			break
+		}
+	}
+
+	return deletes, nil
+}
+
+// PushMirrors pushes refSpecs of repo to the repository on every mirror.
+// Repositories are created on the mirrors as needed, the same way
+// EnsureRepositoryExists creates them on the primary.
+// A failing mirror doesn't stop the others, the result of every mirror is
+// returned in the order of mirrors.
+func PushMirrors(repo *git.Repository, mirrors []*Mirror, repoName string, refSpecs []config.RefSpec) []*MirrorResult {
+	return pushMirrors(repo, mirrors, repoName, refSpecs, false)
+}
+
+// SyncMirrors pushes refSpecs of repo to every mirror like PushMirrors, and
+// also deletes the refs matching refSpecs that repo doesn't have.
+// This brings back a mirror that missed deletions, for example the tags of
+// entries retracted while it was unavailable, so repo must have every ref
+// of the primary that matches refSpecs.
+func SyncMirrors(repo *git.Repository, mirrors []*Mirror, repoName string, refSpecs []config.RefSpec) []*MirrorResult {
+	return pushMirrors(repo, mirrors, repoName, refSpecs, true)
+}
+
+func pushMirrors(repo *git.Repository, mirrors []*Mirror, repoName string, refSpecs []config.RefSpec, prune bool) []*MirrorResult {
+	results := make([]*MirrorResult, 0, len(mirrors))
+	for _, mirror := range mirrors {
+		results = append(results, &MirrorResult{
+			Name: mirror.Name,
+			Err:  pushMirror(repo, mirror, repoName, refSpecs, prune),
+		})
+	}
+
+	return results
+}
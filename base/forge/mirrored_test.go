@@ -0,0 +1,192 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package forge
+
+import (
+	"errors"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/stretchr/testify/require"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// dirForge is a forge with bare repositories in a directory.
+type dirForge struct {
+	dir       string
+	namespace string
+	down      bool
+}
+
+func (d *dirForge) GetAuthenticator() (*Authenticator, error) {
+	return &Authenticator{
+		AuthorName:  "test",
+		AuthorEmail: "test@openela.org",
+		Expires:     time.Now().Add(time.Hour),
+	}, nil
+}
+
+func (d *dirForge) GetRemote(repo string) string {
+	return "file://" + filepath.Join(d.dir, d.namespace, repo)
+}
+
+func (d *dirForge) GetCommitViewerURL(repo string, commit string) string {
+	return d.GetRemote(repo) + "/commit/" + commit
+}
+
+func (d *dirForge) GetTreeViewerURL(repo string, ref string, path string) string {
+	return d.GetRemote(repo) + "/tree/" + ref + "/" + path
+}
+
+func (d *dirForge) EnsureRepositoryExists(_ *Authenticator, repo string) error {
+	if d.down {
+		return errors.New("forge is down")
+	}
+
+	_, err := git.PlainInit(filepath.Join(d.dir, d.namespace, repo), true)
+	if errors.Is(err, git.ErrRepositoryAlreadyExists) {
+		return nil
+	}
+	return err
+}
+
+func (d *dirForge) WithNamespace(namespace string) Forge {
+	newD := *d
+	newD.namespace = namespace
+	return &newD
+}
+
+func newTestRepo(t *testing.T) (*git.Repository, plumbing.Hash) {
+	repo, err := git.Init(memory.NewStorage(), memfs.New())
+	require.Nil(t, err)
+	wt, err := repo.Worktree()
+	require.Nil(t, err)
+
+	f, err := wt.Filesystem.Create("bash.spec")
+	require.Nil(t, err)
+	_, err = f.Write([]byte("Name: bash\n"))
+	require.Nil(t, err)
+	require.Nil(t, f.Close())
+	_, err = wt.Add("bash.spec")
+	require.Nil(t, err)
+
+	hash, err := wt.Commit("import bash", &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  "test",
+			Email: "test@openela.org",
+			When:  time.Now(),
+		},
+	})
+	require.Nil(t, err)
+
+	return repo, hash
+}
+
+func requireBranch(t *testing.T, dir string, branch string, hash plumbing.Hash) {
+	repo, err := git.PlainOpen(dir)
+	require.Nil(t, err)
+	ref, err := repo.Reference(plumbing.NewBranchReferenceName(branch), false)
+	require.Nil(t, err)
+	require.Equal(t, hash, ref.Hash())
+}
+
+func TestMirrored_ServedByPrimary(t *testing.T) {
+	primary := &dirForge{dir: t.TempDir()}
+	m := NewMirrored(primary, &Mirror{Name: "gitlab", Forge: &dirForge{dir: t.TempDir()}})
+
+	require.Equal(t, primary.GetRemote("bash"), m.GetRemote("bash"))
+	require.Equal(t, primary.GetCommitViewerURL("bash", "123"), m.GetCommitViewerURL("bash", "123"))
+	require.Len(t, MirrorsOf(m), 1)
+	require.Len(t, MirrorsOf(NewCacher(m)), 1)
+	require.Nil(t, MirrorsOf(primary))
+}
+
+func TestMirrored_WithNamespace(t *testing.T) {
+	primary := &dirForge{dir: t.TempDir()}
+	mirror := &dirForge{dir: t.TempDir()}
+	m := NewMirrored(primary, &Mirror{Name: "gitlab", Forge: mirror}).WithNamespace("modules")
+
+	require.Equal(t, "file://"+filepath.Join(primary.dir, "modules", "bash"), m.GetRemote("bash"))
+	mirrors := MirrorsOf(m)
+	require.Len(t, mirrors, 1)
+	require.Equal(t, "gitlab", mirrors[0].Name)
+	require.Equal(t, "file://"+filepath.Join(mirror.dir, "modules", "bash"), mirrors[0].GetRemote("bash"))
+}
+
+func TestPushMirrors(t *testing.T) {
+	up := &dirForge{dir: t.TempDir()}
+	down := &dirForge{dir: t.TempDir(), down: true}
+	mirrors := []*Mirror{
+		{Name: "up", Forge: up},
+		{Name: "down", Forge: down},
+	}
+
+	repo, hash := newTestRepo(t)
+	refSpecs := []config.RefSpec{"refs/heads/master:refs/heads/el-9.2"}
+
+	results := PushMirrors(repo, mirrors, "bash", refSpecs)
+	require.Len(t, results, 2)
+	require.Equal(t, "up", results[0].Name)
+	require.Nil(t, results[0].Err)
+	require.Equal(t, "down", results[1].Name)
+	require.NotNil(t, results[1].Err)
+	requireBranch(t, filepath.Join(up.dir, "bash"), "el-9.2", hash)
+
+	// Pushing again is a no-op
+	results = PushMirrors(repo, mirrors[:1], "bash", refSpecs)
+	require.Nil(t, results[0].Err)
+
+	// The mirror came back up
+	down.down = false
+	results = PushMirrors(repo, mirrors[1:], "bash", refSpecs)
+	require.Nil(t, results[0].Err)
+	requireBranch(t, filepath.Join(down.dir, "bash"), "el-9.2", hash)
+
+	// The temporary remote isn't kept
+	remotes, err := repo.Remotes()
+	require.Nil(t, err)
+	require.Empty(t, remotes)
+}
+
+func TestSyncMirrors(t *testing.T) {
+	up := &dirForge{dir: t.TempDir()}
+	mirrors := []*Mirror{{Name: "up", Forge: up}}
+
+	repo, hash := newTestRepo(t)
+	_, err := repo.CreateTag("imports/el-9.2/bash-5.1-1.el9", hash, nil)
+	require.Nil(t, err)
+	refSpecs := []config.RefSpec{
+		"refs/heads/*:refs/heads/*",
+		"refs/tags/*:refs/tags/*",
+	}
+
+	results := PushMirrors(repo, mirrors, "bash", refSpecs)
+	require.Nil(t, results[0].Err)
+
+	// The entry was retracted while the mirror was unavailable
+	require.Nil(t, repo.DeleteTag("imports/el-9.2/bash-5.1-1.el9"))
+
+	// Pushing doesn't delete the tag
+	results = PushMirrors(repo, mirrors, "bash", refSpecs)
+	require.Nil(t, results[0].Err)
+	mirrorRepo, err := git.PlainOpen(filepath.Join(up.dir, "bash"))
+	require.Nil(t, err)
+	_, err = mirrorRepo.Tag("imports/el-9.2/bash-5.1-1.el9")
+	require.Nil(t, err)
+
+	// Syncing does
+	results = SyncMirrors(repo, mirrors, "bash", refSpecs)
+	require.Nil(t, results[0].Err)
+	_, err = mirrorRepo.Tag("imports/el-9.2/bash-5.1-1.el9")
+	require.Equal(t, git.ErrTagNotFound, err)
+	requireBranch(t, filepath.Join(up.dir, "bash"), "master", hash)
+
+	// The refspecs of the caller aren't modified by the forced push
+	require.Equal(t, config.RefSpec("refs/heads/*:refs/heads/*"), refSpecs[0])
+}
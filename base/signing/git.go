@@ -0,0 +1,140 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package signing
+
+import (
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/pkg/errors"
+)
+
+// signature returns the signature of the encoded object.
+func signature(signer Signer, encode func(plumbing.EncodedObject) error) (string, error) {
+	encoded := &plumbing.MemoryObject{}
+	err := encode(encoded)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode object")
+	}
+
+	r, err := encoded.Reader()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read encoded object")
+	}
+	defer r.Close()
+
+	return signer.Sign(r)
+}
+
+// Commit records the changes of the worktree of repo, like Worktree.Commit,
+// and signs the commit with signer.
+// The commit is left unsigned if signer is nil.
+func Commit(repo *git.Repository, msg string, opts *git.CommitOptions, signer Signer) (plumbing.Hash, error) {
+	wt, err := repo.Worktree()
+	if err != nil {
+		return plumbing.ZeroHash, errors.Wrap(err, "failed to get worktree")
+	}
+
+	hash, err := wt.Commit(msg, opts)
+	if err != nil || signer == nil {
+		return hash, err
+	}
+
+	// go-git only signs with OpenPGP keys, so the commit is re-created with
+	// the signature and HEAD moved to it
+	commit, err := repo.CommitObject(hash)
+	if err != nil {
+		return plumbing.ZeroHash, errors.Wrap(err, "failed to get commit")
+	}
+	commit.PGPSignature, err = signature(signer, commit.EncodeWithoutSignature)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	obj := repo.Storer.NewEncodedObject()
+	err = commit.Encode(obj)
+	if err != nil {
+		return plumbing.ZeroHash, errors.Wrap(err, "failed to encode commit")
+	}
+	signedHash, err := repo.Storer.SetEncodedObject(obj)
+	if err != nil {
+		return plumbing.ZeroHash, errors.Wrap(err, "failed to store commit")
+	}
+
+	head, err := repo.Storer.Reference(plumbing.HEAD)
+	if err != nil {
+		return plumbing.ZeroHash, errors.Wrap(err, "failed to get HEAD")
+	}
+	name := plumbing.HEAD
+	if head.Type() == plumbing.SymbolicReference {
+		name = head.Target()
+	}
+	err = repo.Storer.SetReference(plumbing.NewHashReference(name, signedHash))
+	if err != nil {
+		return plumbing.ZeroHash, errors.Wrap(err, "failed to update HEAD")
+	}
+
+	return signedHash, nil
+}
+
+// CreateTag creates an annotated tag, like Repository.CreateTag, and signs
+// it with signer.
+// The tag is left unsigned if signer is nil.
+// Returns git.ErrTagExists if the tag already exists.
+func CreateTag(repo *git.Repository, name string, hash plumbing.Hash, opts *git.CreateTagOptions, signer Signer) (*plumbing.Reference, error) {
+	if signer == nil {
+		return repo.CreateTag(name, hash, opts)
+	}
+
+	refName := plumbing.NewTagReferenceName(name)
+	_, err := repo.Storer.Reference(refName)
+	if err == nil {
+		return nil, git.ErrTagExists
+	}
+	if !errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return nil, errors.Wrap(err, "failed to get tag")
+	}
+
+	// Also ensures the message ends with a newline, the signature is
+	// appended to it
+	err = opts.Validate(repo, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := repo.Object(plumbing.AnyObject, hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get tag target")
+	}
+
+	tag := &object.Tag{
+		Name:       name,
+		Tagger:     *opts.Tagger,
+		Message:    opts.Message,
+		TargetType: target.Type(),
+		Target:     hash,
+	}
+	tag.PGPSignature, err = signature(signer, tag.EncodeWithoutSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := repo.Storer.NewEncodedObject()
+	err = tag.Encode(obj)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode tag")
+	}
+	tagHash, err := repo.Storer.SetEncodedObject(obj)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to store tag")
+	}
+
+	ref := plumbing.NewHashReference(refName, tagHash)
+	err = repo.Storer.SetReference(ref)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create tag reference")
+	}
+
+	return ref, nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package signing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/pkg/errors"
+	"io"
+	"strings"
+)
+
+// OpenPGP signs with an OpenPGP key.
+type OpenPGP struct {
+	entity *openpgp.Entity
+	key    *Key
+}
+
+// normalizeKeyID upper cases a key ID or fingerprint and strips the
+// decoration gpg adds.
+func normalizeKeyID(keyID string) string {
+	keyID = strings.TrimPrefix(strings.TrimPrefix(keyID, "0x"), "0X")
+	return strings.ToUpper(strings.ReplaceAll(keyID, " ", ""))
+}
+
+// matchesKeyID returns true if keyID is the short ID, long ID or fingerprint
+// of the primary key or a subkey of entity.
+func matchesKeyID(entity *openpgp.Entity, keyID string) bool {
+	fingerprints := [][]byte{entity.PrimaryKey.Fingerprint}
+	for _, subkey := range entity.Subkeys {
+		fingerprints = append(fingerprints, subkey.PublicKey.Fingerprint)
+	}
+
+	for _, fingerprint := range fingerprints {
+		if strings.HasSuffix(strings.ToUpper(hex.EncodeToString(fingerprint)), keyID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewOpenPGP creates a signer from an armored OpenPGP private key.
+// keyID is required if the key ring contains multiple private keys.
+func NewOpenPGP(armored []byte, keyID string, passphrase []byte) (*OpenPGP, error) {
+	keyRing, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(armored))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read key ring")
+	}
+
+	keyID = normalizeKeyID(keyID)
+	var candidates []*openpgp.Entity
+	for _, entity := range keyRing {
+		if entity.PrivateKey == nil {
+			continue
+		}
+		if keyID != "" && !matchesKeyID(entity, keyID) {
+			continue
+		}
+		candidates = append(candidates, entity)
+	}
+	switch {
+	case len(candidates) == 0 && keyID != "":
+		return nil, errors.Errorf("no private key with ID %s", keyID)
+	case len(candidates) == 0:
+		return nil, errors.New("no private key found")
+	case len(candidates) > 1:
+		return nil, errors.New("multiple private keys found, a key ID is required")
+	}
+	entity := candidates[0]
+
+	if len(passphrase) > 0 {
+		err = entity.DecryptPrivateKeys(passphrase)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decrypt private key")
+		}
+	}
+	if entity.PrivateKey.Encrypted {
+		return nil, errors.New("private key is encrypted, a passphrase is required")
+	}
+
+	var publicKey bytes.Buffer
+	w, err := armor.Encode(&publicKey, openpgp.PublicKeyType, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to armor public key")
+	}
+	err = entity.Serialize(w)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize public key")
+	}
+	err = w.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to armor public key")
+	}
+	publicKey.WriteString("\n")
+
+	fingerprint := strings.ToUpper(hex.EncodeToString(entity.PrimaryKey.Fingerprint))
+	return &OpenPGP{
+		entity: entity,
+		key: &Key{
+			ID:          fingerprint,
+			Format:      FormatOpenPGP,
+			Fingerprint: fingerprint,
+			PublicKey:   publicKey.String(),
+		},
+	}, nil
+}
+
+func (o *OpenPGP) Sign(message io.Reader) (string, error) {
+	var sig bytes.Buffer
+	err := openpgp.ArmoredDetachSign(&sig, o.entity, message, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign")
+	}
+	// Tag signatures are appended to the message, so they have to end
+	// with a newline like the ones gpg creates
+	sig.WriteString("\n")
+
+	return sig.String(), nil
+}
+
+func (o *OpenPGP) Key() *Key {
+	return o.key
+}
@@ -0,0 +1,329 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package signing
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newOpenPGPEntity(t *testing.T, passphrase []byte) *openpgp.Entity {
+	entity, err := openpgp.NewEntity("Mship Bot", "", "mship@openela.org", nil)
+	require.Nil(t, err)
+	if passphrase != nil {
+		require.Nil(t, entity.EncryptPrivateKeys(passphrase, nil))
+	}
+
+	return entity
+}
+
+// armorKeyRing armors the private keys of entities as a single key ring.
+func armorKeyRing(t *testing.T, entities ...*openpgp.Entity) []byte {
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PrivateKeyType, nil)
+	require.Nil(t, err)
+	for _, entity := range entities {
+		require.Nil(t, entity.SerializePrivateWithoutSigning(w, nil))
+	}
+	require.Nil(t, w.Close())
+
+	return buf.Bytes()
+}
+
+func newOpenPGPKey(t *testing.T, passphrase []byte) ([]byte, *openpgp.Entity) {
+	entity := newOpenPGPEntity(t, passphrase)
+	return armorKeyRing(t, entity), entity
+}
+
+func newSSHKey(t *testing.T, passphrase []byte) []byte {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+
+	var block *pem.Block
+	if passphrase != nil {
+		block, err = ssh.MarshalPrivateKeyWithPassphrase(priv, "", passphrase)
+	} else {
+		block, err = ssh.MarshalPrivateKey(priv, "")
+	}
+	require.Nil(t, err)
+
+	return pem.EncodeToMemory(block)
+}
+
+// verifySSH verifies an armored SSH signature of message.
+func verifySSH(t *testing.T, sig string, message []byte) ssh.PublicKey {
+	lines := strings.Split(strings.TrimSpace(sig), "\n")
+	require.Equal(t, "-----BEGIN SSH SIGNATURE-----", lines[0])
+	require.Equal(t, "-----END SSH SIGNATURE-----", lines[len(lines)-1])
+	for _, line := range lines[1 : len(lines)-1] {
+		require.LessOrEqual(t, len(line), sshSigLineLength)
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(strings.Join(lines[1:len(lines)-1], ""))
+	require.Nil(t, err)
+	require.True(t, bytes.HasPrefix(raw, []byte(sshSigMagic)))
+
+	var blob sshSigBlob
+	require.Nil(t, ssh.Unmarshal(raw[len(sshSigMagic):], &blob))
+	require.Equal(t, uint32(1), blob.Version)
+	require.Equal(t, "git", blob.Namespace)
+	require.Equal(t, "sha512", blob.HashAlgorithm)
+
+	pub, err := ssh.ParsePublicKey([]byte(blob.PublicKey))
+	require.Nil(t, err)
+	var signature ssh.Signature
+	require.Nil(t, ssh.Unmarshal([]byte(blob.Signature), &signature))
+
+	hash := sha512.Sum512(message)
+	signedData := append([]byte(sshSigMagic), ssh.Marshal(sshSigSignedData{
+		Namespace:     "git",
+		HashAlgorithm: "sha512",
+		Hash:          string(hash[:]),
+	})...)
+	require.Nil(t, pub.Verify(signedData, &signature))
+
+	return pub
+}
+
+func TestNew_OpenPGP(t *testing.T) {
+	key, entity := newOpenPGPKey(t, nil)
+	signer, err := New(key, "", nil)
+	require.Nil(t, err)
+
+	k := signer.Key()
+	require.Equal(t, FormatOpenPGP, k.Format)
+	require.Len(t, k.Fingerprint, 40)
+	require.Equal(t, k.Fingerprint, k.ID)
+	require.True(t, strings.HasPrefix(k.PublicKey, "-----BEGIN PGP PUBLIC KEY BLOCK-----"))
+	require.NotContains(t, k.PublicKey, "PRIVATE")
+
+	keyRing, err := openpgp.ReadArmoredKeyRing(strings.NewReader(k.PublicKey))
+	require.Nil(t, err)
+	require.Len(t, keyRing, 1)
+	require.Equal(t, entity.PrimaryKey.Fingerprint, keyRing[0].PrimaryKey.Fingerprint)
+}
+
+func TestNew_OpenPGP_KeyID(t *testing.T) {
+	entity := newOpenPGPEntity(t, nil)
+	key := armorKeyRing(t, entity)
+	keyRing := armorKeyRing(t, entity, newOpenPGPEntity(t, nil))
+
+	_, err := New(keyRing, "", nil)
+	require.EqualError(t, err, "multiple private keys found, a key ID is required")
+
+	signer, err := New(keyRing, "0x"+entity.PrimaryKey.KeyIdString(), nil)
+	require.Nil(t, err)
+	require.True(t, strings.HasSuffix(signer.Key().Fingerprint, strings.ToUpper(entity.PrimaryKey.KeyIdString())))
+
+	_, err = New(key, "DEADBEEF", nil)
+	require.EqualError(t, err, "no private key with ID DEADBEEF")
+}
+
+func TestNew_OpenPGP_Passphrase(t *testing.T) {
+	key, _ := newOpenPGPKey(t, []byte("hunter2"))
+
+	_, err := New(key, "", nil)
+	require.EqualError(t, err, "private key is encrypted, a passphrase is required")
+
+	_, err = New(key, "", []byte("wrong"))
+	require.NotNil(t, err)
+
+	signer, err := New(key, "", []byte("hunter2"))
+	require.Nil(t, err)
+	_, err = signer.Sign(strings.NewReader("hello"))
+	require.Nil(t, err)
+}
+
+func TestNew_SSH(t *testing.T) {
+	signer, err := New(newSSHKey(t, nil), "", nil)
+	require.Nil(t, err)
+
+	k := signer.Key()
+	require.Equal(t, FormatSSH, k.Format)
+	require.True(t, strings.HasPrefix(k.Fingerprint, "SHA256:"))
+	require.Len(t, k.ID, 64)
+	require.True(t, strings.HasPrefix(k.PublicKey, "ssh-ed25519 "))
+
+	// The ID is the fingerprint, hex encoded
+	decoded, err := base64.RawStdEncoding.DecodeString(strings.TrimPrefix(k.Fingerprint, "SHA256:"))
+	require.Nil(t, err)
+	require.Equal(t, strings.ToUpper(hex.EncodeToString(decoded)), k.ID)
+}
+
+func TestNew_SSH_KeyID(t *testing.T) {
+	key := newSSHKey(t, nil)
+	signer, err := New(key, "", nil)
+	require.Nil(t, err)
+
+	_, err = New(key, signer.Key().Fingerprint, nil)
+	require.Nil(t, err)
+
+	_, err = New(key, "SHA256:nope", nil)
+	require.NotNil(t, err)
+}
+
+func TestNew_SSH_Passphrase(t *testing.T) {
+	key := newSSHKey(t, []byte("hunter2"))
+
+	_, err := New(key, "", nil)
+	require.EqualError(t, err, "private key is encrypted, a passphrase is required")
+
+	_, err = New(key, "", []byte("hunter2"))
+	require.Nil(t, err)
+}
+
+func TestNew_Unknown(t *testing.T) {
+	_, err := New([]byte("not a key"), "", nil)
+	require.NotNil(t, err)
+}
+
+func TestSSH_Sign(t *testing.T) {
+	signer, err := New(newSSHKey(t, nil), "", nil)
+	require.Nil(t, err)
+
+	message := bytes.Repeat([]byte("mothership"), 100)
+	sig, err := signer.Sign(bytes.NewReader(message))
+	require.Nil(t, err)
+
+	pub := verifySSH(t, sig, message)
+	require.Equal(t, signer.Key().PublicKey, strings.TrimSpace(string(ssh.MarshalAuthorizedKey(pub))))
+}
+
+func newTestRepo(t *testing.T) *git.Repository {
+	repo, err := git.Init(memory.NewStorage(), memfs.New())
+	require.Nil(t, err)
+	wt, err := repo.Worktree()
+	require.Nil(t, err)
+
+	f, err := wt.Filesystem.Create("bash.spec")
+	require.Nil(t, err)
+	_, err = f.Write([]byte("Name: bash\n"))
+	require.Nil(t, err)
+	require.Nil(t, f.Close())
+	_, err = wt.Add("bash.spec")
+	require.Nil(t, err)
+
+	return repo
+}
+
+func testSignature() *object.Signature {
+	return &object.Signature{
+		Name:  "Mship Bot",
+		Email: "mship@openela.org",
+		When:  time.Now(),
+	}
+}
+
+// encodedWithoutSignature returns the payload of a signature.
+func encodedWithoutSignature(t *testing.T, encode func(plumbing.EncodedObject) error) []byte {
+	obj := &plumbing.MemoryObject{}
+	require.Nil(t, encode(obj))
+	r, err := obj.Reader()
+	require.Nil(t, err)
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(r)
+	require.Nil(t, err)
+
+	return buf.Bytes()
+}
+
+func TestCommitAndTag_OpenPGP(t *testing.T) {
+	key, _ := newOpenPGPKey(t, nil)
+	signer, err := New(key, "", nil)
+	require.Nil(t, err)
+
+	repo := newTestRepo(t)
+	hash, err := Commit(repo, "import bash-5.1-1.el9", &git.CommitOptions{Author: testSignature()}, signer)
+	require.Nil(t, err)
+
+	// HEAD points to the signed commit
+	head, err := repo.Head()
+	require.Nil(t, err)
+	require.Equal(t, hash, head.Hash())
+
+	commit, err := repo.CommitObject(hash)
+	require.Nil(t, err)
+	require.True(t, strings.HasPrefix(commit.PGPSignature, "-----BEGIN PGP SIGNATURE-----"))
+	_, err = commit.Verify(signer.Key().PublicKey)
+	require.Nil(t, err)
+
+	ref, err := CreateTag(repo, "imports/el-9/bash-5.1-1.el9", hash, &git.CreateTagOptions{
+		Tagger:  testSignature(),
+		Message: "imports/el-9/bash-5.1-1.el9",
+	}, signer)
+	require.Nil(t, err)
+
+	tag, err := repo.TagObject(ref.Hash())
+	require.Nil(t, err)
+	require.Equal(t, hash, tag.Target)
+	require.Equal(t, "imports/el-9/bash-5.1-1.el9\n", tag.Message)
+	_, err = tag.Verify(signer.Key().PublicKey)
+	require.Nil(t, err)
+
+	_, err = CreateTag(repo, "imports/el-9/bash-5.1-1.el9", hash, &git.CreateTagOptions{
+		Tagger:  testSignature(),
+		Message: "imports/el-9/bash-5.1-1.el9",
+	}, signer)
+	require.Equal(t, git.ErrTagExists, err)
+}
+
+func TestCommitAndTag_SSH(t *testing.T) {
+	signer, err := New(newSSHKey(t, nil), "", nil)
+	require.Nil(t, err)
+
+	repo := newTestRepo(t)
+	hash, err := Commit(repo, "import bash-5.1-1.el9", &git.CommitOptions{Author: testSignature()}, signer)
+	require.Nil(t, err)
+
+	commit, err := repo.CommitObject(hash)
+	require.Nil(t, err)
+	verifySSH(t, commit.PGPSignature, encodedWithoutSignature(t, commit.EncodeWithoutSignature))
+
+	ref, err := CreateTag(repo, "imports/el-9/bash-5.1-1.el9", hash, &git.CreateTagOptions{
+		Tagger:  testSignature(),
+		Message: "imports/el-9/bash-5.1-1.el9",
+	}, signer)
+	require.Nil(t, err)
+
+	tag, err := repo.TagObject(ref.Hash())
+	require.Nil(t, err)
+	verifySSH(t, tag.PGPSignature, encodedWithoutSignature(t, tag.EncodeWithoutSignature))
+}
+
+func TestCommitAndTag_Unsigned(t *testing.T) {
+	repo := newTestRepo(t)
+	hash, err := Commit(repo, "import bash-5.1-1.el9", &git.CommitOptions{Author: testSignature()}, nil)
+	require.Nil(t, err)
+
+	commit, err := repo.CommitObject(hash)
+	require.Nil(t, err)
+	require.Empty(t, commit.PGPSignature)
+
+	ref, err := CreateTag(repo, "imports/el-9/bash-5.1-1.el9", hash, &git.CreateTagOptions{
+		Tagger:  testSignature(),
+		Message: "imports/el-9/bash-5.1-1.el9",
+	}, nil)
+	require.Nil(t, err)
+
+	tag, err := repo.TagObject(ref.Hash())
+	require.Nil(t, err)
+	require.Empty(t, tag.PGPSignature)
+}
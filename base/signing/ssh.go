@@ -0,0 +1,143 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package signing
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+	"io"
+	"strings"
+)
+
+const (
+	// sshSigMagic is the preamble of SSH signatures, see PROTOCOL.sshsig in
+	// the OpenSSH source.
+	sshSigMagic = "SSHSIG"
+
+	// sshSigNamespace is the namespace git signs with.
+	sshSigNamespace = "git"
+
+	// sshSigHashAlgorithm is the hash algorithm of the message, ssh-keygen
+	// defaults to SHA-512 as well.
+	sshSigHashAlgorithm = "sha512"
+
+	// sshSigLineLength is the line length of armored signatures, the same
+	// as ssh-keygen.
+	sshSigLineLength = 70
+)
+
+// sshSigSignedData is the data that is signed, following the magic.
+type sshSigSignedData struct {
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Hash          string
+}
+
+// sshSigBlob is the signature, following the magic.
+type sshSigBlob struct {
+	Version       uint32
+	PublicKey     string
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Signature     string
+}
+
+// SSH signs with an SSH key, in the format of `ssh-keygen -Y sign`.
+type SSH struct {
+	signer ssh.Signer
+	key    *Key
+}
+
+// NewSSH creates a signer from an SSH private key.
+// If keyID is set, it must be the SHA256 fingerprint of the key.
+func NewSSH(pemBytes []byte, keyID string, passphrase []byte) (*SSH, error) {
+	var signer ssh.Signer
+	var err error
+	if len(passphrase) > 0 {
+		signer, err = ssh.ParsePrivateKeyWithPassphrase(pemBytes, passphrase)
+	} else {
+		signer, err = ssh.ParsePrivateKey(pemBytes)
+	}
+	if err != nil {
+		var missing *ssh.PassphraseMissingError
+		if errors.As(err, &missing) {
+			return nil, errors.New("private key is encrypted, a passphrase is required")
+		}
+		return nil, errors.Wrap(err, "failed to parse private key")
+	}
+
+	pub := signer.PublicKey()
+	fingerprint := ssh.FingerprintSHA256(pub)
+	if keyID != "" && keyID != fingerprint {
+		return nil, errors.Errorf("private key has fingerprint %s, not %s", fingerprint, keyID)
+	}
+
+	// Same hash as the fingerprint, but hex encoded
+	sum := sha256.Sum256(pub.Marshal())
+	return &SSH{
+		signer: signer,
+		key: &Key{
+			ID:          strings.ToUpper(hex.EncodeToString(sum[:])),
+			Format:      FormatSSH,
+			Fingerprint: fingerprint,
+			PublicKey:   strings.TrimSpace(string(ssh.MarshalAuthorizedKey(pub))),
+		},
+	}, nil
+}
+
+func (s *SSH) Sign(message io.Reader) (string, error) {
+	h := sha512.New()
+	_, err := io.Copy(h, message)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to hash message")
+	}
+
+	signedData := append([]byte(sshSigMagic), ssh.Marshal(sshSigSignedData{
+		Namespace:     sshSigNamespace,
+		HashAlgorithm: sshSigHashAlgorithm,
+		Hash:          string(h.Sum(nil)),
+	})...)
+
+	var sig *ssh.Signature
+	if algorithmSigner, ok := s.signer.(ssh.AlgorithmSigner); ok && s.signer.PublicKey().Type() == ssh.KeyAlgoRSA {
+		// ssh-keygen refuses SHA-1 RSA signatures
+		sig, err = algorithmSigner.SignWithAlgorithm(rand.Reader, signedData, ssh.KeyAlgoRSASHA512)
+	} else {
+		sig, err = s.signer.Sign(rand.Reader, signedData)
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign")
+	}
+
+	blob := append([]byte(sshSigMagic), ssh.Marshal(sshSigBlob{
+		Version:       1,
+		PublicKey:     string(s.signer.PublicKey().Marshal()),
+		Namespace:     sshSigNamespace,
+		HashAlgorithm: sshSigHashAlgorithm,
+		Signature:     string(ssh.Marshal(sig)),
+	})...)
+
+	encoded := base64.StdEncoding.EncodeToString(blob)
+	var armored strings.Builder
+	armored.WriteString("-----BEGIN SSH SIGNATURE-----\n")
+	for len(encoded) > sshSigLineLength {
+		armored.WriteString(encoded[:sshSigLineLength] + "\n")
+		encoded = encoded[sshSigLineLength:]
+	}
+	armored.WriteString(encoded + "\n")
+	armored.WriteString("-----END SSH SIGNATURE-----\n")
+
+	return armored.String(), nil
+}
+
+func (s *SSH) Key() *Key {
+	return s.key
+}
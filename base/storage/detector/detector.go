@@ -6,25 +6,145 @@ package storage_detector
 import (
 	"github.com/go-git/go-billy/v5/osfs"
 	"github.com/openela/mothership/base/storage"
+	storage_encrypted "github.com/openela/mothership/base/storage/encrypted"
+	storage_file "github.com/openela/mothership/base/storage/file"
 	storage_memory "github.com/openela/mothership/base/storage/memory"
+	storage_replicated "github.com/openela/mothership/base/storage/replicated"
 	storage_s3 "github.com/openela/mothership/base/storage/s3"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli/v2"
+	"log/slog"
 	"net/url"
+	"slices"
+	"strconv"
 )
 
 func FromFlags(ctx *cli.Context) (storage.Storage, error) {
-	parsedURI, err := url.Parse(ctx.String("storage-connection-string"))
+	return FromURI(ctx, ctx.String("storage-connection-string"))
+}
+
+// FromURI creates a storage backend for the given connection string.
+func FromURI(ctx *cli.Context, uri string) (storage.Storage, error) {
+	parsedURI, err := url.Parse(uri)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse storage connection string")
 	}
 
 	switch parsedURI.Scheme {
 	case "s3":
-		return storage_s3.FromFlags(ctx)
+		return storage_s3.FromURI(ctx, uri)
+	case "file":
+		return storage_file.New(parsedURI.Path)
 	case "memory":
 		return storage_memory.New(osfs.New("/")), nil
+	case "replicated":
+		return replicatedFromURI(ctx, parsedURI)
+	case "encrypted":
+		return encryptedFromURI(ctx, parsedURI)
 	default:
 		return nil, errors.Errorf("unknown storage scheme: %s", parsedURI.Scheme)
 	}
 }
+
+// checkParams returns an error if the query has parameters that aren't
+// allowed. Nested connection strings that aren't query escaped leak their
+// own parameters into the query, this catches them.
+func checkParams(query url.Values, allowed ...string) error {
+	for key := range query {
+		if !slices.Contains(allowed, key) {
+			return errors.Errorf("unknown parameter %s, nested connection strings must be escaped with url.QueryEscape", key)
+		}
+	}
+
+	return nil
+}
+
+// replicatedFromURI creates a replicated storage backend.
+// The connection string lists the replicas in read order, for example
+// replicated://?quorum=2&replica=s3://primary&replica=file:///var/lib/mship.
+// Without a quorum, all replicas have to accept a write.
+// Replica connection strings are query escaped, so every replica can have
+// its own parameters, for example
+// replicated://?replica=s3%3A%2F%2Fprimary%3Fregion%3Dus-east-2&replica=s3%3A%2F%2Fsecondary%3Fregion%3Deu-west-1.
+func replicatedFromURI(ctx *cli.Context, parsedURI *url.URL) (storage.Storage, error) {
+	query := parsedURI.Query()
+	err := checkParams(query, "quorum", "replica")
+	if err != nil {
+		return nil, err
+	}
+
+	quorum := 0
+	if q := query.Get("quorum"); q != "" {
+		quorum, err = strconv.Atoi(q)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse quorum")
+		}
+	}
+
+	var replicas []storage.Storage
+	for _, replicaURI := range query["replica"] {
+		parsedReplica, err := url.Parse(replicaURI)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse replica connection string")
+		}
+		if parsedReplica.Scheme == "replicated" {
+			return nil, errors.New("replicas can't be nested")
+		}
+
+		replica, err := FromURI(ctx, replicaURI)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create replica %s", replicaURI)
+		}
+		replicas = append(replicas, replica)
+	}
+
+	slog.Info("Using replicated storage", "replicas", len(replicas), "quorum", quorum)
+
+	return storage_replicated.New(quorum, replicas...)
+}
+
+// encryptedFromURI creates an encrypted storage backend.
+// The connection string wraps the query escaped connection string of the
+// backend that stores the encrypted objects, for example
+// encrypted://?backend=s3%3A%2F%2Fembargo.
+// Keys are read from the storage-encryption-keys flag, so they never end up
+// in the connection string.
+func encryptedFromURI(ctx *cli.Context, parsedURI *url.URL) (storage.Storage, error) {
+	query := parsedURI.Query()
+	err := checkParams(query, "backend")
+	if err != nil {
+		return nil, err
+	}
+
+	backendURI := query.Get("backend")
+	if backendURI == "" {
+		return nil, errors.New("encrypted storage requires a backend")
+	}
+
+	keys, err := storage_encrypted.ParseKeyring(ctx.StringSlice("storage-encryption-keys"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse storage encryption keys")
+	}
+
+	backend, err := FromURI(ctx, backendURI)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create backend %s", backendURI)
+	}
+
+	// The envelope of every object is stored in its metadata
+	metadataBackend, ok := backend.(storage.MetadataStorage)
+	if !ok {
+		return nil, errors.Errorf("backend %s does not support metadata", backendURI)
+	}
+	if replicated, ok := storage_replicated.Find(backend); ok {
+		for i, replica := range replicated.Replicas() {
+			if _, ok := replica.(storage.MetadataStorage); !ok {
+				return nil, errors.Errorf("replica %d of backend %s does not support metadata", i, backendURI)
+			}
+		}
+	}
+
+	slog.Info("Using encrypted storage", "primaryKey", keys.Primary())
+
+	return storage_encrypted.New(metadataBackend, keys), nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage_detector
+
+import (
+	"flag"
+	"github.com/openela/mothership/base"
+	storage_file "github.com/openela/mothership/base/storage/file"
+	storage_replicated "github.com/openela/mothership/base/storage/replicated"
+	storage_s3 "github.com/openela/mothership/base/storage/s3"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"net/url"
+	"testing"
+)
+
+func testContext(t *testing.T) *cli.Context {
+	set := flag.NewFlagSet("test", flag.ContinueOnError)
+	for _, f := range base.WithStorageFlags() {
+		require.Nil(t, f.Apply(set))
+	}
+
+	return cli.NewContext(cli.NewApp(), set, nil)
+}
+
+func TestFromURI_Replicated(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+
+	uri := "replicated://?quorum=1" +
+		"&replica=" + url.QueryEscape("s3://primary?region=us-east-2") +
+		"&replica=" + url.QueryEscape("s3://secondary?region=eu-west-1&endpoint=https://s3.example.com&path-style=true") +
+		"&replica=" + url.QueryEscape("file://"+t.TempDir())
+	s, err := FromURI(testContext(t), uri)
+	require.Nil(t, err)
+
+	replicated, ok := storage_replicated.Find(s)
+	require.True(t, ok)
+	replicas := replicated.Replicas()
+	require.Len(t, replicas, 3)
+	require.IsType(t, &storage_s3.S3{}, replicas[0])
+	require.IsType(t, &storage_s3.S3{}, replicas[1])
+	require.IsType(t, &storage_file.File{}, replicas[2])
+}
+
+func TestFromURI_Replicated_Unescaped(t *testing.T) {
+	// The endpoint of the replica ends up as a parameter of the replicated
+	// connection string
+	_, err := FromURI(testContext(t), "replicated://?replica=s3://primary?region=us-east-2&endpoint=https://s3.example.com&replica=s3://secondary")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "url.QueryEscape")
+}
+
+func TestFromURI_Replicated_Nested(t *testing.T) {
+	_, err := FromURI(testContext(t), "replicated://?replica="+url.QueryEscape("replicated://?replica=memory://"))
+	require.NotNil(t, err)
+}
+
+func TestFromURI_Encrypted_Unescaped(t *testing.T) {
+	_, err := FromURI(testContext(t), "encrypted://?backend=s3://embargo?region=us-east-2&endpoint=https://s3.example.com")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "url.QueryEscape")
+}
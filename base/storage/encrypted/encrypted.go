@@ -0,0 +1,582 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage_encrypted
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"github.com/openela/mothership/base/storage"
+	"github.com/pkg/errors"
+	"io"
+	"os"
+)
+
+const (
+	// magic starts every encrypted object.
+	// The last byte is the format version.
+	magic = "MSE\x02"
+
+	// chunkSize is the size of plaintext chunks.
+	// Every chunk is sealed on its own, so objects can be streamed without
+	// buffering them in full.
+	chunkSize = 64 * 1024
+
+	// tagSize is the size of the GCM authentication tag of every chunk.
+	tagSize = 16
+
+	// metadataKeyID is the metadata key of the ID of the key that wrapped
+	// the data key.
+	metadataKeyID = "mship-key-id"
+
+	// metadataWrappedKey is the metadata key of the wrapped data key,
+	// base64 encoded.
+	metadataWrappedKey = "mship-wrapped-key"
+)
+
+// ErrNotEncrypted is returned when reading an object that wasn't written
+// through an encrypted backend.
+var ErrNotEncrypted = errors.New("object is not encrypted")
+
+// Encrypted is a storage backend that encrypts objects before handing them
+// to another backend, so whoever operates the underlying bucket can't read
+// them.
+//
+// Every object is encrypted with its own random AES-256-GCM data key. The
+// data key is wrapped with a key from the keyring and stored in the object
+// metadata, together with the ID of the wrapping key, so keys can be
+// rotated without rewriting the content.
+//
+// The content is the magic followed by the plaintext in chunks of
+// chunkSize, each sealed with a nonce made of the chunk index and a flag
+// marking the last chunk, so chunks can't be reordered, dropped or
+// truncated without failing authentication. The object name is part of the
+// additional data of every chunk, so objects can't be swapped either.
+//
+// Object names and modification times are not encrypted, and sizes are only
+// padded by the fixed overhead.
+type Encrypted struct {
+	storage.Storage
+
+	backend storage.MetadataStorage
+	keys    *Keyring
+}
+
+// New creates a new encrypted storage backend that stores objects in the
+// given backend.
+func New(backend storage.MetadataStorage, keys *Keyring) *Encrypted {
+	return &Encrypted{
+		backend: backend,
+		keys:    keys,
+	}
+}
+
+// Find returns the encrypted backend behind s.
+// Other decorators expose the backend they wrap with an Unwrap method.
+func Find(s storage.Storage) (*Encrypted, bool) {
+	for {
+		switch v := s.(type) {
+		case *Encrypted:
+			return v, true
+		case interface{ Unwrap() storage.Storage }:
+			s = v.Unwrap()
+		default:
+			return nil, false
+		}
+	}
+}
+
+// Unwrap returns the backend that stores the encrypted objects.
+func (e *Encrypted) Unwrap() storage.Storage {
+	return e.backend
+}
+
+// envelope is the wrapped data key of an object.
+type envelope struct {
+	keyID      string
+	wrappedKey []byte
+}
+
+// metadata returns the metadata of an object with the envelope.
+// Other keys of the existing metadata are kept.
+func (env *envelope) metadata(existing map[string]string) map[string]string {
+	metadata := make(map[string]string, len(existing)+2)
+	for key, value := range existing {
+		metadata[key] = value
+	}
+	metadata[metadataKeyID] = env.keyID
+	metadata[metadataWrappedKey] = base64.StdEncoding.EncodeToString(env.wrappedKey)
+
+	return metadata
+}
+
+// envelopeFromMetadata reads the envelope from the metadata of an object.
+// Returns ErrNotEncrypted if the metadata has no envelope.
+func envelopeFromMetadata(metadata map[string]string) (*envelope, error) {
+	keyID := metadata[metadataKeyID]
+	encodedKey := metadata[metadataWrappedKey]
+	if keyID == "" || encodedKey == "" {
+		return nil, ErrNotEncrypted
+	}
+
+	wrappedKey, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid wrapped key")
+	}
+
+	return &envelope{
+		keyID:      keyID,
+		wrappedKey: wrappedKey,
+	}, nil
+}
+
+// additionalData returns the additional data every chunk of the object is
+// sealed with.
+func additionalData(object string) []byte {
+	return []byte(magic + object)
+}
+
+// chunkNonce returns the nonce of a chunk.
+func chunkNonce(aead cipher.AEAD, index uint64, last bool) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce, index)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+
+	return nonce
+}
+
+// plaintextSize returns the size of the plaintext for an object of the given
+// size.
+func plaintextSize(size int64) int64 {
+	body := size - int64(len(magic))
+	sealedChunk := int64(chunkSize + tagSize)
+
+	chunks := body / sealedChunk
+	if body%sealedChunk != 0 || chunks == 0 {
+		chunks++
+	}
+
+	plain := body - chunks*tagSize
+	if plain < 0 {
+		return 0
+	}
+
+	return plain
+}
+
+// encryptWriter seals plaintext in chunks and writes them to w.
+type encryptWriter struct {
+	w     io.Writer
+	aead  cipher.AEAD
+	ad    []byte
+	buf   []byte
+	index uint64
+}
+
+func (e *encryptWriter) Write(p []byte) (int, error) {
+	e.buf = append(e.buf, p...)
+
+	// Always keep the remainder around, so the last chunk is sealed as
+	// such on Close, even if the plaintext is a multiple of chunkSize
+	for len(e.buf) > chunkSize {
+		err := e.seal(e.buf[:chunkSize], false)
+		if err != nil {
+			return 0, err
+		}
+		e.buf = e.buf[chunkSize:]
+	}
+
+	return len(p), nil
+}
+
+func (e *encryptWriter) seal(chunk []byte, last bool) error {
+	sealed := e.aead.Seal(nil, chunkNonce(e.aead, e.index, last), chunk, e.ad)
+	e.index++
+
+	_, err := e.w.Write(sealed)
+	return err
+}
+
+// finish seals the last chunk.
+func (e *encryptWriter) finish() error {
+	err := e.seal(e.buf, true)
+	e.buf = nil
+
+	return err
+}
+
+// decryptReader opens chunks sealed by encryptWriter.
+type decryptReader struct {
+	r     *bufio.Reader
+	aead  cipher.AEAD
+	ad    []byte
+	buf   []byte
+	index uint64
+	done  bool
+}
+
+func (d *decryptReader) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+
+		err := d.next()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+
+	return n, nil
+}
+
+func (d *decryptReader) next() error {
+	sealed := make([]byte, chunkSize+tagSize)
+	n, err := io.ReadFull(d.r, sealed)
+	last := false
+	switch err {
+	case nil:
+		// A full chunk is the last one if nothing follows it
+		_, peekErr := d.r.Peek(1)
+		if peekErr == io.EOF {
+			last = true
+		} else if peekErr != nil {
+			return peekErr
+		}
+	case io.EOF, io.ErrUnexpectedEOF:
+		last = true
+	default:
+		return err
+	}
+
+	chunk, err := d.aead.Open(nil, chunkNonce(d.aead, d.index, last), sealed[:n], d.ad)
+	if err != nil {
+		return errors.Wrap(err, "failed to decrypt object, it may be truncated or tampered with")
+	}
+	d.index++
+	d.buf = chunk
+	d.done = last
+
+	return nil
+}
+
+// newEnvelope generates a data key and returns its envelope and the cipher
+// for the object.
+func (e *Encrypted) newEnvelope() (*envelope, cipher.AEAD, error) {
+	dataKey := make([]byte, keySize)
+	_, err := io.ReadFull(rand.Reader, dataKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate data key")
+	}
+
+	keyID, wrappedKey, err := e.keys.wrap(dataKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &envelope{
+		keyID:      keyID,
+		wrappedKey: wrappedKey,
+	}, aead, nil
+}
+
+// encrypt writes the encrypted form of r to w and returns the metadata
+// with the envelope.
+func (e *Encrypted) encrypt(object string, w io.Writer, r io.Reader) (map[string]string, error) {
+	env, aead, err := e.newEnvelope()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = io.WriteString(w, magic)
+	if err != nil {
+		return nil, err
+	}
+
+	ew := &encryptWriter{
+		w:    w,
+		aead: aead,
+		ad:   additionalData(object),
+	}
+	_, err = io.Copy(ew, r)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ew.finish()
+	if err != nil {
+		return nil, err
+	}
+
+	return env.metadata(nil), nil
+}
+
+// openEnvelope reads the envelope of an object and returns its data key
+// cipher.
+func (e *Encrypted) openEnvelope(object string) (cipher.AEAD, error) {
+	metadata, err := e.backend.GetMetadata(object)
+	if err != nil {
+		return nil, err
+	}
+
+	env, err := envelopeFromMetadata(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey, err := e.keys.unwrap(env.keyID, env.wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAEAD(dataKey)
+}
+
+// readCloser pairs a decrypting reader with the closer of the backend
+// reader.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// Open opens a file from the storage backend for streaming reads.
+// The returned size is the size of the plaintext.
+func (e *Encrypted) Open(object string) (io.ReadCloser, int64, error) {
+	aead, err := e.openEnvelope(object)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "failed to read %s", object)
+	}
+
+	rc, size, err := e.backend.Open(object)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	br := bufio.NewReader(rc)
+	prefix := make([]byte, len(magic))
+	_, err = io.ReadFull(br, prefix)
+	if err != nil || string(prefix) != magic {
+		_ = rc.Close()
+		return nil, 0, errors.Wrapf(ErrNotEncrypted, "failed to read %s", object)
+	}
+
+	return &readCloser{
+		Reader: &decryptReader{
+			r:    br,
+			aead: aead,
+			ad:   additionalData(object),
+		},
+		Closer: rc,
+	}, plaintextSize(size), nil
+}
+
+// Get returns the contents of a file from the storage backend.
+func (e *Encrypted) Get(object string) ([]byte, error) {
+	rc, _, err := e.Open(object)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return io.ReadAll(rc)
+}
+
+// Download downloads a file from the storage backend to the given path.
+func (e *Encrypted) Download(object string, toPath string) error {
+	rc, _, err := e.Open(object)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	f, err := os.OpenFile(toPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return errors.Wrap(err, "failed to open file")
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, rc)
+	if err != nil {
+		return errors.Wrap(err, "failed to decrypt object")
+	}
+
+	return nil
+}
+
+// Put uploads a file to the storage backend.
+// The file is encrypted to a temporary file first, so the backend can
+// upload it the same way as an unencrypted file.
+func (e *Encrypted) Put(object string, fromPath string) (*storage.UploadInfo, error) {
+	in, err := os.Open(fromPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open file")
+	}
+	defer in.Close()
+
+	tmp, err := os.CreateTemp("", "mship-encrypted-*")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create temporary file")
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	metadata, err := e.encrypt(object, tmp, in)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encrypt file")
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to close temporary file")
+	}
+
+	return e.backend.PutWithMetadata(object, tmp.Name(), metadata)
+}
+
+// PutBytes uploads a file to the storage backend.
+func (e *Encrypted) PutBytes(object string, data []byte) (*storage.UploadInfo, error) {
+	var buf bytes.Buffer
+	metadata, err := e.encrypt(object, &buf, bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encrypt data")
+	}
+
+	return e.backend.PutBytesWithMetadata(object, buf.Bytes(), metadata)
+}
+
+// writer encrypts writes to a backend writer.
+type writer struct {
+	backend storage.Writer
+	*encryptWriter
+}
+
+// Close seals the last chunk and closes the backend writer.
+func (w *writer) Close() error {
+	err := w.finish()
+	if err != nil {
+		_ = w.backend.Abort()
+		return errors.Wrap(err, "failed to encrypt object")
+	}
+
+	return w.backend.Close()
+}
+
+// Abort discards the object.
+func (w *writer) Abort() error {
+	return w.backend.Abort()
+}
+
+// Create opens a file in the storage backend for streaming writes.
+func (e *Encrypted) Create(object string) (storage.Writer, error) {
+	env, aead, err := e.newEnvelope()
+	if err != nil {
+		return nil, err
+	}
+
+	bw, err := e.backend.CreateWithMetadata(object, env.metadata(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = io.WriteString(bw, magic)
+	if err != nil {
+		_ = bw.Abort()
+		return nil, errors.Wrap(err, "failed to write magic")
+	}
+
+	return &writer{
+		backend: bw,
+		encryptWriter: &encryptWriter{
+			w:    bw,
+			aead: aead,
+			ad:   additionalData(object),
+		},
+	}, nil
+}
+
+// Delete deletes a file from the storage backend.
+func (e *Encrypted) Delete(object string) error {
+	return e.backend.Delete(object)
+}
+
+// Exists checks if a file exists in the storage backend.
+func (e *Encrypted) Exists(object string) (bool, error) {
+	return e.backend.Exists(object)
+}
+
+// List returns all objects whose name starts with the given prefix.
+func (e *Encrypted) List(prefix string) ([]*storage.ObjectInfo, error) {
+	objects, err := e.backend.List(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, obj := range objects {
+		obj.Size = plaintextSize(obj.Size)
+	}
+
+	return objects, nil
+}
+
+// CanReadURI checks if a URI can be read by the storage backend.
+func (e *Encrypted) CanReadURI(uri string) (bool, error) {
+	return e.backend.CanReadURI(uri)
+}
+
+// ObjectFromURI returns the object name for a URI of the backend.
+func (e *Encrypted) ObjectFromURI(uri string) (string, error) {
+	return storage.ObjectFromURI(e.backend, uri)
+}
+
+// Rewrap re-wraps the data key of an object with the primary key, so the
+// key it was written with can be retired after a rotation.
+// Only the metadata changes, the content isn't rewritten.
+// Returns false if the object already uses the primary key.
+func (e *Encrypted) Rewrap(object string) (bool, error) {
+	metadata, err := e.backend.GetMetadata(object)
+	if err != nil {
+		return false, err
+	}
+
+	env, err := envelopeFromMetadata(metadata)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to read %s", object)
+	}
+	if env.keyID == e.keys.primary {
+		return false, nil
+	}
+
+	dataKey, err := e.keys.unwrap(env.keyID, env.wrappedKey)
+	if err != nil {
+		return false, err
+	}
+	keyID, wrappedKey, err := e.keys.wrap(dataKey)
+	if err != nil {
+		return false, err
+	}
+	rewrapped := &envelope{
+		keyID:      keyID,
+		wrappedKey: wrappedKey,
+	}
+
+	err = e.backend.SetMetadata(object, rewrapped.metadata(metadata))
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to update metadata of %s", object)
+	}
+
+	return true, nil
+}
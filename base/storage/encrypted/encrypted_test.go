@@ -0,0 +1,280 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage_encrypted
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/osfs"
+	"github.com/openela/mothership/base/storage"
+	storage_memory "github.com/openela/mothership/base/storage/memory"
+	"github.com/openela/mothership/base/storage/storagetest"
+	"github.com/stretchr/testify/require"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func testKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, keySize)
+}
+
+func testKeyring(t *testing.T, primary string, ids ...string) *Keyring {
+	keys := map[string][]byte{}
+	for i, id := range ids {
+		keys[id] = testKey(byte(i + 1))
+	}
+
+	kr, err := NewKeyring(primary, keys)
+	require.Nil(t, err)
+	return kr
+}
+
+func TestConformance(t *testing.T) {
+	backend := storage_memory.New(osfs.New("/"), t.TempDir())
+	storagetest.Run(t, New(backend, testKeyring(t, "k1", "k1")))
+}
+
+func TestNewKeyring_InvalidKeySize(t *testing.T) {
+	_, err := NewKeyring("k1", map[string][]byte{"k1": []byte("short")})
+	require.NotNil(t, err)
+}
+
+func TestNewKeyring_MissingPrimary(t *testing.T) {
+	_, err := NewKeyring("k2", map[string][]byte{"k1": testKey(1)})
+	require.NotNil(t, err)
+}
+
+func TestParseKeyring(t *testing.T) {
+	kr, err := ParseKeyring([]string{
+		"new=" + base64.StdEncoding.EncodeToString(testKey(2)),
+		"old=" + base64.StdEncoding.EncodeToString(testKey(1)),
+	})
+	require.Nil(t, err)
+	require.Equal(t, "new", kr.primary)
+	require.Len(t, kr.keys, 2)
+}
+
+func TestParseKeyring_Invalid(t *testing.T) {
+	_, err := ParseKeyring(nil)
+	require.NotNil(t, err)
+
+	_, err = ParseKeyring([]string{"no-separator"})
+	require.NotNil(t, err)
+
+	_, err = ParseKeyring([]string{"k1=not base64"})
+	require.NotNil(t, err)
+
+	key := base64.StdEncoding.EncodeToString(testKey(1))
+	_, err = ParseKeyring([]string{"k1=" + key, "k1=" + key})
+	require.NotNil(t, err)
+}
+
+func TestEncrypted_BackendCantRead(t *testing.T) {
+	backend := storage_memory.New(memfs.New())
+	e := New(backend, testKeyring(t, "k1", "k1"))
+
+	plaintext := []byte("embargoed sources")
+	_, err := e.PutBytes("foo", plaintext)
+	require.Nil(t, err)
+
+	raw, err := backend.Get("foo")
+	require.Nil(t, err)
+	require.False(t, bytes.Contains(raw, plaintext))
+
+	data, err := e.Get("foo")
+	require.Nil(t, err)
+	require.Equal(t, plaintext, data)
+}
+
+func TestEncrypted_Chunks(t *testing.T) {
+	backend := storage_memory.New(memfs.New())
+	e := New(backend, testKeyring(t, "k1", "k1"))
+
+	for _, size := range []int{0, 1, chunkSize - 1, chunkSize, chunkSize + 1, 3 * chunkSize, 3*chunkSize + 17} {
+		plaintext := make([]byte, size)
+		for i := range plaintext {
+			plaintext[i] = byte(i)
+		}
+
+		w, err := e.Create("foo")
+		require.Nil(t, err)
+		_, err = io.Copy(w, bytes.NewReader(plaintext))
+		require.Nil(t, err)
+		require.Nil(t, w.Close())
+
+		rc, n, err := e.Open("foo")
+		require.Nil(t, err)
+		require.Equal(t, int64(size), n)
+		data, err := io.ReadAll(rc)
+		require.Nil(t, err)
+		require.Nil(t, rc.Close())
+		require.Equal(t, plaintext, data)
+	}
+}
+
+func TestEncrypted_Put_Download(t *testing.T) {
+	backend := storage_memory.New(osfs.New("/"))
+	e := New(backend, testKeyring(t, "k1", "k1"))
+
+	dir := t.TempDir()
+	plaintext := bytes.Repeat([]byte("srpm"), chunkSize)
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "from"), plaintext, 0644))
+
+	info, err := e.Put("foo", filepath.Join(dir, "from"))
+	require.Nil(t, err)
+	require.Equal(t, "memory://foo", info.Location)
+
+	require.Nil(t, e.Download("foo", filepath.Join(dir, "to")))
+	data, err := os.ReadFile(filepath.Join(dir, "to"))
+	require.Nil(t, err)
+	require.Equal(t, plaintext, data)
+}
+
+func TestEncrypted_Tampered(t *testing.T) {
+	backend := storage_memory.New(memfs.New())
+	e := New(backend, testKeyring(t, "k1", "k1"))
+
+	_, err := e.PutBytes("foo", []byte("hello"))
+	require.Nil(t, err)
+
+	raw, err := backend.Get("foo")
+	require.Nil(t, err)
+	metadata, err := backend.GetMetadata("foo")
+	require.Nil(t, err)
+	raw[len(raw)-1] ^= 0xff
+	_, err = backend.PutBytesWithMetadata("foo", raw, metadata)
+	require.Nil(t, err)
+
+	_, err = e.Get("foo")
+	require.NotNil(t, err)
+}
+
+func TestEncrypted_Truncated(t *testing.T) {
+	backend := storage_memory.New(memfs.New())
+	e := New(backend, testKeyring(t, "k1", "k1"))
+
+	_, err := e.PutBytes("foo", make([]byte, 3*chunkSize))
+	require.Nil(t, err)
+
+	// Drop the last chunk, the chunk before it isn't sealed as the last one
+	raw, err := backend.Get("foo")
+	require.Nil(t, err)
+	metadata, err := backend.GetMetadata("foo")
+	require.Nil(t, err)
+	_, err = backend.PutBytesWithMetadata("foo", raw[:len(raw)-(chunkSize+tagSize)], metadata)
+	require.Nil(t, err)
+
+	_, err = e.Get("foo")
+	require.NotNil(t, err)
+}
+
+func TestEncrypted_Swapped(t *testing.T) {
+	backend := storage_memory.New(memfs.New())
+	e := New(backend, testKeyring(t, "k1", "k1"))
+
+	_, err := e.PutBytes("foo", []byte("hello"))
+	require.Nil(t, err)
+
+	// Copy foo to bar together with its envelope, chunks are bound to the
+	// object name
+	raw, err := backend.Get("foo")
+	require.Nil(t, err)
+	metadata, err := backend.GetMetadata("foo")
+	require.Nil(t, err)
+	_, err = backend.PutBytesWithMetadata("bar", raw, metadata)
+	require.Nil(t, err)
+
+	_, err = e.Get("bar")
+	require.NotNil(t, err)
+}
+
+func TestEncrypted_Metadata(t *testing.T) {
+	backend := storage_memory.New(memfs.New())
+	e := New(backend, testKeyring(t, "k1", "k1"))
+
+	_, err := e.PutBytes("foo", []byte("hello"))
+	require.Nil(t, err)
+
+	metadata, err := backend.GetMetadata("foo")
+	require.Nil(t, err)
+	require.Equal(t, "k1", metadata[metadataKeyID])
+	require.NotEmpty(t, metadata[metadataWrappedKey])
+
+	// The content is only the magic and the sealed chunk
+	raw, err := backend.Get("foo")
+	require.Nil(t, err)
+	require.Len(t, raw, len(magic)+len("hello")+tagSize)
+	require.Equal(t, magic, string(raw[:len(magic)]))
+
+	// Without the envelope, the object can't be read
+	require.Nil(t, backend.SetMetadata("foo", nil))
+	_, err = e.Get("foo")
+	require.True(t, errors.Is(err, ErrNotEncrypted))
+}
+
+func TestEncrypted_NotEncrypted(t *testing.T) {
+	backend := storage_memory.New(memfs.New())
+	e := New(backend, testKeyring(t, "k1", "k1"))
+
+	_, err := backend.PutBytes("foo", []byte("plaintext"))
+	require.Nil(t, err)
+
+	_, err = e.Get("foo")
+	require.True(t, errors.Is(err, ErrNotEncrypted))
+}
+
+func TestEncrypted_NotFound(t *testing.T) {
+	e := New(storage_memory.New(memfs.New()), testKeyring(t, "k1", "k1"))
+
+	_, err := e.Get("foo")
+	require.True(t, errors.Is(err, storage.ErrNotFound))
+}
+
+func TestEncrypted_Rotation(t *testing.T) {
+	backend := storage_memory.New(memfs.New())
+
+	// Written before the rotation
+	old := New(backend, testKeyring(t, "k1", "k1"))
+	_, err := old.PutBytes("foo", []byte("hello"))
+	require.Nil(t, err)
+
+	// k2 is added as the primary key, k1 is kept for reading
+	rotated := New(backend, testKeyring(t, "k2", "k1", "k2"))
+	data, err := rotated.Get("foo")
+	require.Nil(t, err)
+	require.Equal(t, []byte("hello"), data)
+
+	before, err := backend.Get("foo")
+	require.Nil(t, err)
+
+	rewrapped, err := rotated.Rewrap("foo")
+	require.Nil(t, err)
+	require.True(t, rewrapped)
+
+	// Only the envelope changed
+	after, err := backend.Get("foo")
+	require.Nil(t, err)
+	require.Equal(t, before, after)
+	metadata, err := backend.GetMetadata("foo")
+	require.Nil(t, err)
+	require.Equal(t, "k2", metadata[metadataKeyID])
+
+	rewrapped, err = rotated.Rewrap("foo")
+	require.Nil(t, err)
+	require.False(t, rewrapped)
+
+	// k1 can be retired now
+	retired := New(backend, testKeyring(t, "k2", "k1", "k2"))
+	delete(retired.keys.keys, "k1")
+	data, err = retired.Get("foo")
+	require.Nil(t, err)
+	require.Equal(t, []byte("hello"), data)
+
+	_, err = old.Get("foo")
+	require.NotNil(t, err)
+}
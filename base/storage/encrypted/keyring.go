@@ -0,0 +1,141 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage_encrypted
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"github.com/pkg/errors"
+	"io"
+	"strings"
+)
+
+// keySize is the size of both key encryption keys and data keys.
+// AES-256 is used for both.
+const keySize = 32
+
+// maxKeyIDSize is the maximum length of a key ID.
+const maxKeyIDSize = 32
+
+// Keyring holds the key encryption keys.
+// New objects are always encrypted with the primary key, the other keys are
+// only used to decrypt objects written before the primary key was rotated.
+type Keyring struct {
+	primary string
+	keys    map[string]cipher.AEAD
+}
+
+// NewKeyring creates a keyring from AES-256 keys by ID.
+// The primary key is used for new objects.
+func NewKeyring(primary string, keys map[string][]byte) (*Keyring, error) {
+	if _, ok := keys[primary]; !ok {
+		return nil, errors.Errorf("primary key %s not found", primary)
+	}
+
+	kr := &Keyring{
+		primary: primary,
+		keys:    map[string]cipher.AEAD{},
+	}
+	for id, key := range keys {
+		if id == "" || len(id) > maxKeyIDSize {
+			return nil, errors.Errorf("key ID %q must be between 1 and %d bytes", id, maxKeyIDSize)
+		}
+		if len(key) != keySize {
+			return nil, errors.Errorf("key %s must be %d bytes", id, keySize)
+		}
+
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create cipher for key %s", id)
+		}
+		kr.keys[id] = aead
+	}
+
+	return kr, nil
+}
+
+// ParseKeyring creates a keyring from "<id>=<base64 key>" pairs.
+// The first key is the primary key, so rotating means adding a new key in
+// front and keeping the old ones around until no object uses them anymore.
+func ParseKeyring(specs []string) (*Keyring, error) {
+	if len(specs) == 0 {
+		return nil, errors.New("at least one key is required")
+	}
+
+	primary := ""
+	keys := map[string][]byte{}
+	for _, spec := range specs {
+		id, encoded, ok := strings.Cut(spec, "=")
+		if !ok {
+			return nil, errors.New("keys must be of the format <id>=<base64 key>")
+		}
+		if _, exists := keys[id]; exists {
+			return nil, errors.Errorf("duplicate key %s", id)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode key %s", id)
+		}
+		keys[id] = key
+
+		if primary == "" {
+			primary = id
+		}
+	}
+
+	return NewKeyring(primary, keys)
+}
+
+// Primary returns the ID of the key used for new objects.
+func (kr *Keyring) Primary() string {
+	return kr.primary
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// wrap encrypts a data key with the primary key.
+// Returns the ID of the primary key and the nonce-prefixed wrapped key.
+func (kr *Keyring) wrap(dataKey []byte) (string, []byte, error) {
+	aead := kr.keys[kr.primary]
+
+	nonce := make([]byte, aead.NonceSize())
+	_, err := io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to generate nonce")
+	}
+
+	// Binding the key ID makes sure a wrapped key can't be moved to
+	// another key ID
+	return kr.primary, aead.Seal(nonce, nonce, dataKey, []byte(kr.primary)), nil
+}
+
+// unwrap decrypts a data key with the given key.
+func (kr *Keyring) unwrap(id string, wrapped []byte) ([]byte, error) {
+	aead, ok := kr.keys[id]
+	if !ok {
+		return nil, errors.Errorf("unknown key %s", id)
+	}
+
+	if len(wrapped) < aead.NonceSize() {
+		return nil, errors.New("wrapped key is too short")
+	}
+	nonce, ciphertext := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+
+	dataKey, err := aead.Open(nil, nonce, ciphertext, []byte(id))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unwrap data key with key %s", id)
+	}
+
+	return dataKey, nil
+}
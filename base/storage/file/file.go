@@ -0,0 +1,590 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage_file
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/openela/mothership/base/storage"
+	"github.com/pkg/errors"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// tempPrefix is the prefix of in-flight writes.
+// Temporary files live next to their final destination so the rename is
+// atomic, and are never returned as objects.
+const tempPrefix = ".tmp-"
+
+// metadataPrefix is the prefix of the files that hold the metadata of an
+// object, as JSON next to the object. They are never returned as objects
+// either.
+const metadataPrefix = ".meta-"
+
+// File is an implementation of the Storage interface backed by a local
+// directory.
+// Objects are sharded by the first two bytes of the SHA-256 hash of their
+// name, which keeps the directories evenly sized whatever the names are.
+// The name is escaped into a single file name, so an object named
+// "trash/abcdef" is stored at "<root>/15/8d/trash%2Fabcdef".
+type File struct {
+	storage.Storage
+
+	root string
+}
+
+// New creates a new File storage rooted at the given directory.
+// The directory is created if it does not exist.
+func New(root string) (*File, error) {
+	if root == "" {
+		return nil, errors.New("root directory is required")
+	}
+
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get absolute path")
+	}
+
+	err = os.MkdirAll(abs, 0755)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create root directory")
+	}
+
+	return &File{
+		root: abs,
+	}, nil
+}
+
+// cleanObject normalizes the object name and makes sure it can't escape
+// the root directory.
+func cleanObject(object string) (string, error) {
+	cleaned := strings.TrimPrefix(path.Clean("/"+object), "/")
+	if cleaned == "" {
+		return "", errors.New("object name is empty")
+	}
+
+	if isReserved(cleaned) {
+		return "", errors.Errorf("object name %s is reserved", object)
+	}
+
+	return cleaned, nil
+}
+
+// isReserved returns true if the file name is used for the bookkeeping of
+// objects.
+func isReserved(name string) bool {
+	return strings.HasPrefix(name, tempPrefix) || strings.HasPrefix(name, metadataPrefix)
+}
+
+// shardDir returns the shard directory of the object, relative to the root.
+func shardDir(object string) string {
+	sum := sha256.Sum256([]byte(object))
+	hash := hex.EncodeToString(sum[:])
+	return path.Join(hash[:2], hash[2:4])
+}
+
+// shard returns the sharded path of the object, relative to the root.
+func shard(object string) string {
+	return path.Join(shardDir(object), url.PathEscape(object))
+}
+
+// unshard returns the object name for a path relative to the root.
+// Returns false if the path isn't a sharded object path.
+func unshard(rel string) (string, bool) {
+	dir, name := path.Split(rel)
+	object, err := url.PathUnescape(name)
+	if err != nil || object == "" {
+		return "", false
+	}
+
+	if path.Clean(dir) != shardDir(object) || shard(object) != rel {
+		return "", false
+	}
+
+	return object, true
+}
+
+func (f *File) objectPath(object string) (string, error) {
+	cleaned, err := cleanObject(object)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(f.root, filepath.FromSlash(shard(cleaned))), nil
+}
+
+func (f *File) location(object string) (string, error) {
+	cleaned, err := cleanObject(object)
+	if err != nil {
+		return "", err
+	}
+
+	return "file://" + filepath.ToSlash(f.root) + "/" + cleaned, nil
+}
+
+// atomicWriter writes to a temporary file in the same directory as the
+// target, then renames it into place on Close, so readers never observe a
+// partial object.
+type atomicWriter struct {
+	tmp        *os.File
+	targetPath string
+}
+
+func newAtomicWriter(targetPath string) (*atomicWriter, error) {
+	err := os.MkdirAll(filepath.Dir(targetPath), 0755)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create directory")
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(targetPath), tempPrefix+"*")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create temporary file")
+	}
+
+	return &atomicWriter{
+		tmp:        tmp,
+		targetPath: targetPath,
+	}, nil
+}
+
+func (a *atomicWriter) Write(p []byte) (int, error) {
+	return a.tmp.Write(p)
+}
+
+// Close syncs the temporary file and renames it into place.
+func (a *atomicWriter) Close() error {
+	// Removing after a successful rename is a no-op
+	defer os.Remove(a.tmp.Name())
+
+	err := a.tmp.Sync()
+	if err != nil {
+		_ = a.tmp.Close()
+		return errors.Wrap(err, "failed to sync temporary file")
+	}
+
+	err = a.tmp.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to close temporary file")
+	}
+
+	err = os.Chmod(a.tmp.Name(), 0644)
+	if err != nil {
+		return errors.Wrap(err, "failed to set permissions")
+	}
+
+	err = os.Rename(a.tmp.Name(), a.targetPath)
+	if err != nil {
+		return errors.Wrap(err, "failed to rename temporary file")
+	}
+
+	return nil
+}
+
+// Abort removes the temporary file.
+func (a *atomicWriter) Abort() error {
+	_ = a.tmp.Close()
+	return os.Remove(a.tmp.Name())
+}
+
+// writeAtomic writes the contents of r to the given path.
+func writeAtomic(targetPath string, r io.Reader) error {
+	w, err := newAtomicWriter(targetPath)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, r)
+	if err != nil {
+		_ = w.Abort()
+		return errors.Wrap(err, "failed to write temporary file")
+	}
+
+	return w.Close()
+}
+
+// metadataPath returns the path of the metadata file of the object at
+// objectPath.
+func metadataPath(objectPath string) string {
+	return filepath.Join(filepath.Dir(objectPath), metadataPrefix+filepath.Base(objectPath))
+}
+
+// writeMetadata replaces the metadata of the object at objectPath.
+// Without metadata, the metadata file is removed.
+func writeMetadata(objectPath string, metadata map[string]string) error {
+	p := metadataPath(objectPath)
+	if len(metadata) == 0 {
+		err := os.Remove(p)
+		if err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "failed to remove metadata")
+		}
+		return nil
+	}
+
+	lower := make(map[string]string, len(metadata))
+	for key, value := range metadata {
+		lower[strings.ToLower(key)] = value
+	}
+	data, err := json.Marshal(lower)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal metadata")
+	}
+
+	return writeAtomic(p, bytes.NewReader(data))
+}
+
+// fileWriter replaces the metadata of the object once it's written.
+// The object and its metadata are renamed into place one after the other,
+// so a concurrent reader may briefly see the new object with the old
+// metadata.
+type fileWriter struct {
+	*atomicWriter
+	metadata map[string]string
+}
+
+func (w *fileWriter) Close() error {
+	err := w.atomicWriter.Close()
+	if err != nil {
+		return err
+	}
+
+	return writeMetadata(w.targetPath, w.metadata)
+}
+
+func (f *File) open(object string) (*os.File, error) {
+	p, err := f.objectPath(object)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, errors.Wrap(err, "failed to open object")
+	}
+
+	return file, nil
+}
+
+// Download downloads a file from the storage backend to the given path.
+func (f *File) Download(object string, toPath string) error {
+	file, err := f.open(object)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	out, err := os.OpenFile(toPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return errors.Wrap(err, "failed to open file")
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, file)
+	if err != nil {
+		return errors.Wrap(err, "failed to copy object")
+	}
+
+	return nil
+}
+
+// Get returns the contents of a file from the storage backend.
+func (f *File) Get(object string) ([]byte, error) {
+	file, err := f.open(object)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return io.ReadAll(file)
+}
+
+// Open opens a file from the storage backend for streaming reads.
+func (f *File) Open(object string) (io.ReadCloser, int64, error) {
+	file, err := f.open(object)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, 0, errors.Wrap(err, "failed to stat object")
+	}
+
+	return file, info.Size(), nil
+}
+
+// Create opens a file in the storage backend for streaming writes.
+// The object is written atomically once the writer is closed.
+func (f *File) Create(object string) (storage.Writer, error) {
+	return f.CreateWithMetadata(object, nil)
+}
+
+// CreateWithMetadata opens a file in the storage backend for streaming
+// writes, and stores the metadata with it once the writer is closed.
+func (f *File) CreateWithMetadata(object string, metadata map[string]string) (storage.Writer, error) {
+	p, err := f.objectPath(object)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := newAtomicWriter(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileWriter{
+		atomicWriter: w,
+		metadata:     metadata,
+	}, nil
+}
+
+// GetMetadata returns the metadata of an object.
+func (f *File) GetMetadata(object string) (map[string]string, error) {
+	p, err := f.objectPath(object)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = os.Stat(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, errors.Wrap(err, "failed to stat object")
+	}
+
+	metadata := map[string]string{}
+	data, err := os.ReadFile(metadataPath(p))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return metadata, nil
+		}
+		return nil, errors.Wrap(err, "failed to read metadata")
+	}
+
+	err = json.Unmarshal(data, &metadata)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse metadata")
+	}
+
+	return metadata, nil
+}
+
+// SetMetadata replaces the metadata of an object.
+func (f *File) SetMetadata(object string, metadata map[string]string) error {
+	p, err := f.objectPath(object)
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Stat(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return storage.ErrNotFound
+		}
+		return errors.Wrap(err, "failed to stat object")
+	}
+
+	return writeMetadata(p, metadata)
+}
+
+// Put uploads a file to the storage backend.
+func (f *File) Put(object string, fromPath string) (*storage.UploadInfo, error) {
+	return f.PutWithMetadata(object, fromPath, nil)
+}
+
+// PutWithMetadata uploads a file to the storage backend, and stores the
+// metadata with it.
+func (f *File) PutWithMetadata(object string, fromPath string, metadata map[string]string) (*storage.UploadInfo, error) {
+	p, err := f.objectPath(object)
+	if err != nil {
+		return nil, err
+	}
+
+	in, err := os.Open(fromPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open file")
+	}
+	defer in.Close()
+
+	err = writeAtomic(p, in)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeMetadata(p, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := f.location(object)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storage.UploadInfo{
+		Location:  location,
+		VersionID: nil,
+	}, nil
+}
+
+// PutBytes uploads a file to the storage backend.
+func (f *File) PutBytes(object string, data []byte) (*storage.UploadInfo, error) {
+	return f.PutBytesWithMetadata(object, data, nil)
+}
+
+// PutBytesWithMetadata uploads a file to the storage backend, and stores
+// the metadata with it.
+func (f *File) PutBytesWithMetadata(object string, data []byte, metadata map[string]string) (*storage.UploadInfo, error) {
+	p, err := f.objectPath(object)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeAtomic(p, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeMetadata(p, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := f.location(object)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storage.UploadInfo{
+		Location:  location,
+		VersionID: nil,
+	}, nil
+}
+
+// Delete deletes a file from the storage backend.
+func (f *File) Delete(object string) error {
+	p, err := f.objectPath(object)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return storage.ErrNotFound
+		}
+		return errors.Wrap(err, "failed to delete object")
+	}
+
+	return writeMetadata(p, nil)
+}
+
+// Exists checks if a file exists in the storage backend.
+func (f *File) Exists(object string) (bool, error) {
+	p, err := f.objectPath(object)
+	if err != nil {
+		return false, err
+	}
+
+	info, err := os.Stat(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "failed to stat object")
+	}
+
+	return !info.IsDir(), nil
+}
+
+// List returns all objects whose name starts with the given prefix.
+func (f *File) List(prefix string) ([]*storage.ObjectInfo, error) {
+	var objects []*storage.ObjectInfo
+	err := filepath.WalkDir(f.root, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || isReserved(d.Name()) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(f.root, p)
+		if err != nil {
+			return err
+		}
+		object, ok := unshard(filepath.ToSlash(rel))
+		if !ok || !strings.HasPrefix(object, prefix) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			// Deleted while walking
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		objects = append(objects, &storage.ObjectInfo{
+			Name:    object,
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list objects")
+	}
+
+	return objects, nil
+}
+
+// CanReadURI checks if a URI can be read by the storage backend.
+// Only file:// URIs pointing inside the root directory can be read.
+func (f *File) CanReadURI(uri string) (bool, error) {
+	_, err := f.ObjectFromURI(uri)
+	if err != nil {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// ObjectFromURI returns the object name for a file:// URI.
+// For example file:///var/lib/mship/blobs/abcdef with the root
+// /var/lib/mship/blobs returns abcdef.
+func (f *File) ObjectFromURI(uri string) (string, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse URI")
+	}
+
+	if parsed.Scheme != "file" {
+		return "", errors.Errorf("unsupported scheme %s", parsed.Scheme)
+	}
+
+	if parsed.Host != "" && parsed.Host != "localhost" {
+		return "", errors.Errorf("unsupported host %s", parsed.Host)
+	}
+
+	root := filepath.ToSlash(f.root)
+	p := path.Clean(parsed.Path)
+	if !strings.HasPrefix(p, strings.TrimSuffix(root, "/")+"/") {
+		return "", errors.Errorf("%s is not inside %s", p, root)
+	}
+
+	return cleanObject(strings.TrimPrefix(p, root))
+}
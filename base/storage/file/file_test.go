@@ -0,0 +1,170 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage_file
+
+import (
+	"github.com/openela/mothership/base/storage/storagetest"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestConformance(t *testing.T) {
+	f, err := New(t.TempDir())
+	require.Nil(t, err)
+	storagetest.Run(t, f)
+}
+
+func TestNew_Empty(t *testing.T) {
+	_, err := New("")
+	require.NotNil(t, err)
+}
+
+func TestPutBytes_Sharded(t *testing.T) {
+	root := t.TempDir()
+	f, err := New(root)
+	require.Nil(t, err)
+
+	_, err = f.PutBytes("abcdef", []byte("bar"))
+	require.Nil(t, err)
+	_, err = f.PutBytes("trash/abcdef", []byte("baz"))
+	require.Nil(t, err)
+
+	// Sharded by the SHA-256 hash of the name
+	data, err := os.ReadFile(filepath.Join(root, "be", "f5", "abcdef"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("bar"), data)
+	data, err = os.ReadFile(filepath.Join(root, "15", "8d", "trash%2Fabcdef"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("baz"), data)
+}
+
+func TestPutBytes_NoTempFilesLeft(t *testing.T) {
+	root := t.TempDir()
+	f, err := New(root)
+	require.Nil(t, err)
+
+	_, err = f.PutBytes("abcdef", []byte("bar"))
+	require.Nil(t, err)
+
+	entries, err := os.ReadDir(filepath.Join(root, "be", "f5"))
+	require.Nil(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "abcdef", entries[0].Name())
+}
+
+func TestPutBytes_ShortName(t *testing.T) {
+	root := t.TempDir()
+	f, err := New(root)
+	require.Nil(t, err)
+
+	// Names that look like shard directories don't collide with them
+	objects := []string{"abcdef", "be", "be/f5", "be/f5/abcdef", "nested/ab", "a"}
+	for _, object := range objects {
+		_, err = f.PutBytes(object, []byte(object))
+		require.Nil(t, err)
+	}
+
+	for _, object := range objects {
+		data, err := f.Get(object)
+		require.Nil(t, err)
+		require.Equal(t, []byte(object), data)
+	}
+
+	listed, err := f.List("")
+	require.Nil(t, err)
+	var names []string
+	for _, object := range listed {
+		names = append(names, object.Name)
+	}
+	require.ElementsMatch(t, objects, names)
+}
+
+func TestPutBytes_Reserved(t *testing.T) {
+	f, err := New(t.TempDir())
+	require.Nil(t, err)
+
+	_, err = f.PutBytes(tempPrefix+"abcdef", []byte("bar"))
+	require.NotNil(t, err)
+	_, err = f.PutBytes(metadataPrefix+"abcdef", []byte("bar"))
+	require.NotNil(t, err)
+}
+
+func TestPutBytes_EscapeRoot(t *testing.T) {
+	root := t.TempDir()
+	f, err := New(filepath.Join(root, "blobs"))
+	require.Nil(t, err)
+
+	_, err = f.PutBytes("../../escaped", []byte("bar"))
+	require.Nil(t, err)
+
+	_, err = os.Stat(filepath.Join(root, "escaped"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestPutBytes_Location(t *testing.T) {
+	root := t.TempDir()
+	f, err := New(root)
+	require.Nil(t, err)
+
+	info, err := f.PutBytes("abcdef", []byte("bar"))
+	require.Nil(t, err)
+	require.Equal(t, "file://"+filepath.ToSlash(root)+"/abcdef", info.Location)
+}
+
+func TestCanReadURI(t *testing.T) {
+	root := filepath.ToSlash(t.TempDir())
+	f, err := New(root + "/blobs")
+	require.Nil(t, err)
+
+	ok, err := f.CanReadURI("file://" + root + "/blobs/abcdef")
+	require.Nil(t, err)
+	require.True(t, ok)
+
+	ok, err = f.CanReadURI("file://" + root + "/other/abcdef")
+	require.Nil(t, err)
+	require.False(t, ok)
+
+	ok, err = f.CanReadURI("file://" + root + "/blobs/../other/abcdef")
+	require.Nil(t, err)
+	require.False(t, ok)
+
+	ok, err = f.CanReadURI("file://remote-host" + root + "/blobs/abcdef")
+	require.Nil(t, err)
+	require.False(t, ok)
+
+	ok, err = f.CanReadURI("s3://mship/abcdef")
+	require.Nil(t, err)
+	require.False(t, ok)
+}
+
+func TestObjectFromURI(t *testing.T) {
+	root := t.TempDir()
+	f, err := New(root)
+	require.Nil(t, err)
+
+	object, err := f.ObjectFromURI("file://" + filepath.ToSlash(root) + "/nested/abcdef")
+	require.Nil(t, err)
+	require.Equal(t, "nested/abcdef", object)
+}
+
+func TestList_SkipsForeignFiles(t *testing.T) {
+	root := t.TempDir()
+	f, err := New(root)
+	require.Nil(t, err)
+
+	_, err = f.PutBytes("trash/abcdef", []byte("bar"))
+	require.Nil(t, err)
+	require.Nil(t, os.WriteFile(filepath.Join(root, "stray-file"), []byte("x"), 0644))
+	require.Nil(t, os.WriteFile(filepath.Join(root, "15", "8d", tempPrefix+"123"), []byte("x"), 0644))
+	// Not in the shard directory of its name
+	require.Nil(t, os.WriteFile(filepath.Join(root, "15", "8d", "abcdef"), []byte("x"), 0644))
+
+	objects, err := f.List("")
+	require.Nil(t, err)
+	require.Len(t, objects, 1)
+	require.Equal(t, "trash/abcdef", objects[0].Name)
+	require.Equal(t, int64(3), objects[0].Size)
+}
@@ -4,13 +4,16 @@
 package storage_memory
 
 import (
+	"bytes"
 	"github.com/go-git/go-billy/v5"
 	"github.com/openela/mothership/base/storage"
 	"github.com/pkg/errors"
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 )
 
 type InMemory struct {
@@ -19,6 +22,8 @@ type InMemory struct {
 	rootPath string
 	fs       billy.Filesystem
 	blobs    map[string][]byte
+	modTimes map[string]time.Time
+	metadata map[string]map[string]string
 }
 
 // New creates a new InMemory storage.
@@ -29,8 +34,10 @@ func New(fs billy.Filesystem, rootPath ...string) *InMemory {
 	}
 
 	inm := &InMemory{
-		fs:    fs,
-		blobs: make(map[string][]byte),
+		fs:       fs,
+		blobs:    make(map[string][]byte),
+		modTimes: make(map[string]time.Time),
+		metadata: make(map[string]map[string]string),
 	}
 	if len(rootPath) == 1 {
 		inm.rootPath = rootPath[0]
@@ -38,6 +45,26 @@ func New(fs billy.Filesystem, rootPath ...string) *InMemory {
 	return inm
 }
 
+// setBlob stores a blob, replacing the blob and metadata of the object.
+func (im *InMemory) setBlob(object string, blob []byte, metadata map[string]string) {
+	im.blobs[object] = blob
+	im.modTimes[object] = time.Now()
+	im.setMetadata(object, metadata)
+}
+
+func (im *InMemory) setMetadata(object string, metadata map[string]string) {
+	if len(metadata) == 0 {
+		delete(im.metadata, object)
+		return
+	}
+
+	copied := make(map[string]string, len(metadata))
+	for key, value := range metadata {
+		copied[strings.ToLower(key)] = value
+	}
+	im.metadata[object] = copied
+}
+
 func (im *InMemory) getBlob(object string) ([]byte, error) {
 	blob, ok := im.blobs[object]
 	if !ok {
@@ -58,7 +85,7 @@ func (im *InMemory) getBlob(object string) ([]byte, error) {
 		}
 
 		// Store blob
-		im.blobs[object] = blob
+		im.setBlob(object, blob, nil)
 
 		return blob, nil
 	}
@@ -95,6 +122,10 @@ func (im *InMemory) Get(object string) ([]byte, error) {
 }
 
 func (im *InMemory) Put(object string, fromPath string) (*storage.UploadInfo, error) {
+	return im.PutWithMetadata(object, fromPath, nil)
+}
+
+func (im *InMemory) PutWithMetadata(object string, fromPath string, metadata map[string]string) (*storage.UploadInfo, error) {
 	// Open file
 	f, err := im.fs.Open(fromPath)
 	if err != nil {
@@ -108,7 +139,7 @@ func (im *InMemory) Put(object string, fromPath string) (*storage.UploadInfo, er
 	}
 
 	// Store blob
-	im.blobs[object] = blob
+	im.setBlob(object, blob, metadata)
 
 	return &storage.UploadInfo{
 		Location:  "memory://" + object,
@@ -117,8 +148,12 @@ func (im *InMemory) Put(object string, fromPath string) (*storage.UploadInfo, er
 }
 
 func (im *InMemory) PutBytes(object string, blob []byte) (*storage.UploadInfo, error) {
+	return im.PutBytesWithMetadata(object, blob, nil)
+}
+
+func (im *InMemory) PutBytesWithMetadata(object string, blob []byte, metadata map[string]string) (*storage.UploadInfo, error) {
 	// Store blob
-	im.blobs[object] = blob
+	im.setBlob(object, blob, metadata)
 
 	return &storage.UploadInfo{
 		Location:  "memory://" + object,
@@ -126,8 +161,80 @@ func (im *InMemory) PutBytes(object string, blob []byte) (*storage.UploadInfo, e
 	}, nil
 }
 
+func (im *InMemory) Open(object string) (io.ReadCloser, int64, error) {
+	blob, err := im.getBlob(object)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return io.NopCloser(bytes.NewReader(blob)), int64(len(blob)), nil
+}
+
+// inMemoryWriter buffers writes and stores the blob on Close.
+type inMemoryWriter struct {
+	im       *InMemory
+	object   string
+	metadata map[string]string
+	buf      bytes.Buffer
+}
+
+func (w *inMemoryWriter) Write(p []byte) (int, error) {
+	return w.buf.Write(p)
+}
+
+func (w *inMemoryWriter) Close() error {
+	w.im.setBlob(w.object, w.buf.Bytes(), w.metadata)
+	return nil
+}
+
+func (w *inMemoryWriter) Abort() error {
+	w.buf.Reset()
+	return nil
+}
+
+func (im *InMemory) Create(object string) (storage.Writer, error) {
+	return im.CreateWithMetadata(object, nil)
+}
+
+func (im *InMemory) CreateWithMetadata(object string, metadata map[string]string) (storage.Writer, error) {
+	return &inMemoryWriter{
+		im:       im,
+		object:   object,
+		metadata: metadata,
+	}, nil
+}
+
+func (im *InMemory) GetMetadata(object string) (map[string]string, error) {
+	_, err := im.getBlob(object)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := map[string]string{}
+	for key, value := range im.metadata[object] {
+		metadata[key] = value
+	}
+
+	return metadata, nil
+}
+
+func (im *InMemory) SetMetadata(object string, metadata map[string]string) error {
+	_, err := im.getBlob(object)
+	if err != nil {
+		return err
+	}
+
+	im.setMetadata(object, metadata)
+	return nil
+}
+
 func (im *InMemory) Delete(object string) error {
+	if _, ok := im.blobs[object]; !ok {
+		return storage.ErrNotFound
+	}
 	delete(im.blobs, object)
+	delete(im.modTimes, object)
+	delete(im.metadata, object)
 	return nil
 }
 
@@ -145,3 +252,25 @@ func (im *InMemory) Exists(object string) (bool, error) {
 func (im *InMemory) CanReadURI(uri string) (bool, error) {
 	return strings.HasPrefix(uri, "memory://"), nil
 }
+
+func (im *InMemory) List(prefix string) ([]*storage.ObjectInfo, error) {
+	var objects []*storage.ObjectInfo
+	for object, blob := range im.blobs {
+		if !strings.HasPrefix(object, prefix) {
+			continue
+		}
+
+		objects = append(objects, &storage.ObjectInfo{
+			Name:    object,
+			Size:    int64(len(blob)),
+			ModTime: im.modTimes[object],
+		})
+	}
+
+	// Map iteration order is random
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].Name < objects[j].Name
+	})
+
+	return objects, nil
+}
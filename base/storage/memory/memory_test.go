@@ -5,7 +5,9 @@ package storage_memory
 
 import (
 	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/osfs"
 	"github.com/openela/mothership/base/storage"
+	"github.com/openela/mothership/base/storage/storagetest"
 	"github.com/stretchr/testify/require"
 	"os"
 	"testing"
@@ -186,3 +188,14 @@ func TestInMemory_CanReadURI_No(t *testing.T) {
 	require.Nil(t, err)
 	require.False(t, ok)
 }
+
+func TestInMemory_Delete_NotFound(t *testing.T) {
+	fs := memfs.New()
+	im := New(fs)
+	err := im.Delete("foo")
+	require.Equal(t, storage.ErrNotFound, err)
+}
+
+func TestConformance(t *testing.T) {
+	storagetest.Run(t, New(osfs.New("/"), t.TempDir()))
+}
@@ -0,0 +1,579 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage_replicated
+
+import (
+	"github.com/openela/mothership/base/storage"
+	"github.com/pkg/errors"
+	"io"
+	"os"
+	"sort"
+	"sync"
+)
+
+// Replicated is an implementation of the Storage interface that writes
+// every object to multiple backends.
+// Writes succeed once at least quorum replicas accepted the object, reads
+// fall back to the next replica in order if a replica is missing the
+// object or unavailable.
+// Replicas that missed a write are fixed up by Repair.
+type Replicated struct {
+	storage.Storage
+
+	replicas []storage.Storage
+	quorum   int
+}
+
+// New creates a new replicated storage backend.
+// The first replica is the primary, its locations are returned from uploads
+// and it's the first one read from.
+// A quorum of 0 requires all replicas to accept a write.
+func New(quorum int, replicas ...storage.Storage) (*Replicated, error) {
+	if len(replicas) == 0 {
+		return nil, errors.New("at least one replica is required")
+	}
+	if quorum == 0 {
+		quorum = len(replicas)
+	}
+	if quorum < 1 || quorum > len(replicas) {
+		return nil, errors.Errorf("quorum must be between 1 and %d", len(replicas))
+	}
+
+	return &Replicated{
+		replicas: replicas,
+		quorum:   quorum,
+	}, nil
+}
+
+// Find returns the replicated backend behind s.
+// Decorators such as encrypted storage expose the backend they wrap with an
+// Unwrap method.
+func Find(s storage.Storage) (*Replicated, bool) {
+	for {
+		switch v := s.(type) {
+		case *Replicated:
+			return v, true
+		case interface{ Unwrap() storage.Storage }:
+			s = v.Unwrap()
+		default:
+			return nil, false
+		}
+	}
+}
+
+// Replicas returns the member backends in read order.
+func (r *Replicated) Replicas() []storage.Storage {
+	return r.replicas
+}
+
+// writeAll runs fn against every replica concurrently.
+// Returns the upload info of the first replica that succeeded, preferring
+// the primary, or an error if less than quorum replicas succeeded.
+func (r *Replicated) writeAll(object string, fn func(storage.Storage) (*storage.UploadInfo, error)) (*storage.UploadInfo, error) {
+	infos := make([]*storage.UploadInfo, len(r.replicas))
+	errs := make([]error, len(r.replicas))
+
+	var wg sync.WaitGroup
+	for i, replica := range r.replicas {
+		wg.Add(1)
+		go func(i int, replica storage.Storage) {
+			defer wg.Done()
+			infos[i], errs[i] = fn(replica)
+		}(i, replica)
+	}
+	wg.Wait()
+
+	return r.quorumResult(object, infos, errs)
+}
+
+// quorumResult checks that enough replicas succeeded.
+func (r *Replicated) quorumResult(object string, infos []*storage.UploadInfo, errs []error) (*storage.UploadInfo, error) {
+	var info *storage.UploadInfo
+	var firstErr error
+	succeeded := 0
+	for i, err := range errs {
+		if err != nil {
+			if firstErr == nil {
+				firstErr = errors.Wrapf(err, "replica %d", i)
+			}
+			continue
+		}
+		succeeded++
+		if info == nil {
+			info = infos[i]
+		}
+	}
+
+	if succeeded < r.quorum {
+		return nil, errors.Wrapf(firstErr, "failed to write %s to %d of %d replicas", object, r.quorum, len(r.replicas))
+	}
+
+	return info, nil
+}
+
+// Download downloads a file from the first replica that has it.
+func (r *Replicated) Download(object string, toPath string) error {
+	var lastErr error = storage.ErrNotFound
+	for _, replica := range r.replicas {
+		err := replica.Download(object, toPath)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, storage.ErrNotFound) {
+			lastErr = err
+		}
+	}
+
+	// Don't leave a partial or empty file behind
+	_ = os.Remove(toPath)
+
+	return lastErr
+}
+
+// Get returns the contents of a file from the first replica that has it.
+func (r *Replicated) Get(object string) ([]byte, error) {
+	var lastErr error = storage.ErrNotFound
+	for _, replica := range r.replicas {
+		data, err := replica.Get(object)
+		if err == nil {
+			return data, nil
+		}
+		if !errors.Is(err, storage.ErrNotFound) {
+			lastErr = err
+		}
+	}
+
+	return nil, lastErr
+}
+
+// Open opens a file from the first replica that has it.
+func (r *Replicated) Open(object string) (io.ReadCloser, int64, error) {
+	var lastErr error = storage.ErrNotFound
+	for _, replica := range r.replicas {
+		rc, size, err := replica.Open(object)
+		if err == nil {
+			return rc, size, nil
+		}
+		if !errors.Is(err, storage.ErrNotFound) {
+			lastErr = err
+		}
+	}
+
+	return nil, 0, lastErr
+}
+
+// Put uploads a file to all replicas.
+func (r *Replicated) Put(object string, fromPath string) (*storage.UploadInfo, error) {
+	return r.writeAll(object, func(replica storage.Storage) (*storage.UploadInfo, error) {
+		return replica.Put(object, fromPath)
+	})
+}
+
+// PutBytes uploads a file to all replicas.
+func (r *Replicated) PutBytes(object string, data []byte) (*storage.UploadInfo, error) {
+	return r.writeAll(object, func(replica storage.Storage) (*storage.UploadInfo, error) {
+		return replica.PutBytes(object, data)
+	})
+}
+
+// PutWithMetadata uploads a file with metadata to all replicas.
+// Every replica has to support metadata.
+func (r *Replicated) PutWithMetadata(object string, fromPath string, metadata map[string]string) (*storage.UploadInfo, error) {
+	return r.writeAll(object, func(replica storage.Storage) (*storage.UploadInfo, error) {
+		metadataReplica, ok := replica.(storage.MetadataStorage)
+		if !ok {
+			return nil, errMetadataUnsupported
+		}
+		return metadataReplica.PutWithMetadata(object, fromPath, metadata)
+	})
+}
+
+// PutBytesWithMetadata uploads a file with metadata to all replicas.
+// Every replica has to support metadata.
+func (r *Replicated) PutBytesWithMetadata(object string, data []byte, metadata map[string]string) (*storage.UploadInfo, error) {
+	return r.writeAll(object, func(replica storage.Storage) (*storage.UploadInfo, error) {
+		metadataReplica, ok := replica.(storage.MetadataStorage)
+		if !ok {
+			return nil, errMetadataUnsupported
+		}
+		return metadataReplica.PutBytesWithMetadata(object, data, metadata)
+	})
+}
+
+// replicatedWriter fans out writes to a writer per replica.
+// A replica that fails is aborted and dropped, the write only fails once
+// less than quorum replicas are left.
+type replicatedWriter struct {
+	r       *Replicated
+	object  string
+	writers []storage.Writer
+	errs    []error
+}
+
+func (w *replicatedWriter) live() int {
+	n := 0
+	for _, err := range w.errs {
+		if err == nil {
+			n++
+		}
+	}
+	return n
+}
+
+func (w *replicatedWriter) Write(p []byte) (int, error) {
+	for i, writer := range w.writers {
+		if w.errs[i] != nil {
+			continue
+		}
+		_, err := writer.Write(p)
+		if err != nil {
+			w.errs[i] = err
+			_ = writer.Abort()
+		}
+	}
+
+	if w.live() < w.r.quorum {
+		_, err := w.r.quorumResult(w.object, make([]*storage.UploadInfo, len(w.errs)), w.errs)
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Close commits the object on every replica that is still healthy.
+func (w *replicatedWriter) Close() error {
+	for i, writer := range w.writers {
+		if w.errs[i] != nil {
+			continue
+		}
+		w.errs[i] = writer.Close()
+	}
+
+	_, err := w.r.quorumResult(w.object, make([]*storage.UploadInfo, len(w.errs)), w.errs)
+	return err
+}
+
+// Abort discards the object on every replica.
+func (w *replicatedWriter) Abort() error {
+	var firstErr error
+	for i, writer := range w.writers {
+		if w.errs[i] != nil {
+			continue
+		}
+		err := writer.Abort()
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// Create opens the object on all replicas for streaming writes.
+func (r *Replicated) Create(object string) (storage.Writer, error) {
+	return r.create(object, func(replica storage.Storage) (storage.Writer, error) {
+		return replica.Create(object)
+	})
+}
+
+// CreateWithMetadata opens the object on all replicas for streaming writes,
+// and stores the metadata with it on every replica.
+// Every replica has to support metadata.
+func (r *Replicated) CreateWithMetadata(object string, metadata map[string]string) (storage.Writer, error) {
+	return r.create(object, func(replica storage.Storage) (storage.Writer, error) {
+		metadataReplica, ok := replica.(storage.MetadataStorage)
+		if !ok {
+			return nil, errMetadataUnsupported
+		}
+		return metadataReplica.CreateWithMetadata(object, metadata)
+	})
+}
+
+func (r *Replicated) create(object string, fn func(storage.Storage) (storage.Writer, error)) (storage.Writer, error) {
+	w := &replicatedWriter{
+		r:       r,
+		object:  object,
+		writers: make([]storage.Writer, len(r.replicas)),
+		errs:    make([]error, len(r.replicas)),
+	}
+	for i, replica := range r.replicas {
+		w.writers[i], w.errs[i] = fn(replica)
+	}
+
+	if w.live() < r.quorum {
+		_ = w.Abort()
+		_, err := r.quorumResult(object, make([]*storage.UploadInfo, len(w.errs)), w.errs)
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// errMetadataUnsupported is returned for metadata operations on replicas
+// that can't store metadata.
+var errMetadataUnsupported = errors.New("replica does not support metadata")
+
+// GetMetadata returns the metadata of an object from the first replica that
+// has it.
+func (r *Replicated) GetMetadata(object string) (map[string]string, error) {
+	var lastErr error = storage.ErrNotFound
+	for _, replica := range r.replicas {
+		metadataReplica, ok := replica.(storage.MetadataStorage)
+		if !ok {
+			return nil, errMetadataUnsupported
+		}
+
+		metadata, err := metadataReplica.GetMetadata(object)
+		if err == nil {
+			return metadata, nil
+		}
+		if !errors.Is(err, storage.ErrNotFound) {
+			lastErr = err
+		}
+	}
+
+	return nil, lastErr
+}
+
+// SetMetadata replaces the metadata of an object on every replica that has
+// it. Returns ErrNotFound if no replica has the object.
+func (r *Replicated) SetMetadata(object string, metadata map[string]string) error {
+	found := false
+	for i, replica := range r.replicas {
+		metadataReplica, ok := replica.(storage.MetadataStorage)
+		if !ok {
+			return errMetadataUnsupported
+		}
+
+		err := metadataReplica.SetMetadata(object, metadata)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				continue
+			}
+			return errors.Wrapf(err, "replica %d", i)
+		}
+		found = true
+	}
+
+	if !found {
+		return storage.ErrNotFound
+	}
+
+	return nil
+}
+
+// Delete deletes a file from all replicas.
+// Returns ErrNotFound if no replica had the file.
+func (r *Replicated) Delete(object string) error {
+	found := false
+	for i, replica := range r.replicas {
+		err := replica.Delete(object)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				continue
+			}
+			return errors.Wrapf(err, "replica %d", i)
+		}
+		found = true
+	}
+
+	if !found {
+		return storage.ErrNotFound
+	}
+
+	return nil
+}
+
+// Exists checks if a file exists in any replica.
+// Unavailable replicas are skipped as long as another replica has the file.
+func (r *Replicated) Exists(object string) (bool, error) {
+	var firstErr error
+	for i, replica := range r.replicas {
+		ok, err := replica.Exists(object)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = errors.Wrapf(err, "replica %d", i)
+			}
+			continue
+		}
+		if ok {
+			return true, nil
+		}
+	}
+
+	return false, firstErr
+}
+
+// ExistsPerReplica reports for every replica, in order, whether it has
+// the file.
+func (r *Replicated) ExistsPerReplica(object string) ([]bool, error) {
+	status := make([]bool, len(r.replicas))
+	for i, replica := range r.replicas {
+		ok, err := replica.Exists(object)
+		if err != nil {
+			return nil, errors.Wrapf(err, "replica %d", i)
+		}
+		status[i] = ok
+	}
+
+	return status, nil
+}
+
+// List returns all objects whose name starts with the given prefix in any
+// replica.
+// If replicas disagree, the most recently written object wins.
+func (r *Replicated) List(prefix string) ([]*storage.ObjectInfo, error) {
+	seen := map[string]*storage.ObjectInfo{}
+	for i, replica := range r.replicas {
+		objects, err := replica.List(prefix)
+		if err != nil {
+			return nil, errors.Wrapf(err, "replica %d", i)
+		}
+		for _, obj := range objects {
+			existing := seen[obj.Name]
+			if existing == nil || obj.ModTime.After(existing.ModTime) {
+				seen[obj.Name] = obj
+			}
+		}
+	}
+
+	objects := make([]*storage.ObjectInfo, 0, len(seen))
+	for _, obj := range seen {
+		objects = append(objects, obj)
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].Name < objects[j].Name
+	})
+
+	return objects, nil
+}
+
+// CanReadURI checks if a URI can be read by any replica.
+func (r *Replicated) CanReadURI(uri string) (bool, error) {
+	replica, err := r.replicaForURI(uri)
+	if err != nil {
+		return false, err
+	}
+
+	return replica != nil, nil
+}
+
+func (r *Replicated) replicaForURI(uri string) (storage.Storage, error) {
+	for _, replica := range r.replicas {
+		ok, err := replica.CanReadURI(uri)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return replica, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ObjectFromURI returns the object name for a URI of any replica.
+// Objects are stored under the same name in every replica, so the object
+// can be read through the replicated backend regardless of which replica
+// the URI came from.
+func (r *Replicated) ObjectFromURI(uri string) (string, error) {
+	replica, err := r.replicaForURI(uri)
+	if err != nil {
+		return "", err
+	}
+	if replica == nil {
+		return "", errors.Errorf("no replica can read %s", uri)
+	}
+
+	return storage.ObjectFromURI(replica, uri)
+}
+
+// RepairInfo is the result of repairing a single object.
+type RepairInfo struct {
+	// Repaired lists the replicas the object was copied to.
+	Repaired []int
+
+	// Size is the size of the object in bytes.
+	Size int64
+}
+
+// Repair copies the object from the first replica that has it to every
+// replica that is missing it.
+// Returns ErrNotFound if no replica has the object.
+func (r *Replicated) Repair(object string) (*RepairInfo, error) {
+	status, err := r.ExistsPerReplica(object)
+	if err != nil {
+		return nil, err
+	}
+
+	source := -1
+	var missing []int
+	for i, ok := range status {
+		if ok && source == -1 {
+			source = i
+		}
+		if !ok {
+			missing = append(missing, i)
+		}
+	}
+	if source == -1 {
+		return nil, storage.ErrNotFound
+	}
+
+	info := &RepairInfo{}
+	if len(missing) == 0 {
+		return info, nil
+	}
+
+	for _, i := range missing {
+		size, err := copyObject(r.replicas[source], r.replicas[i], object)
+		if err != nil {
+			return info, errors.Wrapf(err, "failed to copy from replica %d to replica %d", source, i)
+		}
+		info.Size = size
+		info.Repaired = append(info.Repaired, i)
+	}
+
+	return info, nil
+}
+
+// copyObject streams an object between two replicas, together with its
+// metadata if both replicas support metadata.
+// Returns the number of bytes copied.
+func copyObject(from storage.Storage, to storage.Storage, object string) (int64, error) {
+	var metadata map[string]string
+	fromMetadata, fromOk := from.(storage.MetadataStorage)
+	toMetadata, toOk := to.(storage.MetadataStorage)
+	if fromOk && toOk {
+		var err error
+		metadata, err = fromMetadata.GetMetadata(object)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	rc, _, err := from.Open(object)
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+
+	var w storage.Writer
+	if len(metadata) > 0 {
+		w, err = toMetadata.CreateWithMetadata(object, metadata)
+	} else {
+		w, err = to.Create(object)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := io.Copy(w, rc)
+	if err != nil {
+		_ = w.Abort()
+		return 0, err
+	}
+
+	return n, w.Close()
+}
@@ -0,0 +1,259 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage_replicated
+
+import (
+	"errors"
+	"github.com/openela/mothership/base/storage"
+	storage_file "github.com/openela/mothership/base/storage/file"
+	"github.com/openela/mothership/base/storage/storagetest"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// unavailable is a replica that fails every operation.
+type unavailable struct {
+	storage.Storage
+}
+
+var errUnavailable = errors.New("replica unavailable")
+
+func (unavailable) Get(string) ([]byte, error)            { return nil, errUnavailable }
+func (unavailable) Exists(string) (bool, error)           { return false, errUnavailable }
+func (unavailable) Create(string) (storage.Writer, error) { return nil, errUnavailable }
+func (unavailable) PutBytes(string, []byte) (*storage.UploadInfo, error) {
+	return nil, errUnavailable
+}
+
+func newFile(t *testing.T) *storage_file.File {
+	f, err := storage_file.New(t.TempDir())
+	require.Nil(t, err)
+	return f
+}
+
+func TestNew_NoReplicas(t *testing.T) {
+	_, err := New(0)
+	require.NotNil(t, err)
+}
+
+func TestNew_QuorumTooLarge(t *testing.T) {
+	_, err := New(3, newFile(t), newFile(t))
+	require.NotNil(t, err)
+}
+
+func TestConformance(t *testing.T) {
+	r, err := New(0, newFile(t), newFile(t))
+	require.Nil(t, err)
+	storagetest.Run(t, r)
+}
+
+func TestPutBytes_WritesAllReplicas(t *testing.T) {
+	a, b := newFile(t), newFile(t)
+	r, err := New(0, a, b)
+	require.Nil(t, err)
+
+	info, err := r.PutBytes("foobar", []byte("bar"))
+	require.Nil(t, err)
+
+	// The primary's location is returned
+	ok, err := a.CanReadURI(info.Location)
+	require.Nil(t, err)
+	require.True(t, ok)
+
+	status, err := r.ExistsPerReplica("foobar")
+	require.Nil(t, err)
+	require.Equal(t, []bool{true, true}, status)
+}
+
+func TestPutBytes_Quorum(t *testing.T) {
+	a := newFile(t)
+	r, err := New(1, unavailable{}, a)
+	require.Nil(t, err)
+
+	info, err := r.PutBytes("foobar", []byte("bar"))
+	require.Nil(t, err)
+	ok, err := a.CanReadURI(info.Location)
+	require.Nil(t, err)
+	require.True(t, ok)
+
+	data, err := r.Get("foobar")
+	require.Nil(t, err)
+	require.Equal(t, []byte("bar"), data)
+}
+
+func TestPutBytes_NoQuorum(t *testing.T) {
+	r, err := New(2, unavailable{}, newFile(t))
+	require.Nil(t, err)
+
+	_, err = r.PutBytes("foobar", []byte("bar"))
+	require.True(t, errors.Is(err, errUnavailable))
+}
+
+func TestCreate_Quorum(t *testing.T) {
+	r, err := New(1, unavailable{}, newFile(t))
+	require.Nil(t, err)
+
+	w, err := r.Create("foobar")
+	require.Nil(t, err)
+	_, err = w.Write([]byte("bar"))
+	require.Nil(t, err)
+	require.Nil(t, w.Close())
+
+	ok, err := r.Exists("foobar")
+	require.Nil(t, err)
+	require.True(t, ok)
+}
+
+func TestCreate_NoQuorum(t *testing.T) {
+	r, err := New(0, unavailable{}, newFile(t))
+	require.Nil(t, err)
+
+	_, err = r.Create("foobar")
+	require.True(t, errors.Is(err, errUnavailable))
+}
+
+func TestGet_FallsBack(t *testing.T) {
+	a, b := newFile(t), newFile(t)
+	_, err := b.PutBytes("foobar", []byte("bar"))
+	require.Nil(t, err)
+
+	r, err := New(0, unavailable{}, a, b)
+	require.Nil(t, err)
+
+	data, err := r.Get("foobar")
+	require.Nil(t, err)
+	require.Equal(t, []byte("bar"), data)
+}
+
+func TestGet_NotFound(t *testing.T) {
+	r, err := New(0, newFile(t), newFile(t))
+	require.Nil(t, err)
+
+	_, err = r.Get("foobar")
+	require.True(t, errors.Is(err, storage.ErrNotFound))
+}
+
+func TestGet_Unavailable(t *testing.T) {
+	r, err := New(0, unavailable{}, newFile(t))
+	require.Nil(t, err)
+
+	// A replica failing is not the same as the object not existing
+	_, err = r.Get("foobar")
+	require.True(t, errors.Is(err, errUnavailable))
+}
+
+func TestExists_SkipsUnavailable(t *testing.T) {
+	a := newFile(t)
+	_, err := a.PutBytes("foobar", []byte("bar"))
+	require.Nil(t, err)
+
+	r, err := New(0, unavailable{}, a)
+	require.Nil(t, err)
+
+	ok, err := r.Exists("foobar")
+	require.Nil(t, err)
+	require.True(t, ok)
+
+	_, err = r.Exists("barbaz")
+	require.True(t, errors.Is(err, errUnavailable))
+}
+
+func TestExistsPerReplica(t *testing.T) {
+	a, b := newFile(t), newFile(t)
+	_, err := b.PutBytes("foobar", []byte("bar"))
+	require.Nil(t, err)
+
+	r, err := New(0, a, b)
+	require.Nil(t, err)
+
+	status, err := r.ExistsPerReplica("foobar")
+	require.Nil(t, err)
+	require.Equal(t, []bool{false, true}, status)
+}
+
+func TestObjectFromURI_SecondaryReplica(t *testing.T) {
+	a, b := newFile(t), newFile(t)
+	info, err := b.PutBytes("foobar", []byte("bar"))
+	require.Nil(t, err)
+
+	r, err := New(0, a, b)
+	require.Nil(t, err)
+
+	object, err := r.ObjectFromURI(info.Location)
+	require.Nil(t, err)
+	require.Equal(t, "foobar", object)
+}
+
+func TestRepair(t *testing.T) {
+	a, b, c := newFile(t), newFile(t), newFile(t)
+	_, err := b.PutBytes("foobar", []byte("bar"))
+	require.Nil(t, err)
+
+	r, err := New(0, a, b, c)
+	require.Nil(t, err)
+
+	info, err := r.Repair("foobar")
+	require.Nil(t, err)
+	require.Equal(t, []int{0, 2}, info.Repaired)
+	require.Equal(t, int64(3), info.Size)
+
+	status, err := r.ExistsPerReplica("foobar")
+	require.Nil(t, err)
+	require.Equal(t, []bool{true, true, true}, status)
+
+	data, err := c.Get("foobar")
+	require.Nil(t, err)
+	require.Equal(t, []byte("bar"), data)
+
+	info, err = r.Repair("foobar")
+	require.Nil(t, err)
+	require.Empty(t, info.Repaired)
+}
+
+func TestRepair_Metadata(t *testing.T) {
+	a, b := newFile(t), newFile(t)
+	metadata := map[string]string{"mship-key-id": "k1"}
+	_, err := b.PutBytesWithMetadata("foobar", []byte("bar"), metadata)
+	require.Nil(t, err)
+
+	r, err := New(0, a, b)
+	require.Nil(t, err)
+
+	info, err := r.Repair("foobar")
+	require.Nil(t, err)
+	require.Equal(t, []int{0}, info.Repaired)
+
+	got, err := a.GetMetadata("foobar")
+	require.Nil(t, err)
+	require.Equal(t, metadata, got)
+}
+
+func TestRepair_NotFound(t *testing.T) {
+	r, err := New(0, newFile(t), newFile(t))
+	require.Nil(t, err)
+
+	_, err = r.Repair("foobar")
+	require.True(t, errors.Is(err, storage.ErrNotFound))
+}
+
+// wrapper is a decorator around another backend.
+type wrapper struct {
+	storage.Storage
+}
+
+func (w wrapper) Unwrap() storage.Storage {
+	return w.Storage
+}
+
+func TestFind(t *testing.T) {
+	r, err := New(0, newFile(t))
+	require.Nil(t, err)
+
+	found, ok := Find(wrapper{wrapper{r}})
+	require.True(t, ok)
+	require.Equal(t, r, found)
+
+	_, ok = Find(wrapper{newFile(t)})
+	require.False(t, ok)
+}
@@ -4,17 +4,30 @@
 package storage_s3
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	base "github.com/openela/mothership/base"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli/v2"
 	"log/slog"
 	"net/url"
+	"os"
+	"strconv"
 	"strings"
 )
 
 func FromFlags(ctx *cli.Context) (*S3, error) {
+	return FromURI(ctx, ctx.String("storage-connection-string"))
+}
+
+// FromURI creates a new S3 storage backend for the given s3:// URI.
+// The remaining storage flags are read from the context.
+// Query parameters of the URI take precedence over the flags, so every
+// backend of a replicated storage can use its own provider, for example
+// s3://bucket?region=eu-west-1&endpoint=https://s3.example.com.
+func FromURI(ctx *cli.Context, uri string) (*S3, error) {
 	// Parse the connection string
-	parsedURI, err := url.Parse(ctx.String("storage-connection-string"))
+	parsedURI, err := url.Parse(uri)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse storage connection string")
 	}
@@ -37,7 +50,64 @@ func FromFlags(ctx *cli.Context) (*S3, error) {
 		base.RareUseChangeDefault("AWS_S3_FORCE_PATH_STYLE", "true")
 	}
 
+	awsCfg, err := configFromQuery(parsedURI.Query())
+	if err != nil {
+		return nil, err
+	}
+
 	slog.Info("Using S3 bucket", "bucket", bucket)
 
-	return New(bucket)
+	return NewWithConfig(bucket, awsCfg)
+}
+
+// configFromQuery returns the AWS config for the query parameters of an
+// s3:// URI. Supported parameters are:
+//   - region: the region of the bucket
+//   - endpoint: the S3 endpoint, for S3 compatible providers
+//   - secure: false to connect without TLS
+//   - path-style: true to use path style addressing
+//   - access-key-id-env and secret-access-key-env: the names of the
+//     environment variables that hold the credentials, so they never end up
+//     in the connection string
+func configFromQuery(query url.Values) (*aws.Config, error) {
+	awsCfg := &aws.Config{}
+	var accessKeyIDEnv, secretAccessKeyEnv string
+	for key := range query {
+		value := query.Get(key)
+		switch key {
+		case "region":
+			awsCfg.Region = aws.String(value)
+		case "endpoint":
+			awsCfg.Endpoint = aws.String(value)
+		case "secure":
+			secure, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to parse secure")
+			}
+			awsCfg.DisableSSL = aws.Bool(!secure)
+		case "path-style":
+			pathStyle, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to parse path-style")
+			}
+			awsCfg.S3ForcePathStyle = aws.Bool(pathStyle)
+		case "access-key-id-env":
+			accessKeyIDEnv = value
+		case "secret-access-key-env":
+			secretAccessKeyEnv = value
+		default:
+			return nil, errors.Errorf("unknown S3 parameter %s", key)
+		}
+	}
+
+	if accessKeyIDEnv != "" || secretAccessKeyEnv != "" {
+		accessKeyID := os.Getenv(accessKeyIDEnv)
+		secretAccessKey := os.Getenv(secretAccessKeyEnv)
+		if accessKeyID == "" || secretAccessKey == "" {
+			return nil, errors.New("access-key-id-env and secret-access-key-env must both name non-empty environment variables")
+		}
+		awsCfg.Credentials = credentials.NewStaticCredentials(accessKeyID, secretAccessKey, "")
+	}
+
+	return awsCfg, nil
 }
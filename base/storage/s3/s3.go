@@ -5,6 +5,8 @@ package storage_s3
 
 import (
 	"bytes"
+	"encoding/base64"
+	"encoding/hex"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -12,9 +14,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/openela/mothership/base/awsutils"
 	"github.com/openela/mothership/base/storage"
+	"github.com/pkg/errors"
+	"io"
 	"net/url"
 	"os"
 	"strings"
+	"time"
 )
 
 // S3 is an implementation of the Storage interface for S3.
@@ -29,7 +34,13 @@ type S3 struct {
 // New creates a new S3 storage backend.
 // Supports AWS CLI related environment variables.
 func New(bucket string) (*S3, error) {
-	awsCfg := &aws.Config{}
+	return NewWithConfig(bucket, &aws.Config{})
+}
+
+// NewWithConfig creates a new S3 storage backend with the given AWS config.
+// Settings missing from the config are read from the AWS CLI related
+// environment variables.
+func NewWithConfig(bucket string, awsCfg *aws.Config) (*S3, error) {
 	awsutils.FillOutConfig(awsCfg)
 
 	sess, err := session.NewSession(awsCfg)
@@ -47,6 +58,13 @@ func New(bucket string) (*S3, error) {
 	}, nil
 }
 
+// location returns the s3:// URI of the object.
+// The URI is readable by CanReadURI, unlike the HTTP location returned
+// by the uploader.
+func (s *S3) location(object string) string {
+	return "s3://" + s.bucket + "/" + strings.TrimPrefix(object, "/")
+}
+
 // Download downloads a file from the storage backend to the given path.
 func (s *S3) Download(object string, toPath string) error {
 	f, err := os.OpenFile(toPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
@@ -91,6 +109,12 @@ func (s *S3) Get(object string) ([]byte, error) {
 
 // Put uploads a file to the storage backend.
 func (s *S3) Put(object string, fromPath string) (*storage.UploadInfo, error) {
+	return s.PutWithMetadata(object, fromPath, nil)
+}
+
+// PutWithMetadata uploads a file to the storage backend.
+// The metadata is stored as user metadata of the object.
+func (s *S3) PutWithMetadata(object string, fromPath string, metadata map[string]string) (*storage.UploadInfo, error) {
 	f, err := os.Open(fromPath)
 	if err != nil {
 		return nil, err
@@ -98,34 +122,239 @@ func (s *S3) Put(object string, fromPath string) (*storage.UploadInfo, error) {
 	defer f.Close()
 
 	result, err := s.uploader.Upload(&s3manager.UploadInput{
-		Bucket: aws.String(s.bucket),
-		Key:    aws.String(object),
-		Body:   f,
+		Bucket:   aws.String(s.bucket),
+		Key:      aws.String(object),
+		Body:     f,
+		Metadata: toAWSMetadata(metadata),
 	})
+	if err != nil {
+		return nil, err
+	}
 
 	return &storage.UploadInfo{
-		Location:  result.Location,
+		Location:  s.location(object),
 		VersionID: result.VersionID,
-	}, err
+	}, nil
 }
 
 // PutBytes uploads a file to the storage backend.
 func (s *S3) PutBytes(object string, data []byte) (*storage.UploadInfo, error) {
+	return s.PutBytesWithMetadata(object, data, nil)
+}
+
+// PutBytesWithMetadata uploads a file to the storage backend.
+// The metadata is stored as user metadata of the object.
+func (s *S3) PutBytesWithMetadata(object string, data []byte, metadata map[string]string) (*storage.UploadInfo, error) {
 	result, err := s.uploader.Upload(&s3manager.UploadInput{
-		Bucket: aws.String(s.bucket),
-		Key:    aws.String(object),
-		Body:   aws.ReadSeekCloser(bytes.NewBuffer(data)),
+		Bucket:   aws.String(s.bucket),
+		Key:      aws.String(object),
+		Body:     aws.ReadSeekCloser(bytes.NewBuffer(data)),
+		Metadata: toAWSMetadata(metadata),
 	})
+	if err != nil {
+		return nil, err
+	}
 
 	return &storage.UploadInfo{
-		Location:  result.Location,
+		Location:  s.location(object),
 		VersionID: result.VersionID,
-	}, err
+	}, nil
+}
+
+// Open opens a file from the storage backend for streaming reads.
+func (s *S3) Open(object string) (io.ReadCloser, int64, error) {
+	result, err := s.uploader.S3.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(object),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == s3.ErrCodeNoSuchKey {
+				return nil, 0, storage.ErrNotFound
+			}
+		}
+		return nil, 0, err
+	}
+
+	return result.Body, aws.Int64Value(result.ContentLength), nil
+}
+
+// errUploadAborted is used to fail the upload when the writer is aborted.
+var errUploadAborted = errors.New("upload aborted")
+
+// s3Writer streams writes through a pipe into the uploader.
+// The uploader switches to a multipart upload for large objects, so
+// the object never has to be buffered in full.
+type s3Writer struct {
+	pw   *io.PipeWriter
+	done chan error
+}
+
+func (w *s3Writer) Write(p []byte) (int, error) {
+	return w.pw.Write(p)
+}
+
+func (w *s3Writer) Close() error {
+	err := w.pw.Close()
+	if err != nil {
+		return err
+	}
+
+	return <-w.done
+}
+
+func (w *s3Writer) Abort() error {
+	_ = w.pw.CloseWithError(errUploadAborted)
+	// The upload is expected to fail now, and the uploader cleans up
+	// any multipart upload it started.
+	<-w.done
+	return nil
+}
+
+// Create opens a file in the storage backend for streaming writes.
+func (s *S3) Create(object string) (storage.Writer, error) {
+	return s.CreateWithMetadata(object, nil)
+}
+
+// CreateWithMetadata opens a file in the storage backend for streaming
+// writes. The metadata is stored as user metadata of the object.
+func (s *S3) CreateWithMetadata(object string, metadata map[string]string) (storage.Writer, error) {
+	pr, pw := io.Pipe()
+	w := &s3Writer{
+		pw:   pw,
+		done: make(chan error, 1),
+	}
+
+	go func() {
+		_, err := s.uploader.Upload(&s3manager.UploadInput{
+			Bucket:   aws.String(s.bucket),
+			Key:      aws.String(object),
+			Body:     pr,
+			Metadata: toAWSMetadata(metadata),
+		})
+		// Unblock any pending writes if the upload failed early
+		_ = pr.CloseWithError(err)
+		w.done <- err
+	}()
+
+	return w, nil
+}
+
+func toAWSMetadata(metadata map[string]string) map[string]*string {
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	awsMetadata := make(map[string]*string, len(metadata))
+	for key, value := range metadata {
+		awsMetadata[key] = aws.String(value)
+	}
+
+	return awsMetadata
+}
+
+// GetMetadata returns the user metadata of an object.
+// S3 canonicalizes metadata keys like HTTP headers, so they are lower cased
+// again.
+func (s *S3) GetMetadata(object string) (map[string]string, error) {
+	result, err := s.uploader.S3.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(object),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == s3.ErrCodeNoSuchKey || awsErr.Code() == "NotFound" {
+				return nil, storage.ErrNotFound
+			}
+		}
+		return nil, err
+	}
+
+	metadata := make(map[string]string, len(result.Metadata))
+	for key, value := range result.Metadata {
+		metadata[strings.ToLower(key)] = aws.StringValue(value)
+	}
+
+	return metadata, nil
+}
+
+// SetMetadata replaces the user metadata of an object by copying the object
+// onto itself, which S3 does without transferring the content.
+// Objects larger than 5 GiB can't be copied in a single request, so their
+// metadata can't be replaced.
+func (s *S3) SetMetadata(object string, metadata map[string]string) error {
+	_, err := s.uploader.S3.CopyObject(&s3.CopyObjectInput{
+		Bucket:            aws.String(s.bucket),
+		Key:               aws.String(object),
+		CopySource:        aws.String(url.PathEscape(s.bucket + "/" + object)),
+		Metadata:          toAWSMetadata(metadata),
+		MetadataDirective: aws.String(s3.MetadataDirectiveReplace),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == s3.ErrCodeNoSuchKey {
+				return storage.ErrNotFound
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+// PresignPut returns a presigned PUT request for the object.
+// The checksum is part of the signature, so S3 rejects uploads with
+// different content.
+func (s *S3) PresignPut(object string, checksumSha256 string, expires time.Duration) (*storage.PresignedRequest, error) {
+	sum, err := hex.DecodeString(checksumSha256)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode checksum")
+	}
+	checksum := base64.StdEncoding.EncodeToString(sum)
+
+	req, _ := s.uploader.S3.PutObjectRequest(&s3.PutObjectInput{
+		Bucket:         aws.String(s.bucket),
+		Key:            aws.String(object),
+		ChecksumSHA256: aws.String(checksum),
+	})
+	// Keep the checksum as a signed header instead of a query parameter,
+	// so the client has to send it and S3 verifies the content.
+	req.NotHoist = true
+	url, signedHeaders, err := req.PresignRequest(expires)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to presign request")
+	}
+
+	headers := map[string]string{}
+	for key, values := range signedHeaders {
+		// Host is set by the HTTP client
+		if strings.EqualFold(key, "host") {
+			continue
+		}
+		headers[key] = strings.Join(values, ",")
+	}
+
+	return &storage.PresignedRequest{
+		URL:      url,
+		Headers:  headers,
+		Location: s.location(object),
+		Expires:  time.Now().Add(expires),
+	}, nil
 }
 
 // Delete deletes a file from the storage backend.
 func (s *S3) Delete(object string) error {
-	_, err := s.uploader.S3.DeleteObject(&s3.DeleteObjectInput{
+	// DeleteObject succeeds for missing keys, so check first to match
+	// the ErrNotFound contract.
+	exists, err := s.Exists(object)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return storage.ErrNotFound
+	}
+
+	_, err = s.uploader.S3.DeleteObject(&s3.DeleteObjectInput{
 		Bucket: aws.String(s.bucket),
 		Key:    aws.String(object),
 	})
@@ -159,6 +388,29 @@ func (s *S3) Exists(object string) (bool, error) {
 	return true, nil
 }
 
+// List returns all objects whose name starts with the given prefix.
+func (s *S3) List(prefix string) ([]*storage.ObjectInfo, error) {
+	var objects []*storage.ObjectInfo
+	err := s.uploader.S3.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, obj := range page.Contents {
+			objects = append(objects, &storage.ObjectInfo{
+				Name:    aws.StringValue(obj.Key),
+				Size:    aws.Int64Value(obj.Size),
+				ModTime: aws.TimeValue(obj.LastModified),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list objects")
+	}
+
+	return objects, nil
+}
+
 // CanReadURI checks if a URI can be read by the storage backend.
 func (s *S3) CanReadURI(uri string) (bool, error) {
 	parsed, err := url.Parse(uri)
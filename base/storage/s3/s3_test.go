@@ -0,0 +1,95 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage_s3
+
+import (
+	"github.com/openela/mothership/base/storage/storagetest"
+	"github.com/stretchr/testify/require"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+)
+
+// TestConformance runs against a real bucket, for example a local MinIO.
+// Set MSHIP_TEST_S3_BUCKET and the usual AWS environment variables to enable it.
+func TestConformance(t *testing.T) {
+	bucket := os.Getenv("MSHIP_TEST_S3_BUCKET")
+	if bucket == "" {
+		t.Skip("MSHIP_TEST_S3_BUCKET is not set")
+	}
+
+	s, err := New(bucket)
+	require.Nil(t, err)
+	storagetest.Run(t, s)
+}
+
+func TestPresignPut(t *testing.T) {
+	t.Setenv("AWS_REGION", "us-east-2")
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+
+	s, err := New("mship")
+	require.Nil(t, err)
+
+	// sha256 of "hello"
+	req, err := s.PresignPut("2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", 15*time.Minute)
+	require.Nil(t, err)
+	require.Contains(t, req.URL, "X-Amz-Signature=")
+	require.Equal(t, "LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ=", req.Headers["x-amz-checksum-sha256"])
+	require.Equal(t, "s3://mship/2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", req.Location)
+}
+
+func TestPresignPut_InvalidChecksum(t *testing.T) {
+	t.Setenv("AWS_REGION", "us-east-2")
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+
+	s, err := New("mship")
+	require.Nil(t, err)
+
+	_, err = s.PresignPut("foo", "not-hex", 15*time.Minute)
+	require.NotNil(t, err)
+}
+
+func TestConfigFromQuery(t *testing.T) {
+	t.Setenv("REPLICA_ACCESS_KEY_ID", "replica-id")
+	t.Setenv("REPLICA_SECRET_ACCESS_KEY", "replica-secret")
+
+	cfg, err := configFromQuery(url.Values{
+		"region":                {"eu-west-1"},
+		"endpoint":              {"https://s3.example.com"},
+		"secure":                {"false"},
+		"path-style":            {"true"},
+		"access-key-id-env":     {"REPLICA_ACCESS_KEY_ID"},
+		"secret-access-key-env": {"REPLICA_SECRET_ACCESS_KEY"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, "eu-west-1", *cfg.Region)
+	require.Equal(t, "https://s3.example.com", *cfg.Endpoint)
+	require.True(t, *cfg.DisableSSL)
+	require.True(t, *cfg.S3ForcePathStyle)
+
+	creds, err := cfg.Credentials.Get()
+	require.Nil(t, err)
+	require.Equal(t, "replica-id", creds.AccessKeyID)
+	require.Equal(t, "replica-secret", creds.SecretAccessKey)
+
+	// Unset parameters are filled out from the environment
+	cfg, err = configFromQuery(url.Values{})
+	require.Nil(t, err)
+	require.Nil(t, cfg.Region)
+	require.Nil(t, cfg.Credentials)
+}
+
+func TestConfigFromQuery_Invalid(t *testing.T) {
+	_, err := configFromQuery(url.Values{"bucket": {"other"}})
+	require.NotNil(t, err)
+
+	_, err = configFromQuery(url.Values{"secure": {"maybe"}})
+	require.NotNil(t, err)
+
+	_, err = configFromQuery(url.Values{"access-key-id-env": {"MSHIP_TEST_UNSET_ENV"}})
+	require.NotNil(t, err)
+}
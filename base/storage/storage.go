@@ -3,7 +3,13 @@
 
 package storage
 
-import "errors"
+import (
+	"errors"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+)
 
 var ErrNotFound = errors.New("not found")
 
@@ -16,6 +22,28 @@ type UploadInfo struct {
 	VersionID *string
 }
 
+// ObjectInfo is the information about a stored object.
+type ObjectInfo struct {
+	// Name is the object name, as passed to Put.
+	Name string
+
+	// Size is the size of the object in bytes.
+	Size int64
+
+	// ModTime is the last time the object was written.
+	ModTime time.Time
+}
+
+// Writer is a streaming writer for a single object.
+// The object only becomes visible once Close returns successfully.
+type Writer interface {
+	io.WriteCloser
+
+	// Abort discards everything written so far.
+	// Any existing object with the same name is left untouched.
+	Abort() error
+}
+
 // Storage is an interface for storage backends.
 // Usually S3, but can be anything.
 type Storage interface {
@@ -32,6 +60,16 @@ type Storage interface {
 	// PutBytes uploads a file to the storage backend.
 	PutBytes(object string, data []byte) (*UploadInfo, error)
 
+	// Open opens a file from the storage backend for streaming reads.
+	// Returns the reader and the size of the file.
+	// The caller must close the reader.
+	// Returns ErrNotFound if the file does not exist.
+	Open(object string) (io.ReadCloser, int64, error)
+
+	// Create opens a file in the storage backend for streaming writes.
+	// The caller must either Close or Abort the writer.
+	Create(object string) (Writer, error)
+
 	// Delete deletes a file from the storage backend.
 	// Returns ErrNotFound if the file does not exist.
 	Delete(object string) error
@@ -43,4 +81,94 @@ type Storage interface {
 	// CanReadURI checks if a URI can be read by the storage backend.
 	// Returns false if the URI cannot be read.
 	CanReadURI(uri string) (bool, error)
+
+	// List returns all objects whose name starts with the given prefix.
+	// An empty prefix lists every object.
+	List(prefix string) ([]*ObjectInfo, error)
+}
+
+// MetadataStorage is implemented by storage backends that can store
+// metadata with objects, for example S3 user metadata.
+// Metadata keys are lower case. Writing an object without metadata, for
+// example with Put, removes the metadata of the object it replaces.
+type MetadataStorage interface {
+	Storage
+
+	// CreateWithMetadata opens a file in the storage backend for streaming
+	// writes, like Create. The metadata is stored with the object once the
+	// writer is closed.
+	CreateWithMetadata(object string, metadata map[string]string) (Writer, error)
+
+	// PutWithMetadata uploads a file to the storage backend, like Put, and
+	// stores the metadata with it.
+	PutWithMetadata(object string, fromPath string, metadata map[string]string) (*UploadInfo, error)
+
+	// PutBytesWithMetadata uploads a file to the storage backend, like
+	// PutBytes, and stores the metadata with it.
+	PutBytesWithMetadata(object string, data []byte, metadata map[string]string) (*UploadInfo, error)
+
+	// GetMetadata returns the metadata of an object.
+	// Returns ErrNotFound if the object does not exist.
+	GetMetadata(object string) (map[string]string, error)
+
+	// SetMetadata replaces the metadata of an object, without rewriting
+	// its content.
+	// Returns ErrNotFound if the object does not exist.
+	SetMetadata(object string, metadata map[string]string) error
+}
+
+// URIResolver is implemented by storage backends whose URIs don't follow
+// the scheme://bucket/object layout, for example file:// URIs where the
+// object is relative to a root directory.
+type URIResolver interface {
+	// ObjectFromURI returns the object name for a URI that CanReadURI
+	// accepted.
+	ObjectFromURI(uri string) (string, error)
+}
+
+// ObjectFromURI returns the object name for a URI that CanReadURI accepted.
+// Uses the URIResolver of the backend if it has one, otherwise the object
+// is the path of a scheme://bucket/object URI, or the host of a
+// scheme://object URI.
+func ObjectFromURI(s Storage, uri string) (string, error) {
+	if resolver, ok := s.(URIResolver); ok {
+		return resolver.ObjectFromURI(uri)
+	}
+
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	object := strings.TrimPrefix(parsed.Path, "/")
+	if object == "" {
+		object = parsed.Host
+	}
+
+	return object, nil
+}
+
+// PresignedRequest is a presigned HTTP request for an object.
+type PresignedRequest struct {
+	// URL is the presigned URL.
+	URL string
+
+	// Headers must be sent with the request, otherwise the signature
+	// doesn't match.
+	Headers map[string]string
+
+	// Location is the location of the object, as it would be returned
+	// in UploadInfo.
+	Location string
+
+	// Expires is the time after which the URL is no longer valid.
+	Expires time.Time
+}
+
+// Presigner is implemented by storage backends that can hand out
+// short-lived URLs for uploading directly to the backend.
+type Presigner interface {
+	// PresignPut returns a presigned PUT request for the object.
+	// The upload is only accepted if the content matches the given
+	// hex encoded SHA-256 checksum.
+	PresignPut(object string, checksumSha256 string, expires time.Duration) (*PresignedRequest, error)
 }
@@ -0,0 +1,41 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// resolverStorage resolves every URI to the same object.
+type resolverStorage struct {
+	Storage
+}
+
+func (resolverStorage) ObjectFromURI(string) (string, error) {
+	return "resolved", nil
+}
+
+func TestObjectFromURI_Path_S3(t *testing.T) {
+	object, err := ObjectFromURI(nil, "s3://mship/test.rpm")
+	require.Nil(t, err)
+	require.Equal(t, "test.rpm", object)
+}
+
+func TestObjectFromURI_Host_Memory(t *testing.T) {
+	object, err := ObjectFromURI(nil, "memory://test.rpm")
+	require.Nil(t, err)
+	require.Equal(t, "test.rpm", object)
+}
+
+func TestObjectFromURI_InvalidURI(t *testing.T) {
+	_, err := ObjectFromURI(nil, "test://test:test/")
+	require.NotNil(t, err)
+}
+
+func TestObjectFromURI_Resolver(t *testing.T) {
+	object, err := ObjectFromURI(resolverStorage{}, "file:///var/lib/mship/test.rpm")
+	require.Nil(t, err)
+	require.Equal(t, "resolved", object)
+}
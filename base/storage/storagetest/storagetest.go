@@ -0,0 +1,272 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package storagetest implements a conformance suite for storage backends.
+// Every implementation of storage.Storage should run Run from its tests.
+package storagetest
+
+import (
+	"errors"
+	"github.com/openela/mothership/base/storage"
+	"github.com/stretchr/testify/require"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+// Run runs the conformance suite against the given storage backend.
+// The backend should be empty, and is written to by the suite.
+// Backends that implement storage.MetadataStorage are checked for that as
+// well.
+func Run(t *testing.T, s storage.Storage) {
+	if ms, ok := s.(storage.MetadataStorage); ok {
+		t.Run("Metadata", func(t *testing.T) {
+			runMetadata(t, ms)
+		})
+	}
+
+	t.Run("PutBytes_Get", func(t *testing.T) {
+		info, err := s.PutBytes("conformance-putbytes", []byte("hello"))
+		require.Nil(t, err)
+		require.NotNil(t, info)
+		require.NotEmpty(t, info.Location)
+
+		data, err := s.Get("conformance-putbytes")
+		require.Nil(t, err)
+		require.Equal(t, []byte("hello"), data)
+	})
+
+	t.Run("PutBytes_Overwrite", func(t *testing.T) {
+		_, err := s.PutBytes("conformance-overwrite", []byte("first"))
+		require.Nil(t, err)
+		_, err = s.PutBytes("conformance-overwrite", []byte("second"))
+		require.Nil(t, err)
+
+		data, err := s.Get("conformance-overwrite")
+		require.Nil(t, err)
+		require.Equal(t, []byte("second"), data)
+	})
+
+	t.Run("Put_Download", func(t *testing.T) {
+		tempDir := t.TempDir()
+		fromPath := filepath.Join(tempDir, "from")
+		require.Nil(t, os.WriteFile(fromPath, []byte("from file"), 0644))
+
+		info, err := s.Put("conformance-put", fromPath)
+		require.Nil(t, err)
+		require.NotNil(t, info)
+
+		toPath := filepath.Join(tempDir, "to")
+		require.Nil(t, s.Download("conformance-put", toPath))
+
+		data, err := os.ReadFile(toPath)
+		require.Nil(t, err)
+		require.Equal(t, []byte("from file"), data)
+	})
+
+	t.Run("Create_Open", func(t *testing.T) {
+		w, err := s.Create("conformance-create")
+		require.Nil(t, err)
+		_, err = w.Write([]byte("hello "))
+		require.Nil(t, err)
+		_, err = w.Write([]byte("world"))
+		require.Nil(t, err)
+		require.Nil(t, w.Close())
+
+		r, size, err := s.Open("conformance-create")
+		require.Nil(t, err)
+		defer r.Close()
+		require.Equal(t, int64(11), size)
+
+		data, err := io.ReadAll(r)
+		require.Nil(t, err)
+		require.Equal(t, []byte("hello world"), data)
+	})
+
+	t.Run("Create_Abort", func(t *testing.T) {
+		_, err := s.PutBytes("conformance-abort", []byte("original"))
+		require.Nil(t, err)
+
+		w, err := s.Create("conformance-abort")
+		require.Nil(t, err)
+		_, err = w.Write([]byte("partial"))
+		require.Nil(t, err)
+		require.Nil(t, w.Abort())
+
+		data, err := s.Get("conformance-abort")
+		require.Nil(t, err)
+		require.Equal(t, []byte("original"), data)
+
+		w, err = s.Create("conformance-abort-new")
+		require.Nil(t, err)
+		_, err = w.Write([]byte("partial"))
+		require.Nil(t, err)
+		require.Nil(t, w.Abort())
+
+		ok, err := s.Exists("conformance-abort-new")
+		require.Nil(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		_, err := s.PutBytes("conformance-exists", []byte("x"))
+		require.Nil(t, err)
+
+		ok, err := s.Exists("conformance-exists")
+		require.Nil(t, err)
+		require.True(t, ok)
+
+		ok, err = s.Exists("conformance-does-not-exist")
+		require.Nil(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		_, err := s.PutBytes("conformance-delete", []byte("x"))
+		require.Nil(t, err)
+		require.Nil(t, s.Delete("conformance-delete"))
+
+		ok, err := s.Exists("conformance-delete")
+		require.Nil(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := s.Get("conformance-does-not-exist")
+		require.True(t, errors.Is(err, storage.ErrNotFound))
+
+		err = s.Download("conformance-does-not-exist", filepath.Join(t.TempDir(), "to"))
+		require.True(t, errors.Is(err, storage.ErrNotFound))
+
+		_, _, err = s.Open("conformance-does-not-exist")
+		require.True(t, errors.Is(err, storage.ErrNotFound))
+
+		err = s.Delete("conformance-does-not-exist")
+		require.True(t, errors.Is(err, storage.ErrNotFound))
+	})
+
+	t.Run("List", func(t *testing.T) {
+		_, err := s.PutBytes("conformance-list/first-object", []byte("1"))
+		require.Nil(t, err)
+		_, err = s.PutBytes("conformance-list/second-object", []byte("22"))
+		require.Nil(t, err)
+		_, err = s.PutBytes("conformance-list-other", []byte("333"))
+		require.Nil(t, err)
+
+		objects, err := s.List("conformance-list/")
+		require.Nil(t, err)
+		sort.Slice(objects, func(i, j int) bool {
+			return objects[i].Name < objects[j].Name
+		})
+		require.Len(t, objects, 2)
+		require.Equal(t, "conformance-list/first-object", objects[0].Name)
+		require.Equal(t, int64(1), objects[0].Size)
+		require.False(t, objects[0].ModTime.IsZero())
+		require.Equal(t, "conformance-list/second-object", objects[1].Name)
+		require.Equal(t, int64(2), objects[1].Size)
+
+		objects, err = s.List("")
+		require.Nil(t, err)
+		var names []string
+		for _, object := range objects {
+			names = append(names, object.Name)
+		}
+		require.Contains(t, names, "conformance-list-other")
+		require.Contains(t, names, "conformance-list/first-object")
+	})
+
+	t.Run("CanReadURI_Location", func(t *testing.T) {
+		info, err := s.PutBytes("conformance-uri", []byte("x"))
+		require.Nil(t, err)
+
+		ok, err := s.CanReadURI(info.Location)
+		require.Nil(t, err)
+		require.True(t, ok)
+
+		if resolver, isResolver := s.(storage.URIResolver); isResolver {
+			object, err := resolver.ObjectFromURI(info.Location)
+			require.Nil(t, err)
+			require.Equal(t, "conformance-uri", object)
+		}
+	})
+
+	t.Run("CanReadURI_Foreign", func(t *testing.T) {
+		ok, err := s.CanReadURI("conformance-foreign://bucket/object")
+		require.Nil(t, err)
+		require.False(t, ok)
+	})
+}
+
+func runMetadata(t *testing.T, s storage.MetadataStorage) {
+	metadata := map[string]string{"mship-test": "value"}
+
+	t.Run("PutBytesWithMetadata", func(t *testing.T) {
+		_, err := s.PutBytesWithMetadata("conformance-metadata-putbytes", []byte("x"), metadata)
+		require.Nil(t, err)
+
+		got, err := s.GetMetadata("conformance-metadata-putbytes")
+		require.Nil(t, err)
+		require.Equal(t, metadata, got)
+	})
+
+	t.Run("PutWithMetadata", func(t *testing.T) {
+		fromPath := filepath.Join(t.TempDir(), "from")
+		require.Nil(t, os.WriteFile(fromPath, []byte("x"), 0644))
+
+		_, err := s.PutWithMetadata("conformance-metadata-put", fromPath, metadata)
+		require.Nil(t, err)
+
+		got, err := s.GetMetadata("conformance-metadata-put")
+		require.Nil(t, err)
+		require.Equal(t, metadata, got)
+	})
+
+	t.Run("CreateWithMetadata", func(t *testing.T) {
+		w, err := s.CreateWithMetadata("conformance-metadata-create", metadata)
+		require.Nil(t, err)
+		_, err = w.Write([]byte("x"))
+		require.Nil(t, err)
+		require.Nil(t, w.Close())
+
+		got, err := s.GetMetadata("conformance-metadata-create")
+		require.Nil(t, err)
+		require.Equal(t, metadata, got)
+	})
+
+	t.Run("SetMetadata", func(t *testing.T) {
+		_, err := s.PutBytesWithMetadata("conformance-metadata-set", []byte("content"), metadata)
+		require.Nil(t, err)
+
+		replaced := map[string]string{"mship-other": "other"}
+		require.Nil(t, s.SetMetadata("conformance-metadata-set", replaced))
+
+		got, err := s.GetMetadata("conformance-metadata-set")
+		require.Nil(t, err)
+		require.Equal(t, replaced, got)
+
+		data, err := s.Get("conformance-metadata-set")
+		require.Nil(t, err)
+		require.Equal(t, []byte("content"), data)
+	})
+
+	t.Run("Overwrite", func(t *testing.T) {
+		_, err := s.PutBytesWithMetadata("conformance-metadata-overwrite", []byte("first"), metadata)
+		require.Nil(t, err)
+		_, err = s.PutBytes("conformance-metadata-overwrite", []byte("second"))
+		require.Nil(t, err)
+
+		got, err := s.GetMetadata("conformance-metadata-overwrite")
+		require.Nil(t, err)
+		require.Empty(t, got)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := s.GetMetadata("conformance-does-not-exist")
+		require.True(t, errors.Is(err, storage.ErrNotFound))
+
+		err = s.SetMetadata("conformance-does-not-exist", metadata)
+		require.True(t, errors.Is(err, storage.ErrNotFound))
+	})
+}
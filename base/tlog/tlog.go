@@ -0,0 +1,384 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tlog implements RFC 6962 Merkle trees for transparency logs.
+// Trees are either computed from all leaf hashes, or from the stored hashes
+// of complete subtrees, which only reads O(log n) hashes per tree.
+package tlog
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"github.com/pkg/errors"
+	"math/bits"
+)
+
+// HashSize is the size of all hashes of the tree.
+const HashSize = sha256.Size
+
+var (
+	// ErrInvalidProof is returned if a proof doesn't verify.
+	ErrInvalidProof = errors.New("invalid proof")
+
+	// ErrInvalidSize is returned if a tree size or index is out of range.
+	ErrInvalidSize = errors.New("invalid tree size")
+)
+
+// LeafHash returns the hash of a leaf, SHA-256(0x00 || data).
+func LeafHash(data []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{0x00})
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// NodeHash returns the hash of an interior node, SHA-256(0x01 || left || right).
+func NodeHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{0x01})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// split returns the largest power of two smaller than n, n must be > 1.
+func split(n int) int {
+	return 1 << (bits.Len(uint(n-1)) - 1)
+}
+
+// RootHash returns the root hash of the tree with the given leaf hashes.
+// The root hash of an empty tree is the hash of the empty string.
+func RootHash(leaves [][]byte) []byte {
+	switch len(leaves) {
+	case 0:
+		h := sha256.Sum256(nil)
+		return h[:]
+	case 1:
+		return leaves[0]
+	}
+
+	k := split(len(leaves))
+	return NodeHash(RootHash(leaves[:k]), RootHash(leaves[k:]))
+}
+
+// InclusionProof returns the audit path of the leaf at index in the tree with
+// the given leaf hashes.
+func InclusionProof(leaves [][]byte, index int) ([][]byte, error) {
+	if index < 0 || index >= len(leaves) {
+		return nil, ErrInvalidSize
+	}
+
+	return inclusionProof(leaves, index), nil
+}
+
+func inclusionProof(leaves [][]byte, index int) [][]byte {
+	if len(leaves) <= 1 {
+		return nil
+	}
+
+	k := split(len(leaves))
+	if index < k {
+		return append(inclusionProof(leaves[:k], index), RootHash(leaves[k:]))
+	}
+	return append(inclusionProof(leaves[k:], index-k), RootHash(leaves[:k]))
+}
+
+// ConsistencyProof returns the proof that the tree with the first size1
+// leaves is a prefix of the tree with the given leaf hashes.
+func ConsistencyProof(leaves [][]byte, size1 int) ([][]byte, error) {
+	if size1 < 0 || size1 > len(leaves) {
+		return nil, ErrInvalidSize
+	}
+	if size1 == 0 || size1 == len(leaves) {
+		return nil, nil
+	}
+
+	return consistencyProof(leaves, size1, true), nil
+}
+
+func consistencyProof(leaves [][]byte, m int, complete bool) [][]byte {
+	n := len(leaves)
+	if m == n {
+		if complete {
+			return nil
+		}
+		return [][]byte{RootHash(leaves)}
+	}
+
+	k := split(n)
+	if m <= k {
+		return append(consistencyProof(leaves[:k], m, complete), RootHash(leaves[k:]))
+	}
+	return append(consistencyProof(leaves[k:], m-k, false), RootHash(leaves[:k]))
+}
+
+// VerifyInclusion verifies that leafHash is at index in the tree of the given
+// size and root hash.
+func VerifyInclusion(leafHash []byte, index, size int64, proof [][]byte, root []byte) error {
+	if index < 0 || index >= size {
+		return ErrInvalidSize
+	}
+
+	fn, sn := index, size-1
+	r := leafHash
+	for _, p := range proof {
+		if sn == 0 {
+			return ErrInvalidProof
+		}
+		if fn&1 == 1 || fn == sn {
+			r = NodeHash(p, r)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			r = NodeHash(r, p)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+
+	if sn != 0 || !bytes.Equal(r, root) {
+		return ErrInvalidProof
+	}
+
+	return nil
+}
+
+// VerifyConsistency verifies that the tree of size1 and root1 is a prefix of
+// the tree of size2 and root2.
+func VerifyConsistency(size1, size2 int64, root1, root2 []byte, proof [][]byte) error {
+	switch {
+	case size1 < 0 || size1 > size2:
+		return ErrInvalidSize
+	case size1 == size2:
+		if len(proof) != 0 || !bytes.Equal(root1, root2) {
+			return ErrInvalidProof
+		}
+		return nil
+	case size1 == 0:
+		// The empty tree is a prefix of every tree
+		if len(proof) != 0 {
+			return ErrInvalidProof
+		}
+		return nil
+	case len(proof) == 0:
+		return ErrInvalidProof
+	}
+
+	// If the first tree is complete, its root is the first node of the proof
+	if size1&(size1-1) == 0 {
+		proof = append([][]byte{root1}, proof...)
+	}
+
+	fn, sn := size1-1, size2-1
+	for fn&1 == 1 {
+		fn >>= 1
+		sn >>= 1
+	}
+
+	fr, sr := proof[0], proof[0]
+	for _, c := range proof[1:] {
+		if sn == 0 {
+			return ErrInvalidProof
+		}
+		if fn&1 == 1 || fn == sn {
+			fr = NodeHash(c, fr)
+			sr = NodeHash(c, sr)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			sr = NodeHash(sr, c)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+
+	if sn != 0 || !bytes.Equal(fr, root1) || !bytes.Equal(sr, root2) {
+		return ErrInvalidProof
+	}
+
+	return nil
+}
+
+// NodeID identifies the complete subtree of 2^Level leaves that starts at
+// leaf Index<<Level. The nodes of level 0 are the leaves.
+type NodeID struct {
+	Level int
+	Index int64
+}
+
+// HashReader reads the hashes of complete subtrees.
+type HashReader interface {
+	// ReadHashes returns the hashes of the nodes, in the order of ids.
+	ReadHashes(ids []NodeID) ([][]byte, error)
+}
+
+// subtrees returns the complete subtrees that make up the leaves [lo, hi).
+// lo must be a multiple of the largest power of two not greater than
+// hi - lo, which is true for every range of an RFC 6962 tree.
+func subtrees(lo, hi int64) []NodeID {
+	var ids []NodeID
+	for lo < hi {
+		level := bits.Len64(uint64(hi-lo)) - 1
+		ids = append(ids, NodeID{Level: level, Index: lo >> level})
+		lo += 1 << level
+	}
+
+	return ids
+}
+
+// readRangeHashes returns the hashes of the leaves [lo, hi) of each range.
+// All nodes are read at once.
+func readRangeHashes(r HashReader, ranges [][2]int64) ([][]byte, error) {
+	if len(ranges) == 0 {
+		return nil, nil
+	}
+
+	var ids []NodeID
+	for _, rng := range ranges {
+		ids = append(ids, subtrees(rng[0], rng[1])...)
+	}
+	hashes, err := r.ReadHashes(ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(hashes) != len(ids) {
+		return nil, errors.Errorf("read %d hashes, expected %d", len(hashes), len(ids))
+	}
+
+	res := make([][]byte, 0, len(ranges))
+	for _, rng := range ranges {
+		n := len(subtrees(rng[0], rng[1]))
+		nodes := hashes[:n]
+		hashes = hashes[n:]
+
+		// Subtrees are combined from the right, like the leaves of RootHash
+		h := nodes[n-1]
+		for i := n - 2; i >= 0; i-- {
+			h = NodeHash(nodes[i], h)
+		}
+		res = append(res, h)
+	}
+
+	return res, nil
+}
+
+// AppendNodes returns the complete subtrees, other than the leaf itself,
+// that appending the leaf at index creates, ordered by level.
+// r must return the nodes of the tree of size index.
+func AppendNodes(r HashReader, index int64, leafHash []byte) ([]NodeID, [][]byte, error) {
+	if index < 0 {
+		return nil, nil, ErrInvalidSize
+	}
+
+	// The leaf completes a subtree on every level its left sibling is
+	// complete on
+	var siblings []NodeID
+	for level := 0; (index>>level)&1 == 1; level++ {
+		siblings = append(siblings, NodeID{Level: level, Index: (index >> level) - 1})
+	}
+	if len(siblings) == 0 {
+		return nil, nil, nil
+	}
+
+	hashes, err := r.ReadHashes(siblings)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(hashes) != len(siblings) {
+		return nil, nil, errors.Errorf("read %d hashes, expected %d", len(hashes), len(siblings))
+	}
+
+	ids := make([]NodeID, 0, len(siblings))
+	nodes := make([][]byte, 0, len(siblings))
+	h := leafHash
+	for i, sibling := range siblings {
+		h = NodeHash(hashes[i], h)
+		ids = append(ids, NodeID{Level: sibling.Level + 1, Index: index >> (sibling.Level + 1)})
+		nodes = append(nodes, h)
+	}
+
+	return ids, nodes, nil
+}
+
+// TreeHash returns the root hash of the tree of size, like RootHash.
+func TreeHash(r HashReader, size int64) ([]byte, error) {
+	if size < 0 {
+		return nil, ErrInvalidSize
+	}
+	if size == 0 {
+		return RootHash(nil), nil
+	}
+
+	hashes, err := readRangeHashes(r, [][2]int64{{0, size}})
+	if err != nil {
+		return nil, err
+	}
+
+	return hashes[0], nil
+}
+
+// ProveInclusion returns the audit path of the leaf at index in the tree of
+// size, like InclusionProof.
+func ProveInclusion(r HashReader, size, index int64) ([][]byte, error) {
+	if index < 0 || index >= size {
+		return nil, ErrInvalidSize
+	}
+
+	return readRangeHashes(r, inclusionRanges(0, size, index))
+}
+
+func inclusionRanges(lo, hi, index int64) [][2]int64 {
+	if hi-lo <= 1 {
+		return nil
+	}
+
+	k := lo + int64(split(int(hi-lo)))
+	if index < k {
+		return append(inclusionRanges(lo, k, index), [2]int64{k, hi})
+	}
+	return append(inclusionRanges(k, hi, index), [2]int64{lo, k})
+}
+
+// ProveConsistency returns the proof that the tree of size1 is a prefix of
+// the tree of size2, like ConsistencyProof.
+func ProveConsistency(r HashReader, size1, size2 int64) ([][]byte, error) {
+	if size1 < 0 || size1 > size2 {
+		return nil, ErrInvalidSize
+	}
+	if size1 == 0 || size1 == size2 {
+		return nil, nil
+	}
+
+	return readRangeHashes(r, consistencyRanges(0, size2, size1, true))
+}
+
+func consistencyRanges(lo, hi, m int64, complete bool) [][2]int64 {
+	if m == hi-lo {
+		if complete {
+			return nil
+		}
+		return [][2]int64{{lo, hi}}
+	}
+
+	k := int64(split(int(hi - lo)))
+	if m <= k {
+		return append(consistencyRanges(lo, lo+k, m, complete), [2]int64{lo + k, hi})
+	}
+	return append(consistencyRanges(lo+k, hi, m-k, false), [2]int64{lo, lo + k})
+}
+
+// Checkpoint returns the signed body of a tree head, in the checkpoint format
+// of Go's checksum database and other transparency logs:
+//
+//	<origin>
+//	<tree size>
+//	<base64 root hash>
+func Checkpoint(origin string, size int64, root []byte) string {
+	return fmt.Sprintf("%s\n%d\n%s\n", origin, size, base64.StdEncoding.EncodeToString(root))
+}
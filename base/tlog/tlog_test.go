@@ -0,0 +1,192 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tlog
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func testLeaves(n int) [][]byte {
+	leaves := make([][]byte, n)
+	for i := range leaves {
+		leaves[i] = LeafHash([]byte(fmt.Sprintf("leaf %d", i)))
+	}
+
+	return leaves
+}
+
+func TestRootHash_Empty(t *testing.T) {
+	empty := sha256.Sum256(nil)
+	require.Equal(t, empty[:], RootHash(nil))
+}
+
+func TestRootHash(t *testing.T) {
+	leaves := testLeaves(3)
+	require.Equal(t, leaves[0], RootHash(leaves[:1]))
+	require.Equal(t, NodeHash(leaves[0], leaves[1]), RootHash(leaves[:2]))
+	require.Equal(t, NodeHash(NodeHash(leaves[0], leaves[1]), leaves[2]), RootHash(leaves))
+}
+
+func TestLeafHash(t *testing.T) {
+	// Leaf and node hashes are domain separated
+	require.Equal(
+		t,
+		"6e340b9cffb37a989ca544e6bb780a2c78901d3fb33738768511a30617afa01d",
+		hex.EncodeToString(LeafHash(nil)),
+	)
+}
+
+func TestInclusionProof(t *testing.T) {
+	leaves := testLeaves(17)
+	for size := 1; size <= len(leaves); size++ {
+		root := RootHash(leaves[:size])
+		for index := 0; index < size; index++ {
+			proof, err := InclusionProof(leaves[:size], index)
+			require.Nil(t, err)
+			require.Nil(t, VerifyInclusion(leaves[index], int64(index), int64(size), proof, root), "size %d index %d", size, index)
+
+			// A different leaf doesn't verify
+			other := leaves[(index+1)%len(leaves)]
+			require.ErrorIs(t, VerifyInclusion(other, int64(index), int64(size), proof, root), ErrInvalidProof)
+		}
+	}
+}
+
+func TestInclusionProof_InvalidIndex(t *testing.T) {
+	_, err := InclusionProof(testLeaves(3), 3)
+	require.ErrorIs(t, err, ErrInvalidSize)
+	require.ErrorIs(t, VerifyInclusion(nil, 3, 3, nil, nil), ErrInvalidSize)
+}
+
+func TestConsistencyProof(t *testing.T) {
+	leaves := testLeaves(17)
+	for size2 := 1; size2 <= len(leaves); size2++ {
+		root2 := RootHash(leaves[:size2])
+		for size1 := 0; size1 <= size2; size1++ {
+			root1 := RootHash(leaves[:size1])
+			proof, err := ConsistencyProof(leaves[:size2], size1)
+			require.Nil(t, err)
+			require.Nil(t, VerifyConsistency(int64(size1), int64(size2), root1, root2, proof), "sizes %d %d", size1, size2)
+
+			// A rewritten history doesn't verify
+			if size1 > 0 && size1 < size2 {
+				rewritten := RootHash(testLeaves(size1 + 100)[100:])
+				require.ErrorIs(t, VerifyConsistency(int64(size1), int64(size2), rewritten, root2, proof), ErrInvalidProof)
+			}
+		}
+	}
+}
+
+func TestConsistencyProof_InvalidSize(t *testing.T) {
+	_, err := ConsistencyProof(testLeaves(3), 4)
+	require.ErrorIs(t, err, ErrInvalidSize)
+	require.ErrorIs(t, VerifyConsistency(4, 3, nil, nil, nil), ErrInvalidSize)
+}
+
+func TestCheckpoint(t *testing.T) {
+	root := make([]byte, HashSize)
+	require.Equal(
+		t,
+		"mship.example.com\n3\nAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=\n",
+		Checkpoint("mship.example.com", 3, root),
+	)
+}
+
+// memoryNodes stores the nodes of a tree, and counts the nodes read.
+type memoryNodes struct {
+	nodes map[NodeID][]byte
+	reads int
+}
+
+func (m *memoryNodes) ReadHashes(ids []NodeID) ([][]byte, error) {
+	hashes := make([][]byte, 0, len(ids))
+	for _, id := range ids {
+		hash, ok := m.nodes[id]
+		if !ok {
+			return nil, fmt.Errorf("node %v not found", id)
+		}
+		hashes = append(hashes, hash)
+	}
+	m.reads += len(ids)
+
+	return hashes, nil
+}
+
+// appendTestNodes returns the nodes of a tree of leaves, appended one by one.
+func appendTestNodes(t *testing.T, leaves [][]byte) *memoryNodes {
+	m := &memoryNodes{nodes: map[NodeID][]byte{}}
+	for i, leaf := range leaves {
+		ids, hashes, err := AppendNodes(m, int64(i), leaf)
+		require.Nil(t, err)
+		m.nodes[NodeID{Level: 0, Index: int64(i)}] = leaf
+		for j, id := range ids {
+			m.nodes[id] = hashes[j]
+		}
+	}
+
+	return m
+}
+
+func TestAppendNodes(t *testing.T) {
+	leaves := testLeaves(4)
+	m := appendTestNodes(t, leaves)
+	require.Len(t, m.nodes, 7)
+	require.Equal(t, RootHash(leaves[:2]), m.nodes[NodeID{Level: 1, Index: 0}])
+	require.Equal(t, RootHash(leaves[2:]), m.nodes[NodeID{Level: 1, Index: 1}])
+	require.Equal(t, RootHash(leaves), m.nodes[NodeID{Level: 2, Index: 0}])
+}
+
+func TestTreeHash(t *testing.T) {
+	leaves := testLeaves(17)
+	m := appendTestNodes(t, leaves)
+	for size := 0; size <= len(leaves); size++ {
+		root, err := TreeHash(m, int64(size))
+		require.Nil(t, err)
+		require.Equal(t, RootHash(leaves[:size]), root, "size %d", size)
+	}
+
+	// Only the complete subtrees of the tree are read, 16 and 1
+	m.reads = 0
+	_, err := TreeHash(m, 17)
+	require.Nil(t, err)
+	require.Equal(t, 2, m.reads)
+}
+
+func TestProveInclusion(t *testing.T) {
+	leaves := testLeaves(17)
+	m := appendTestNodes(t, leaves)
+	for size := 1; size <= len(leaves); size++ {
+		for index := 0; index < size; index++ {
+			expected, err := InclusionProof(leaves[:size], index)
+			require.Nil(t, err)
+			proof, err := ProveInclusion(m, int64(size), int64(index))
+			require.Nil(t, err)
+			require.Equal(t, expected, proof, "size %d index %d", size, index)
+		}
+	}
+
+	_, err := ProveInclusion(m, 3, 3)
+	require.ErrorIs(t, err, ErrInvalidSize)
+}
+
+func TestProveConsistency(t *testing.T) {
+	leaves := testLeaves(17)
+	m := appendTestNodes(t, leaves)
+	for size2 := 1; size2 <= len(leaves); size2++ {
+		for size1 := 0; size1 <= size2; size1++ {
+			expected, err := ConsistencyProof(leaves[:size2], size1)
+			require.Nil(t, err)
+			proof, err := ProveConsistency(m, int64(size1), int64(size2))
+			require.Nil(t, err)
+			require.Equal(t, expected, proof, "sizes %d %d", size1, size2)
+		}
+	}
+
+	_, err := ProveConsistency(m, 4, 3)
+	require.ErrorIs(t, err, ErrInvalidSize)
+}
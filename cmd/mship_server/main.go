@@ -8,11 +8,13 @@ import (
 	"log/slog"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/openela/mothership/base"
+	storage_detector "github.com/openela/mothership/base/storage/detector"
 	mothership_migrations "github.com/openela/mothership/migrations"
 	mothership_rpc "github.com/openela/mothership/rpc"
 	"github.com/urfave/cli/v2"
@@ -25,9 +27,17 @@ func run(ctx *cli.Context) error {
 		return err
 	}
 
+	storage, err := storage_detector.FromFlags(ctx)
+	if err != nil {
+		return err
+	}
+
 	s, err := mothership_rpc.NewServer(
 		base.GetDBFromFlags(ctx),
+		storage,
 		temporalClient,
+		int32(ctx.Int("quorum")),
+		ctx.Duration("quorum-timeout"),
 		base.FlagsToGRPCServerOptions(ctx)...,
 	)
 	if err != nil {
@@ -43,8 +53,23 @@ func main() {
 		Flags: base.WithFlags(
 			base.WithDatabaseFlags("mothership"),
 			base.WithTemporalFlags("", "mship_worker_server"),
+			base.WithStorageFlags(),
 			base.WithGrpcFlags(6677),
 			base.WithGatewayFlags(6678),
+			[]cli.Flag{
+				&cli.IntFlag{
+					Name:    "quorum",
+					Usage:   "Number of workers of distinct organizations that must submit the same SRPM before it is imported. Workers must belong to an organization. Disabled if less than 2",
+					EnvVars: []string{"QUORUM"},
+					Value:   1,
+				},
+				&cli.DurationFlag{
+					Name:    "quorum-timeout",
+					Usage:   "How long an SRPM waits for the quorum before it is put on hold",
+					EnvVars: []string{"QUORUM_TIMEOUT"},
+					Value:   24 * time.Hour,
+				},
+			},
 		),
 		Commands: []*cli.Command{
 			{
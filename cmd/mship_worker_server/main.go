@@ -9,23 +9,144 @@ import (
 	"encoding/base64"
 	"log/slog"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/openela/mothership/base"
 	"github.com/openela/mothership/base/bugtracker"
 	github_bugtracker "github.com/openela/mothership/base/bugtracker/github"
 	"github.com/openela/mothership/base/forge"
+	gitea_forge "github.com/openela/mothership/base/forge/gitea"
 	github_forge "github.com/openela/mothership/base/forge/github"
+	gitlab_forge "github.com/openela/mothership/base/forge/gitlab"
+	local_forge "github.com/openela/mothership/base/forge/local"
+	"github.com/openela/mothership/base/signing"
 	storage_detector "github.com/openela/mothership/base/storage/detector"
+	storage_encrypted "github.com/openela/mothership/base/storage/encrypted"
+	storage_replicated "github.com/openela/mothership/base/storage/replicated"
+	mshipadminpb "github.com/openela/mothership/proto/admin/v1"
+	mothershippb "github.com/openela/mothership/proto/v1"
 	mothership_worker_server "github.com/openela/mothership/worker_server"
+	"github.com/openela/mothership/worker_server/srpm_import"
+	"github.com/pkg/errors"
 	"github.com/urfave/cli/v2"
 	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/worker"
 	"golang.org/x/crypto/openpgp"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 //go:embed rh_public_key.asc
 var defaultGpgKey []byte
 
+// forgeFromFlags creates the forge of a git provider, configured by the
+// flags of the provider.
+func forgeFromFlags(ctx *cli.Context, provider string) (forge.Forge, error) {
+	switch provider {
+	case "github":
+		var appPrivateKey []byte
+		if ctx.Bool("github-app-private-key-base64") {
+			var err error
+			appPrivateKey, err = base64.StdEncoding.DecodeString(ctx.String("github-app-private-key"))
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			appPrivateKey = []byte(ctx.String("github-app-private-key"))
+		}
+
+		githubForge, err := github_forge.New(
+			ctx.String("github-org"),
+			ctx.String("github-app-id"),
+			appPrivateKey,
+			ctx.Bool("github-make-repo-public"),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return githubForge, nil
+	case "gitlab":
+		// Flag actions only run for flags that are set
+		for _, name := range []string{"gitlab-host", "gitlab-group", "gitlab-username", "gitlab-token"} {
+			if ctx.String(name) == "" {
+				return nil, cli.Exit(name+" is required for gitlab", 1)
+			}
+		}
+
+		return gitlab_forge.New(
+			ctx.String("gitlab-host"),
+			ctx.String("gitlab-group"),
+			ctx.String("gitlab-username"),
+			ctx.String("gitlab-token"),
+			ctx.String("gitlab-author-name"),
+			ctx.String("gitlab-author-email"),
+			ctx.Bool("gitlab-make-repo-public"),
+		), nil
+	case "gitea":
+		// Flag actions only run for flags that are set
+		for _, name := range []string{"gitea-host", "gitea-org", "gitea-username", "gitea-token"} {
+			if ctx.String(name) == "" {
+				return nil, cli.Exit(name+" is required for gitea", 1)
+			}
+		}
+
+		return gitea_forge.New(
+			ctx.String("gitea-host"),
+			ctx.String("gitea-org"),
+			ctx.String("gitea-username"),
+			ctx.String("gitea-token"),
+			ctx.String("gitea-author-name"),
+			ctx.String("gitea-author-email"),
+			ctx.Bool("gitea-make-repo-public"),
+		), nil
+	case "local":
+		if ctx.String("local-root") == "" {
+			return nil, cli.Exit("local-root is required for local", 1)
+		}
+
+		localForge, err := local_forge.New(
+			ctx.String("local-root"),
+			ctx.String("local-author-name"),
+			ctx.String("local-author-email"),
+			ctx.String("local-commit-viewer-url"),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return localForge, nil
+	default:
+		return nil, cli.Exit(provider+" is not a git provider, must be github, gitlab, gitea or local", 1)
+	}
+}
+
+// parseProjectIDs parses major=projectID pairs into a project ID per major
+// version.
+func parseProjectIDs(pairs []string) (map[int32]int64, error) {
+	projectIDs := map[int32]int64{}
+	for _, pair := range pairs {
+		major, projectID, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, errors.Errorf("invalid project ID %s, must be major=projectID", pair)
+		}
+
+		majorInt, err := strconv.ParseInt(major, 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid major version %s", major)
+		}
+		projectIDInt, err := strconv.ParseInt(projectID, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid project ID %s", projectID)
+		}
+
+		projectIDs[int32(majorInt)] = projectIDInt
+	}
+
+	return projectIDs, nil
+}
+
 func run(ctx *cli.Context) error {
 	temporalClient, err := base.GetTemporalClientFromFlags(ctx, client.Options{})
 	if err != nil {
@@ -54,30 +175,9 @@ func run(ctx *cli.Context) error {
 	}
 
 	// Create forge based on git provider
-	var remoteForge forge.Forge
-	switch ctx.String("git-provider") {
-	case "github":
-		var appPrivateKey []byte
-		if ctx.Bool("github-app-private-key-base64") {
-			appPrivateKey, err = base64.StdEncoding.DecodeString(ctx.String("github-app-private-key"))
-			if err != nil {
-				return err
-			}
-		} else {
-			appPrivateKey = []byte(ctx.String("github-app-private-key"))
-		}
-
-		remoteForge, err = github_forge.New(
-			ctx.String("github-org"),
-			ctx.String("github-app-id"),
-			appPrivateKey,
-			ctx.Bool("github-make-repo-public"),
-		)
-		if err != nil {
-			return err
-		}
-	default:
-		return cli.Exit("git-provider must be github", 1)
+	remoteForge, err := forgeFromFlags(ctx, ctx.String("git-provider"))
+	if err != nil {
+		return err
 	}
 
 	// Create bugtracker
@@ -120,34 +220,232 @@ func run(ctx *cli.Context) error {
 		if err != nil {
 			return err
 		}
+	case "mantis":
+		projectIDs, err := parseProjectIDs(ctx.StringSlice("bugtracker-mantis-project-ids"))
+		if err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+
+		remoteTracker, err = mothership_worker_server.NewBugtracker(&mshipadminpb.BugTrackerConfig{
+			Type: mshipadminpb.BugTrackerConfig_MANTIS,
+			Uri:  ctx.String("bugtracker-mantis-uri"),
+			Config: &mshipadminpb.BugTrackerConfig_Mantis{
+				Mantis: &mshipadminpb.BugTrackerConfig_MantisConfig{
+					ApiKey:     ctx.String("bugtracker-mantis-api-key"),
+					ProjectIds: projectIDs,
+				},
+			},
+		})
+		if err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+	case "gitlab":
+		remoteTracker, err = mothership_worker_server.NewBugtracker(&mshipadminpb.BugTrackerConfig{
+			Type: mshipadminpb.BugTrackerConfig_GITLAB,
+			Uri:  ctx.String("bugtracker-gitlab-uri"),
+			Config: &mshipadminpb.BugTrackerConfig_Gitlab{
+				Gitlab: &mshipadminpb.BugTrackerConfig_GitlabConfig{
+					Token:   ctx.String("bugtracker-gitlab-token"),
+					Project: ctx.String("bugtracker-gitlab-project"),
+				},
+			},
+		})
+		if err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+	case "jira":
+		remoteTracker, err = mothership_worker_server.NewBugtracker(&mshipadminpb.BugTrackerConfig{
+			Type: mshipadminpb.BugTrackerConfig_JIRA,
+			Uri:  ctx.String("bugtracker-jira-uri"),
+			Config: &mshipadminpb.BugTrackerConfig_Jira{
+				Jira: &mshipadminpb.BugTrackerConfig_JiraConfig{
+					Email:           ctx.String("bugtracker-jira-email"),
+					ApiToken:        ctx.String("bugtracker-jira-api-token"),
+					ProjectKey:      ctx.String("bugtracker-jira-project-key"),
+					IssueType:       ctx.String("bugtracker-jira-issue-type"),
+					CloseTransition: ctx.String("bugtracker-jira-close-transition"),
+					Components:      ctx.StringSlice("bugtracker-jira-components"),
+				},
+			},
+		})
+		if err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
 	}
 
 	remoteForge = forge.NewCacher(remoteForge)
 
+	// Create mirrors, every mirror is configured by the flags of its provider
+	var mirrors []*forge.Mirror
+	seen := map[string]bool{ctx.String("git-provider"): true}
+	for _, provider := range ctx.StringSlice("git-mirrors") {
+		if seen[provider] {
+			return cli.Exit("git-mirrors can't contain "+provider+" twice or as the git-provider", 1)
+		}
+		seen[provider] = true
+
+		mirrorForge, err := forgeFromFlags(ctx, provider)
+		if err != nil {
+			return err
+		}
+		mirrors = append(mirrors, &forge.Mirror{
+			Forge: forge.NewCacher(mirrorForge),
+			Name:  provider,
+		})
+	}
+	if len(mirrors) > 0 {
+		remoteForge = forge.NewMirrored(remoteForge, mirrors...)
+	}
+
 	publicURI := ctx.String("public-uri")
 	if remoteTracker != nil && publicURI == "" {
 		return cli.Exit("public-uri is required if bugtracker is used", 1)
 	}
 
+	metadataFormat, err := srpm_import.ParseMetadataFormat(ctx.String("lookaside-metadata-format"))
+	if err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	lookasideRules := &srpm_import.LookasideRules{
+		SizeThreshold: ctx.Int64("lookaside-size-threshold"),
+		Include:       ctx.StringSlice("lookaside-include"),
+		Exclude:       ctx.StringSlice("lookaside-exclude"),
+		SniffBinary:   ctx.Bool("lookaside-sniff-binary"),
+	}
+	err = lookasideRules.Validate()
+	if err != nil {
+		return cli.Exit(err.Error(), 1)
+	}
+
+	// Load ticket template, the default template is used without one
+	var ticketTemplate *mothership_worker_server.TicketTemplate
+	if templateFile := ctx.String("ticket-template"); templateFile != "" {
+		ticketTemplate, err = mothership_worker_server.LoadTicketTemplate(templateFile)
+		if err != nil {
+			return cli.Exit(err.Error(), 1)
+		}
+	}
+
+	// Create signer, imports are unsigned without a key
+	var signer signing.Signer
+	if keyFile := ctx.String("signing-key-file"); keyFile != "" {
+		key, err := os.ReadFile(keyFile)
+		if err != nil {
+			return err
+		}
+		signer, err = signing.New(key, ctx.String("signing-key-id"), []byte(ctx.String("signing-key-passphrase")))
+		if err != nil {
+			return cli.Exit("failed to load signing key: "+err.Error(), 1)
+		}
+
+		err = mothership_worker_server.EnsureSigningKey(db, signer)
+		if err != nil {
+			return err
+		}
+		slog.Info("signing imports", "format", signer.Key().Format, "fingerprint", signer.Key().Fingerprint)
+	}
+
 	w := worker.New(temporalClient, ctx.String("temporal-task-queue"), worker.Options{})
-	workerServer := mothership_worker_server.New(
-		db,
-		storage,
-		gpgKeys,
-		remoteForge,
-		remoteTracker,
-		ctx.Bool("import-rolling-release"),
-		publicURI,
-	)
+	workerServer := mothership_worker_server.New(mothership_worker_server.Options{
+		DB:             db,
+		Storage:        storage,
+		GPGKeys:        gpgKeys,
+		Forge:          remoteForge,
+		Bugtracker:     remoteTracker,
+		Rolling:        ctx.Bool("import-rolling-release"),
+		PublicURI:      publicURI,
+		MetadataFormat: metadataFormat,
+		LookasideRules: lookasideRules,
+		Signer:         signer,
+		TicketTemplate: ticketTemplate,
+		EntryTickets:   ctx.Bool("bugtracker-entry-tickets"),
+	})
 
 	// Register workflows
 	w.RegisterWorkflow(mothership_worker_server.ProcessRPMWorkflow)
 	w.RegisterWorkflow(mothership_worker_server.RetractEntryWorkflow)
 	w.RegisterWorkflow(mothership_worker_server.SealBatchWorkflow)
+	w.RegisterWorkflow(mothership_worker_server.GarbageCollectWorkflow)
+	w.RegisterWorkflow(mothership_worker_server.RepairReplicasWorkflow)
+	w.RegisterWorkflow(mothership_worker_server.RepairMirrorsWorkflow)
+	w.RegisterWorkflow(mothership_worker_server.RewrapKeysWorkflow)
+	w.RegisterWorkflow(mothership_worker_server.BackfillLookasideBlobsWorkflow)
 
 	// Register activities
 	w.RegisterActivity(workerServer)
 
+	// Schedule garbage collection
+	err = mothership_worker_server.EnsureGarbageCollectSchedule(
+		ctx.Context,
+		temporalClient,
+		ctx.String("temporal-task-queue"),
+		ctx.String("gc-schedule"),
+		&mothershippb.GarbageCollectArgs{
+			DryRun:      ctx.Bool("gc-dry-run"),
+			GracePeriod: durationpb.New(ctx.Duration("gc-grace-period")),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	// Schedule replica repair, only replicated storage has replicas to repair
+	repairSchedule := ctx.String("replica-repair-schedule")
+	if _, ok := storage_replicated.Find(storage); !ok {
+		repairSchedule = ""
+	}
+	err = mothership_worker_server.EnsureRepairReplicasSchedule(
+		ctx.Context,
+		temporalClient,
+		ctx.String("temporal-task-queue"),
+		repairSchedule,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Schedule key rewrap, only encrypted storage has keys to rewrap
+	rewrapSchedule := ctx.String("storage-rewrap-schedule")
+	if _, ok := storage_encrypted.Find(storage); !ok {
+		rewrapSchedule = ""
+	}
+	err = mothership_worker_server.EnsureRewrapKeysSchedule(
+		ctx.Context,
+		temporalClient,
+		ctx.String("temporal-task-queue"),
+		rewrapSchedule,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Schedule mirror repair, only a mirrored forge has mirrors to repair
+	mirrorRepairSchedule := ctx.String("mirror-repair-schedule")
+	if len(mirrors) == 0 {
+		mirrorRepairSchedule = ""
+	}
+	err = mothership_worker_server.EnsureRepairMirrorsSchedule(
+		ctx.Context,
+		temporalClient,
+		ctx.String("temporal-task-queue"),
+		mirrorRepairSchedule,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Record the lookaside blobs of entries archived before they were
+	// recorded, only those are served by the lookaside endpoint
+	err = mothership_worker_server.EnsureLookasideBlobsBackfill(
+		ctx.Context,
+		temporalClient,
+		ctx.String("temporal-task-queue"),
+	)
+	if err != nil {
+		return err
+	}
+
 	// Start worker
 	return w.Run(worker.InterruptCh())
 }
@@ -177,16 +475,21 @@ func main() {
 			&cli.StringFlag{
 				Name: "git-provider",
 				Action: func(ctx *cli.Context, s string) error {
-					// Can only be github for now
-					if s != "github" {
-						return cli.Exit("git-provider must be github", 1)
+					switch s {
+					case "github", "gitlab", "gitea", "local":
+						return nil
+					default:
+						return cli.Exit("git-provider must be github, gitlab, gitea or local", 1)
 					}
-
-					return nil
 				},
-				Usage:   "Git provider to use. Currently only github is supported",
+				Usage:   "Git provider to use. Supported providers are github, gitlab, gitea (also used for Forgejo) and local (bare repositories in a directory)",
 				EnvVars: []string{"GIT_PROVIDER"},
 			},
+			&cli.StringSliceFlag{
+				Name:    "git-mirrors",
+				Usage:   "Git providers every import is also pushed to, configured by the same flags as git-provider. The git-provider stays authoritative",
+				EnvVars: []string{"GIT_MIRRORS"},
+			},
 			// Github only
 			&cli.StringFlag{
 				Name:    "github-org",
@@ -239,9 +542,135 @@ func main() {
 				EnvVars: []string{"GITHUB_MAKE_REPO_PUBLIC"},
 				Value:   false,
 			},
+			// Gitlab only
+			&cli.StringFlag{
+				Name:    "gitlab-host",
+				Usage:   "Gitlab host, e.g. gitlab.com",
+				EnvVars: []string{"GITLAB_HOST"},
+				Value:   "gitlab.com",
+			},
+			&cli.StringFlag{
+				Name:    "gitlab-group",
+				Usage:   "Gitlab group to use, may be a subgroup path such as openela/src",
+				EnvVars: []string{"GITLAB_GROUP"},
+				Action: func(ctx *cli.Context, s string) error {
+					// Required for gitlab
+					if ctx.String("git-provider") == "gitlab" && s == "" {
+						return cli.Exit("gitlab-group is required for gitlab", 1)
+					}
+
+					return nil
+				},
+			},
+			&cli.StringFlag{
+				Name:    "gitlab-username",
+				Usage:   "Gitlab username to push as",
+				EnvVars: []string{"GITLAB_USERNAME"},
+				Action: func(ctx *cli.Context, s string) error {
+					// Required for gitlab
+					if ctx.String("git-provider") == "gitlab" && s == "" {
+						return cli.Exit("gitlab-username is required for gitlab", 1)
+					}
+
+					return nil
+				},
+			},
+			&cli.StringFlag{
+				Name:    "gitlab-token",
+				Usage:   "Gitlab access token. Needs the api and write_repository scopes",
+				EnvVars: []string{"GITLAB_TOKEN"},
+				Action: func(ctx *cli.Context, s string) error {
+					// Required for gitlab
+					if ctx.String("git-provider") == "gitlab" && s == "" {
+						return cli.Exit("gitlab-token is required for gitlab", 1)
+					}
+
+					return nil
+				},
+			},
+			&cli.StringFlag{
+				Name:    "gitlab-author-name",
+				Usage:   "Name of the author of import commits",
+				EnvVars: []string{"GITLAB_AUTHOR_NAME"},
+				Value:   "Mship Bot",
+			},
+			&cli.StringFlag{
+				Name:    "gitlab-author-email",
+				Usage:   "Email of the author of import commits",
+				EnvVars: []string{"GITLAB_AUTHOR_EMAIL"},
+				Value:   "no-reply+mshipbot@openela.org",
+			},
+			&cli.BoolFlag{
+				Name:    "gitlab-make-repo-public",
+				Usage:   "Whether to make the Gitlab project public",
+				EnvVars: []string{"GITLAB_MAKE_REPO_PUBLIC"},
+				Value:   false,
+			},
+			// Gitea only
+			&cli.StringFlag{
+				Name:    "gitea-host",
+				Usage:   "Gitea or Forgejo host, e.g. codeberg.org",
+				EnvVars: []string{"GITEA_HOST"},
+			},
+			&cli.StringFlag{
+				Name:    "gitea-org",
+				Usage:   "Gitea organization to use",
+				EnvVars: []string{"GITEA_ORG"},
+			},
+			&cli.StringFlag{
+				Name:    "gitea-username",
+				Usage:   "Gitea username to push as",
+				EnvVars: []string{"GITEA_USERNAME"},
+			},
+			&cli.StringFlag{
+				Name:    "gitea-token",
+				Usage:   "Gitea access token. Needs the write:organization and write:repository scopes",
+				EnvVars: []string{"GITEA_TOKEN"},
+			},
+			&cli.StringFlag{
+				Name:    "gitea-author-name",
+				Usage:   "Name of the author of import commits",
+				EnvVars: []string{"GITEA_AUTHOR_NAME"},
+				Value:   "Mship Bot",
+			},
+			&cli.StringFlag{
+				Name:    "gitea-author-email",
+				Usage:   "Email of the author of import commits",
+				EnvVars: []string{"GITEA_AUTHOR_EMAIL"},
+				Value:   "no-reply+mshipbot@openela.org",
+			},
+			&cli.BoolFlag{
+				Name:    "gitea-make-repo-public",
+				Usage:   "Whether to make the Gitea repository public",
+				EnvVars: []string{"GITEA_MAKE_REPO_PUBLIC"},
+				Value:   false,
+			},
+			// Local only
+			&cli.StringFlag{
+				Name:    "local-root",
+				Usage:   "Directory the bare repositories are created in",
+				EnvVars: []string{"LOCAL_ROOT"},
+			},
+			&cli.StringFlag{
+				Name:    "local-commit-viewer-url",
+				Usage:   "Base URL of a web frontend serving local-root, e.g. cgit. Commits link to the file:// remote if empty",
+				EnvVars: []string{"LOCAL_COMMIT_VIEWER_URL"},
+			},
+			&cli.StringFlag{
+				Name:    "local-author-name",
+				Usage:   "Name of the author of import commits",
+				EnvVars: []string{"LOCAL_AUTHOR_NAME"},
+				Value:   "Mship Bot",
+			},
+			&cli.StringFlag{
+				Name:    "local-author-email",
+				Usage:   "Email of the author of import commits",
+				EnvVars: []string{"LOCAL_AUTHOR_EMAIL"},
+				Value:   "no-reply+mshipbot@openela.org",
+			},
 			&cli.StringFlag{
 				Name:    "bugtracker-provider",
-				Usage:   "Bugtracker provider to use. Currently only github is supported",
+				Usage:   "Bugtracker provider to use. Supported providers are github, mantis, gitlab and jira. The active bug tracker config of the admin API takes precedence",
 				EnvVars: []string{"BUGTRACKER_PROVIDER"},
 				Value:   "github",
 			},
@@ -272,6 +701,162 @@ func main() {
 				EnvVars: []string{"BUGTRACKER_GITHUB_USE_FORGE_AUTH"},
 				Value:   false,
 			},
+			&cli.StringFlag{
+				Name:    "bugtracker-mantis-uri",
+				Usage:   "URI of the Mantis install to use for bugtracker",
+				EnvVars: []string{"BUGTRACKER_MANTIS_URI"},
+			},
+			&cli.StringFlag{
+				Name:    "bugtracker-mantis-api-key",
+				Usage:   "Mantis API key for bugtracker",
+				EnvVars: []string{"BUGTRACKER_MANTIS_API_KEY"},
+			},
+			&cli.StringSliceFlag{
+				Name:    "bugtracker-mantis-project-ids",
+				Usage:   "Mantis project ID per major version, as major=projectID (e.g. 8=1,9=2)",
+				EnvVars: []string{"BUGTRACKER_MANTIS_PROJECT_IDS"},
+			},
+			&cli.StringFlag{
+				Name:    "bugtracker-gitlab-uri",
+				Usage:   "URI of the GitLab instance to use for bugtracker",
+				EnvVars: []string{"BUGTRACKER_GITLAB_URI"},
+				Value:   "https://gitlab.com",
+			},
+			&cli.StringFlag{
+				Name:    "bugtracker-gitlab-token",
+				Usage:   "GitLab access token for bugtracker",
+				EnvVars: []string{"BUGTRACKER_GITLAB_TOKEN"},
+			},
+			&cli.StringFlag{
+				Name:    "bugtracker-gitlab-project",
+				Usage:   "Path of the GitLab project to use for bugtracker (e.g. openela/bugs)",
+				EnvVars: []string{"BUGTRACKER_GITLAB_PROJECT"},
+			},
+			&cli.StringFlag{
+				Name:    "bugtracker-jira-uri",
+				Usage:   "URI of the Jira instance to use for bugtracker",
+				EnvVars: []string{"BUGTRACKER_JIRA_URI"},
+			},
+			&cli.StringFlag{
+				Name:    "bugtracker-jira-email",
+				Usage:   "Email of the Jira user the API token belongs to",
+				EnvVars: []string{"BUGTRACKER_JIRA_EMAIL"},
+			},
+			&cli.StringFlag{
+				Name:    "bugtracker-jira-api-token",
+				Usage:   "Jira API token for bugtracker",
+				EnvVars: []string{"BUGTRACKER_JIRA_API_TOKEN"},
+			},
+			&cli.StringFlag{
+				Name:    "bugtracker-jira-project-key",
+				Usage:   "Key of the Jira project to use for bugtracker",
+				EnvVars: []string{"BUGTRACKER_JIRA_PROJECT_KEY"},
+			},
+			&cli.StringFlag{
+				Name:    "bugtracker-jira-issue-type",
+				Usage:   "Issue type of created Jira issues",
+				EnvVars: []string{"BUGTRACKER_JIRA_ISSUE_TYPE"},
+				Value:   "Bug",
+			},
+			&cli.StringFlag{
+				Name:    "bugtracker-jira-close-transition",
+				Usage:   "Name of the Jira transition that closes issues",
+				EnvVars: []string{"BUGTRACKER_JIRA_CLOSE_TRANSITION"},
+				Value:   "Done",
+			},
+			&cli.StringSliceFlag{
+				Name:    "bugtracker-jira-components",
+				Usage:   "Components of the Jira project. Labels with the name of a component set the component instead",
+				EnvVars: []string{"BUGTRACKER_JIRA_COMPONENTS"},
+			},
+			&cli.BoolFlag{
+				Name:    "bugtracker-entry-tickets",
+				Usage:   "Create a ticket for every entry that fails to import, in addition to the batch ticket. The setting of the active bugtracker config takes precedence",
+				EnvVars: []string{"BUGTRACKER_ENTRY_TICKETS"},
+			},
+			&cli.StringFlag{
+				Name:    "ticket-template",
+				Usage:   "Path to a JSON TicketTemplate for batch tickets. The template of the active bugtracker config takes precedence",
+				EnvVars: []string{"TICKET_TEMPLATE"},
+			},
+			&cli.StringFlag{
+				Name:    "gc-schedule",
+				Usage:   "Cron expression for garbage collecting unreachable objects, for example \"0 4 * * *\". Every run clones all package repositories. Disabled if empty",
+				EnvVars: []string{"GC_SCHEDULE"},
+			},
+			&cli.BoolFlag{
+				Name:    "gc-dry-run",
+				Usage:   "Only report what garbage collection would reclaim, without moving or deleting objects",
+				EnvVars: []string{"GC_DRY_RUN"},
+				Value:   true,
+			},
+			&cli.DurationFlag{
+				Name:    "gc-grace-period",
+				Usage:   "How long unreferenced objects are kept, both before and after being moved to the trash",
+				EnvVars: []string{"GC_GRACE_PERIOD"},
+				Value:   7 * 24 * time.Hour,
+			},
+			&cli.StringFlag{
+				Name:    "replica-repair-schedule",
+				Usage:   "Cron expression for copying objects to storage replicas that are missing them. Only used with replicated:// storage",
+				EnvVars: []string{"REPLICA_REPAIR_SCHEDULE"},
+				Value:   "0 */6 * * *",
+			},
+			&cli.StringFlag{
+				Name:    "storage-rewrap-schedule",
+				Usage:   "Cron expression for re-wrapping data keys with the primary storage encryption key, so older keys can be retired. Can also be started on demand as RewrapKeysWorkflow. Only used with encrypted:// storage",
+				EnvVars: []string{"STORAGE_REWRAP_SCHEDULE"},
+			},
+			&cli.StringFlag{
+				Name:    "mirror-repair-schedule",
+				Usage:   "Cron expression for pushing to git-mirrors that fell behind. Only used with git-mirrors",
+				EnvVars: []string{"MIRROR_REPAIR_SCHEDULE"},
+				Value:   "30 */6 * * *",
+			},
+			&cli.StringFlag{
+				Name:    "signing-key-file",
+				Usage:   "Armored OpenPGP or SSH private key that import and retraction commits and tags, entry attestations and transparency log checkpoints are signed with. Imports are unsigned, not attested and not logged if empty",
+				EnvVars: []string{"SIGNING_KEY_FILE"},
+			},
+			&cli.StringFlag{
+				Name:    "signing-key-id",
+				Usage:   "Key ID or fingerprint of the OpenPGP key to sign with, required if signing-key-file has multiple keys. For SSH keys, the expected SHA256 fingerprint",
+				EnvVars: []string{"SIGNING_KEY_ID"},
+			},
+			&cli.StringFlag{
+				Name:    "signing-key-passphrase",
+				Usage:   "Passphrase of signing-key-file, if it is encrypted",
+				EnvVars: []string{"SIGNING_KEY_PASSPHRASE"},
+			},
+			&cli.StringFlag{
+				Name:    "lookaside-metadata-format",
+				Usage:   "Lookaside metadata files to write. srpmproc (.<name>.metadata, SHA-256), sources (dist-git sources file, SHA-512) or both",
+				EnvVars: []string{"LOOKASIDE_METADATA_FORMAT"},
+				Value:   "srpmproc",
+			},
+			&cli.Int64Flag{
+				Name:    "lookaside-size-threshold",
+				Usage:   "Files larger than this many bytes are stored in the lookaside. 0 disables the size rule",
+				EnvVars: []string{"LOOKASIDE_SIZE_THRESHOLD"},
+				Value:   5 * 1024 * 1024,
+			},
+			&cli.StringSliceFlag{
+				Name:    "lookaside-include",
+				Usage:   "Globs for files that are always stored in the lookaside",
+				EnvVars: []string{"LOOKASIDE_INCLUDE"},
+				Value:   cli.NewStringSlice("*.tar*"),
+			},
+			&cli.StringSliceFlag{
+				Name:    "lookaside-exclude",
+				Usage:   "Globs for files that are always kept in git. Takes precedence over lookaside-include",
+				EnvVars: []string{"LOOKASIDE_EXCLUDE"},
+			},
+			&cli.BoolFlag{
+				Name:    "lookaside-sniff-binary",
+				Usage:   "Store binary files in the lookaside regardless of their size",
+				EnvVars: []string{"LOOKASIDE_SNIFF_BINARY"},
+				Value:   false,
+			},
 		},
 	)
 
@@ -23,6 +23,10 @@ type Batch struct {
 	UpdateTime    time.Time      `db:"update_time" pika:"omitempty"`
 	SealTime      sql.NullTime   `db:"seal_time"`
 	BugtrackerURI sql.NullString `db:"bugtracker_uri"`
+
+	// BugtrackerConfigName is the bug tracker config the ticket was created
+	// with, or null if it was created with the bug tracker of the worker flags.
+	BugtrackerConfigName sql.NullString `db:"bugtracker_config_name"`
 }
 
 func (b *Batch) GetID() string {
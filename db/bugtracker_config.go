@@ -0,0 +1,94 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_db
+
+import (
+	"time"
+
+	mshipadminpb "github.com/openela/mothership/proto/admin/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MaskedSecret replaces the secrets of bug tracker configs read through the
+// admin API.
+const MaskedSecret = "********"
+
+// BugTrackerConfig is the configuration of a bug tracker.
+// Config is the BugTrackerConfig message as JSON, without the fields that
+// have their own column.
+type BugTrackerConfig struct {
+	PikaTableName      string `pika:"bugtracker_configs"`
+	PikaDefaultOrderBy string `pika:"-create_time"`
+
+	Name       string    `db:"name"`
+	CreateTime time.Time `db:"create_time" pika:"omitempty"`
+	UpdateTime time.Time `db:"update_time" pika:"omitempty"`
+	Config     string    `db:"config"`
+	Active     bool      `db:"active"`
+}
+
+func (b *BugTrackerConfig) GetID() string {
+	return b.Name
+}
+
+// SetConfig sets the config column from config.
+func (b *BugTrackerConfig) SetConfig(config *mshipadminpb.BugTrackerConfig) error {
+	config = proto.Clone(config).(*mshipadminpb.BugTrackerConfig)
+	config.Name = ""
+	config.CreateTime = nil
+	config.UpdateTime = nil
+	config.Active = false
+
+	value, err := protojson.Marshal(config)
+	if err != nil {
+		return err
+	}
+	b.Config = string(value)
+
+	return nil
+}
+
+// Proto returns the config with its secrets.
+func (b *BugTrackerConfig) Proto() (*mshipadminpb.BugTrackerConfig, error) {
+	config := &mshipadminpb.BugTrackerConfig{}
+	err := protojson.Unmarshal([]byte(b.Config), config)
+	if err != nil {
+		return nil, err
+	}
+
+	config.Name = b.Name
+	config.CreateTime = timestamppb.New(b.CreateTime)
+	config.UpdateTime = timestamppb.New(b.UpdateTime)
+	config.Active = b.Active
+
+	return config, nil
+}
+
+// ToPB returns the config with its secrets masked.
+func (b *BugTrackerConfig) ToPB() *mshipadminpb.BugTrackerConfig {
+	// The config is always written by SetConfig
+	config, err := b.Proto()
+	if err != nil {
+		config = &mshipadminpb.BugTrackerConfig{
+			Name:       b.Name,
+			CreateTime: timestamppb.New(b.CreateTime),
+			UpdateTime: timestamppb.New(b.UpdateTime),
+			Active:     b.Active,
+		}
+	}
+
+	if mantis := config.GetMantis(); mantis != nil && mantis.ApiKey != "" {
+		mantis.ApiKey = MaskedSecret
+	}
+	if gitlab := config.GetGitlab(); gitlab != nil && gitlab.Token != "" {
+		gitlab.Token = MaskedSecret
+	}
+	if jira := config.GetJira(); jira != nil && jira.ApiToken != "" {
+		jira.ApiToken = MaskedSecret
+	}
+
+	return config
+}
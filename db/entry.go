@@ -30,6 +30,17 @@ type Entry struct {
 	CommitTag      string                   `db:"commit_tag"`
 	State          mothershippb.Entry_State `db:"state"`
 	PackageName    string                   `db:"package_name"`
+	// NEVRA is the name-epoch:version-release.arch of the SRPM
+	NEVRA string `db:"nevra"`
+	// ErrorMessage is the error of the last failed import, empty once
+	// archived
+	ErrorMessage    string    `db:"error_message"`
+	StateChangeTime time.Time `db:"state_change_time" pika:"omitempty"`
+	// BugtrackerURI is the ticket of the failed import, if any
+	BugtrackerURI sql.NullString `db:"bugtracker_uri"`
+	// BugtrackerConfigName is the bug tracker config the ticket was created
+	// with, or null if it was created with the bug tracker of the worker flags.
+	BugtrackerConfigName sql.NullString `db:"bugtracker_config_name"`
 }
 
 func (e *Entry) GetID() string {
@@ -38,20 +49,22 @@ func (e *Entry) GetID() string {
 
 func (e *Entry) ToPB() *mothershippb.Entry {
 	return &mothershippb.Entry{
-		Name:         e.Name,
-		EntryId:      e.EntryID,
-		CreateTime:   timestamppb.New(e.CreateTime),
-		OsRelease:    e.OSRelease,
-		Sha256Sum:    e.Sha256Sum,
-		Repository:   e.RepositoryName,
-		WorkerId:     base.SqlNullString(e.WorkerID),
-		Batch:        base.SqlNullString(e.BatchName),
-		UserEmail:    base.SqlNullString(e.UserEmail),
-		CommitUri:    e.CommitURI,
-		CommitHash:   e.CommitHash,
-		CommitBranch: e.CommitBranch,
-		CommitTag:    e.CommitTag,
-		State:        e.State,
-		Pkg:          e.PackageName,
+		Name:          e.Name,
+		EntryId:       e.EntryID,
+		CreateTime:    timestamppb.New(e.CreateTime),
+		OsRelease:     e.OSRelease,
+		Sha256Sum:     e.Sha256Sum,
+		Repository:    e.RepositoryName,
+		WorkerId:      base.SqlNullString(e.WorkerID),
+		Batch:         base.SqlNullString(e.BatchName),
+		UserEmail:     base.SqlNullString(e.UserEmail),
+		CommitUri:     e.CommitURI,
+		CommitHash:    e.CommitHash,
+		CommitBranch:  e.CommitBranch,
+		CommitTag:     e.CommitTag,
+		State:         e.State,
+		Pkg:           e.PackageName,
+		ErrorMessage:  e.ErrorMessage,
+		BugtrackerUri: base.SqlNullString(e.BugtrackerURI),
 	}
 }
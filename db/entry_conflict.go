@@ -0,0 +1,56 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/openela/mothership/base"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// EntryConflict is a conflict between an entry and an earlier entry with the
+// same entry ID and OS release, but a different checksum.
+type EntryConflict struct {
+	PikaTableName      string `pika:"entry_conflicts"`
+	PikaDefaultOrderBy string `pika:"-create_time"`
+
+	Name                 string         `db:"name"`
+	CreateTime           time.Time      `db:"create_time" pika:"omitempty"`
+	EntryName            string         `db:"entry_name"`
+	ConflictingEntryName string         `db:"conflicting_entry_name"`
+	HeaderDiffs          string         `db:"header_diffs"`
+	BugtrackerURI        sql.NullString `db:"bugtracker_uri"`
+	ResolveTime          sql.NullTime   `db:"resolve_time"`
+	ChosenEntryName      sql.NullString `db:"chosen_entry_name"`
+	BugtrackerConfigName sql.NullString `db:"bugtracker_config_name"`
+}
+
+// EntryConflictName returns the name of the conflict of entry.
+func EntryConflictName(entry string) string {
+	return entry + "/conflict"
+}
+
+func (e *EntryConflict) GetID() string {
+	return e.Name
+}
+
+func (e *EntryConflict) ToPB() *mothershippb.EntryConflict {
+	// The diffs are always written by the worker server
+	var headerDiffs []*mothershippb.HeaderDiff
+	_ = json.Unmarshal([]byte(e.HeaderDiffs), &headerDiffs)
+
+	return &mothershippb.EntryConflict{
+		Entry:            e.EntryName,
+		ConflictingEntry: e.ConflictingEntryName,
+		CreateTime:       timestamppb.New(e.CreateTime),
+		HeaderDiffs:      headerDiffs,
+		BugtrackerUri:    e.BugtrackerURI.String,
+		ResolveTime:      base.SqlNullTime(e.ResolveTime),
+		ChosenEntry:      e.ChosenEntryName.String,
+	}
+}
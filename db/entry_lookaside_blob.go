@@ -0,0 +1,30 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_db
+
+import "time"
+
+// EntryLookasideBlob is a lookaside blob referenced by the metadata an
+// entry committed.
+type EntryLookasideBlob struct {
+	PikaTableName      string `pika:"entry_lookaside_blobs"`
+	PikaDefaultOrderBy string `pika:"file"`
+
+	Name       string    `db:"name"`
+	EntryName  string    `db:"entry_name"`
+	File       string    `db:"file"`
+	Sha256     string    `db:"sha256"`
+	Sha512     string    `db:"sha512"`
+	CreateTime time.Time `db:"create_time" pika:"omitempty"`
+}
+
+// EntryLookasideBlobName returns the name of the blob of entry with the
+// given SHA-256 hash.
+func EntryLookasideBlobName(entry string, sha256 string) string {
+	return entry + "/lookaside/" + sha256
+}
+
+func (e *EntryLookasideBlob) GetID() string {
+	return e.Name
+}
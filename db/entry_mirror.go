@@ -0,0 +1,42 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_db
+
+import (
+	"time"
+
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// EntryMirror is the status of an entry on a mirror forge.
+type EntryMirror struct {
+	PikaTableName      string `pika:"entry_mirrors"`
+	PikaDefaultOrderBy string `pika:"mirror"`
+
+	Name       string    `db:"name"`
+	EntryName  string    `db:"entry_name"`
+	Mirror     string    `db:"mirror"`
+	UpdateTime time.Time `db:"update_time"`
+	Synced     bool      `db:"synced"`
+	Error      string    `db:"error"`
+}
+
+// EntryMirrorName returns the name of the status of entry on mirror.
+func EntryMirrorName(entry string, mirror string) string {
+	return entry + "/mirrors/" + mirror
+}
+
+func (e *EntryMirror) GetID() string {
+	return e.Name
+}
+
+func (e *EntryMirror) ToPB() *mothershippb.MirrorStatus {
+	return &mothershippb.MirrorStatus{
+		Mirror:     e.Mirror,
+		Synced:     e.Synced,
+		Error:      e.Error,
+		UpdateTime: timestamppb.New(e.UpdateTime),
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_db
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// LogCheckpoint is a signed tree head of the transparency log.
+type LogCheckpoint struct {
+	PikaTableName      string `pika:"log_checkpoints"`
+	PikaDefaultOrderBy string `pika:"-tree_size"`
+
+	Name       string    `db:"name"`
+	TreeSize   int64     `db:"tree_size"`
+	CreateTime time.Time `db:"create_time" pika:"omitempty"`
+	RootHash   string    `db:"root_hash"`
+	Checkpoint string    `db:"checkpoint"`
+	KeyID      string    `db:"key_id"`
+	Signature  string    `db:"signature"`
+}
+
+// LogCheckpointName returns the name of the checkpoint of the tree of size.
+func LogCheckpointName(size int64) string {
+	return fmt.Sprintf("log/checkpoints/%d", size)
+}
+
+func (l *LogCheckpoint) GetID() string {
+	return l.Name
+}
+
+func (l *LogCheckpoint) ToPB() *mothershippb.LogCheckpoint {
+	// The hash is always written hex encoded
+	rootHash, _ := hex.DecodeString(l.RootHash)
+
+	return &mothershippb.LogCheckpoint{
+		TreeSize:   l.TreeSize,
+		RootHash:   rootHash,
+		CreateTime: timestamppb.New(l.CreateTime),
+		Checkpoint: l.Checkpoint,
+		KeyId:      l.KeyID,
+		Signature:  l.Signature,
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_db
+
+import (
+	"fmt"
+	"time"
+
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// LogLeaf is a leaf of the transparency log.
+// The log is append-only, leaves are never updated or deleted.
+type LogLeaf struct {
+	PikaTableName      string `pika:"log_leaves"`
+	PikaDefaultOrderBy string `pika:"leaf_index"`
+
+	Name       string                   `db:"name"`
+	LeafIndex  int64                    `db:"leaf_index"`
+	CreateTime time.Time                `db:"create_time" pika:"omitempty"`
+	EntryName  string                   `db:"entry_name"`
+	State      mothershippb.Entry_State `db:"state"`
+	Data       string                   `db:"data"`
+	LeafHash   string                   `db:"leaf_hash"`
+}
+
+// LogLeafName returns the name of the leaf at index.
+func LogLeafName(index int64) string {
+	return fmt.Sprintf("log/leaves/%d", index)
+}
+
+func (l *LogLeaf) GetID() string {
+	return l.Name
+}
+
+func (l *LogLeaf) ToPB() *mothershippb.LogLeaf {
+	return &mothershippb.LogLeaf{
+		LeafIndex:  l.LeafIndex,
+		CreateTime: timestamppb.New(l.CreateTime),
+		Entry:      l.EntryName,
+		State:      l.State,
+		Data:       []byte(l.Data),
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_db
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/openela/mothership/base"
+	"github.com/openela/mothership/base/tlog"
+	"github.com/pkg/errors"
+)
+
+// LogNode is the hash of a complete subtree of the transparency log.
+// Nodes are created when the leaf that completes them is appended, and are
+// never updated or deleted.
+type LogNode struct {
+	PikaTableName      string `pika:"log_nodes"`
+	PikaDefaultOrderBy string `pika:"level"`
+
+	Name       string    `db:"name"`
+	Level      int       `db:"level"`
+	NodeIndex  int64     `db:"node_index"`
+	CreateTime time.Time `db:"create_time" pika:"omitempty"`
+	Hash       string    `db:"hash"`
+}
+
+// LogNodeName returns the name of the node at index on level.
+func LogNodeName(level int, index int64) string {
+	return fmt.Sprintf("log/nodes/%d/%d", level, index)
+}
+
+func (l *LogNode) GetID() string {
+	return l.Name
+}
+
+// LogHashReader reads the hashes of the transparency log.
+// The nodes of level 0 are read from the leaves. A node that is missing,
+// because its append was interrupted, is computed from its children.
+type LogHashReader struct {
+	DB *base.DB
+}
+
+func (r *LogHashReader) ReadHashes(ids []tlog.NodeID) ([][]byte, error) {
+	hashes := make([][]byte, 0, len(ids))
+	for _, id := range ids {
+		hash, err := r.readHash(id)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+
+	return hashes, nil
+}
+
+func (r *LogHashReader) readHash(id tlog.NodeID) ([]byte, error) {
+	if id.Level == 0 {
+		leaf, err := base.Q[LogLeaf](r.DB).F("name", LogLeafName(id.Index)).GetOrNil()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get log leaf")
+		}
+		if leaf == nil {
+			return nil, errors.Errorf("log leaf %d not found", id.Index)
+		}
+
+		hash, err := hex.DecodeString(leaf.LeafHash)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid hash of leaf %d", id.Index)
+		}
+		return hash, nil
+	}
+
+	node, err := base.Q[LogNode](r.DB).F("name", LogNodeName(id.Level, id.Index)).GetOrNil()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get log node")
+	}
+	if node == nil {
+		left, err := r.readHash(tlog.NodeID{Level: id.Level - 1, Index: id.Index * 2})
+		if err != nil {
+			return nil, err
+		}
+		right, err := r.readHash(tlog.NodeID{Level: id.Level - 1, Index: id.Index*2 + 1})
+		if err != nil {
+			return nil, err
+		}
+		return tlog.NodeHash(left, right), nil
+	}
+
+	hash, err := hex.DecodeString(node.Hash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid hash of node %d/%d", id.Level, id.Index)
+	}
+	return hash, nil
+}
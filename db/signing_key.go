@@ -0,0 +1,39 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_db
+
+import (
+	"time"
+
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SigningKey is a key that imports were signed with.
+// Keys are never removed, so commits signed with a rotated key can still be
+// verified.
+type SigningKey struct {
+	PikaTableName      string `pika:"signing_keys"`
+	PikaDefaultOrderBy string `pika:"-create_time"`
+
+	Name        string    `db:"name"`
+	CreateTime  time.Time `db:"create_time" pika:"omitempty"`
+	Format      string    `db:"format"`
+	Fingerprint string    `db:"fingerprint"`
+	PublicKey   string    `db:"public_key"`
+}
+
+func (s *SigningKey) GetID() string {
+	return s.Name
+}
+
+func (s *SigningKey) ToPB() *mothershippb.SigningKey {
+	return &mothershippb.SigningKey{
+		Name:        s.Name,
+		CreateTime:  timestamppb.New(s.CreateTime),
+		Format:      s.Format,
+		Fingerprint: s.Fingerprint,
+		PublicKey:   s.PublicKey,
+	}
+}
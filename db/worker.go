@@ -20,6 +20,7 @@ type Worker struct {
 	WorkerID        string       `db:"worker_id"`
 	LastCheckinTime sql.NullTime `db:"last_checkin_time"`
 	ApiSecret       string       `db:"api_secret"`
+	Organization    string       `db:"organization"`
 }
 
 func (w *Worker) GetID() string {
@@ -32,5 +33,6 @@ func (w *Worker) ToPB() *mshipadminpb.Worker {
 		WorkerId:        w.WorkerID,
 		CreateTime:      timestamppb.New(w.CreateTime),
 		LastCheckinTime: base.SqlNullTime(w.LastCheckinTime),
+		Organization:    w.Organization,
 	}
 }
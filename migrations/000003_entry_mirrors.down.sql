@@ -0,0 +1,4 @@
+-- Copyright 2024 The Mothership Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+DROP TABLE IF EXISTS entry_mirrors;
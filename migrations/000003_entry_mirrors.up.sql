@@ -0,0 +1,14 @@
+-- Copyright 2024 The Mothership Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+CREATE TABLE entry_mirrors
+(
+    name        VARCHAR(255) PRIMARY KEY,
+    entry_name  VARCHAR(255) REFERENCES entries (name) ON DELETE CASCADE NOT NULL,
+    mirror      VARCHAR(255)                                            NOT NULL,
+    update_time TIMESTAMPTZ                                             NOT NULL DEFAULT NOW(),
+    synced      BOOLEAN                                                 NOT NULL,
+    error       TEXT                                                    NOT NULL
+);
+
+CREATE INDEX entry_mirrors_unsynced_idx ON entry_mirrors (mirror) WHERE NOT synced;
@@ -0,0 +1,11 @@
+-- Copyright 2024 The Mothership Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+CREATE TABLE signing_keys
+(
+    name        VARCHAR(255) PRIMARY KEY,
+    create_time TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    format      TEXT        NOT NULL,
+    fingerprint TEXT        NOT NULL,
+    public_key  TEXT        NOT NULL
+);
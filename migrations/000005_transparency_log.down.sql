@@ -0,0 +1,6 @@
+-- Copyright 2024 The Mothership Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+DROP TABLE IF EXISTS log_checkpoints;
+DROP TABLE IF EXISTS log_leaves;
+DROP FUNCTION IF EXISTS reject_log_modification;
@@ -0,0 +1,46 @@
+-- Copyright 2024 The Mothership Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+CREATE TABLE log_leaves
+(
+    name        VARCHAR(255) PRIMARY KEY,
+    leaf_index  BIGINT       NOT NULL UNIQUE,
+    create_time TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    entry_name  VARCHAR(255) NOT NULL,
+    state       NUMERIC      NOT NULL,
+    data        TEXT         NOT NULL,
+    leaf_hash   VARCHAR(64)  NOT NULL
+);
+
+CREATE INDEX log_leaves_entry_name_idx ON log_leaves (entry_name);
+
+CREATE TABLE log_checkpoints
+(
+    name        VARCHAR(255) PRIMARY KEY,
+    tree_size   BIGINT      NOT NULL UNIQUE,
+    create_time TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    root_hash   VARCHAR(64) NOT NULL,
+    checkpoint  TEXT        NOT NULL,
+    key_id      TEXT        NOT NULL,
+    signature   TEXT        NOT NULL
+);
+
+-- The log is append-only, rows can never be changed or removed
+CREATE FUNCTION reject_log_modification() RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'transparency log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER log_leaves_append_only
+    BEFORE UPDATE OR DELETE
+    ON log_leaves
+    FOR EACH ROW
+EXECUTE FUNCTION reject_log_modification();
+
+CREATE TRIGGER log_checkpoints_append_only
+    BEFORE UPDATE OR DELETE
+    ON log_checkpoints
+    FOR EACH ROW
+EXECUTE FUNCTION reject_log_modification();
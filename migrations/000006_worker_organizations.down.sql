@@ -0,0 +1,5 @@
+-- Copyright 2024 The Mothership Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+ALTER TABLE workers
+    DROP COLUMN IF EXISTS organization;
@@ -0,0 +1,14 @@
+-- Copyright 2024 The Mothership Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+CREATE TABLE entry_conflicts
+(
+    name                   VARCHAR(255) PRIMARY KEY,
+    create_time            TIMESTAMPTZ                                             NOT NULL DEFAULT NOW(),
+    entry_name             VARCHAR(255) REFERENCES entries (name) ON DELETE CASCADE NOT NULL UNIQUE,
+    conflicting_entry_name VARCHAR(255) REFERENCES entries (name) ON DELETE CASCADE NOT NULL,
+    header_diffs           TEXT                                                    NOT NULL,
+    bugtracker_uri         TEXT,
+    resolve_time           TIMESTAMPTZ,
+    chosen_entry_name      VARCHAR(255)
+);
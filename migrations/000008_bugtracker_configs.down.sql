@@ -0,0 +1,13 @@
+-- Copyright 2024 The Mothership Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+ALTER TABLE entry_conflicts
+    DROP COLUMN IF EXISTS bugtracker_config_name;
+
+ALTER TABLE batches
+    DROP COLUMN IF EXISTS bugtracker_config_name;
+
+DROP INDEX IF EXISTS bugtracker_configs_active_idx;
+
+ALTER TABLE bugtracker_configs
+    DROP COLUMN IF EXISTS active;
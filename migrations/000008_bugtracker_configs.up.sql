@@ -0,0 +1,15 @@
+-- Copyright 2024 The Mothership Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+ALTER TABLE bugtracker_configs
+    ADD COLUMN active BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Only one bug tracker config can be active
+CREATE UNIQUE INDEX bugtracker_configs_active_idx ON bugtracker_configs (active) WHERE active;
+
+-- Tickets are updated in the bug tracker that created them
+ALTER TABLE batches
+    ADD COLUMN bugtracker_config_name VARCHAR(255);
+
+ALTER TABLE entry_conflicts
+    ADD COLUMN bugtracker_config_name VARCHAR(255);
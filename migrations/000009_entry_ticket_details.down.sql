@@ -0,0 +1,7 @@
+-- Copyright 2024 The Mothership Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+ALTER TABLE entries
+    DROP COLUMN IF EXISTS state_change_time,
+    DROP COLUMN IF EXISTS error_message,
+    DROP COLUMN IF EXISTS nevra;
@@ -0,0 +1,7 @@
+-- Copyright 2024 The Mothership Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+ALTER TABLE entries
+    ADD COLUMN nevra             TEXT        NOT NULL DEFAULT '',
+    ADD COLUMN error_message     TEXT        NOT NULL DEFAULT '',
+    ADD COLUMN state_change_time TIMESTAMPTZ NOT NULL DEFAULT NOW();
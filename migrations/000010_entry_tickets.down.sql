@@ -0,0 +1,6 @@
+-- Copyright 2024 The Mothership Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+ALTER TABLE entries
+    DROP COLUMN IF EXISTS bugtracker_config_name,
+    DROP COLUMN IF EXISTS bugtracker_uri;
@@ -0,0 +1,7 @@
+-- Copyright 2024 The Mothership Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- Entries that fail to import can have a ticket of their own
+ALTER TABLE entries
+    ADD COLUMN bugtracker_uri         VARCHAR(255),
+    ADD COLUMN bugtracker_config_name VARCHAR(255);
@@ -0,0 +1,19 @@
+-- Copyright 2024 The Mothership Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- Lookaside blobs referenced by the metadata an entry committed.
+-- The lookaside endpoint only serves blobs of archived entries. The blobs of
+-- entries archived before this table existed are recorded by the worker
+-- server, see BackfillLookasideBlobsWorkflow.
+CREATE TABLE entry_lookaside_blobs
+(
+    name        VARCHAR(255) PRIMARY KEY,
+    entry_name  VARCHAR(255) REFERENCES entries (name) ON DELETE CASCADE NOT NULL,
+    file        TEXT                                                    NOT NULL,
+    sha256      VARCHAR(64)                                             NOT NULL,
+    sha512      VARCHAR(128)                                            NOT NULL,
+    create_time TIMESTAMPTZ                                             NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX entry_lookaside_blobs_sha256_idx ON entry_lookaside_blobs (sha256);
+CREATE INDEX entry_lookaside_blobs_sha512_idx ON entry_lookaside_blobs (sha512);
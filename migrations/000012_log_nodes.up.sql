@@ -0,0 +1,50 @@
+-- Copyright 2024 The Mothership Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- Hashes of the complete subtrees of the transparency log, so that tree
+-- heads and proofs only read O(log n) hashes. The leaves are the nodes of
+-- level 0, and aren't stored again.
+CREATE TABLE log_nodes
+(
+    name        VARCHAR(255) PRIMARY KEY,
+    level       INT         NOT NULL,
+    node_index  BIGINT      NOT NULL,
+    create_time TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    hash        VARCHAR(64) NOT NULL,
+    UNIQUE (level, node_index)
+);
+
+-- Backfill the nodes of the existing leaves, one level at a time
+DO
+$$
+    DECLARE
+        lvl INT := 1;
+    BEGIN
+        LOOP
+            WITH children AS (SELECT leaf_index AS idx, leaf_hash AS hash
+                              FROM log_leaves
+                              WHERE lvl = 1
+                              UNION ALL
+                              SELECT node_index, hash
+                              FROM log_nodes
+                              WHERE level = lvl - 1)
+            INSERT
+            INTO log_nodes (name, level, node_index, hash)
+            SELECT 'log/nodes/' || lvl || '/' || l.idx / 2,
+                   lvl,
+                   l.idx / 2,
+                   encode(sha256('\x01'::BYTEA || decode(l.hash, 'hex') || decode(r.hash, 'hex')), 'hex')
+            FROM children l
+                     JOIN children r ON r.idx = l.idx + 1
+            WHERE l.idx % 2 = 0;
+            EXIT WHEN NOT FOUND;
+            lvl := lvl + 1;
+        END LOOP;
+    END
+$$;
+
+CREATE TRIGGER log_nodes_append_only
+    BEFORE UPDATE OR DELETE
+    ON log_nodes
+    FOR EACH ROW
+EXECUTE FUNCTION reject_log_modification();
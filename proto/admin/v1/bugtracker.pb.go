@@ -7,8 +7,10 @@
 package mshipadminpb
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -28,6 +30,10 @@ const (
 	BugTrackerConfig_UNKNOWN BugTrackerConfig_Type = 0
 	// MantisBT bug tracker.
 	BugTrackerConfig_MANTIS BugTrackerConfig_Type = 1
+	// GitLab issues.
+	BugTrackerConfig_GITLAB BugTrackerConfig_Type = 2
+	// Jira bug tracker.
+	BugTrackerConfig_JIRA BugTrackerConfig_Type = 3
 )
 
 // Enum value maps for BugTrackerConfig_Type.
@@ -35,10 +41,14 @@ var (
 	BugTrackerConfig_Type_name = map[int32]string{
 		0: "UNKNOWN",
 		1: "MANTIS",
+		2: "GITLAB",
+		3: "JIRA",
 	}
 	BugTrackerConfig_Type_value = map[string]int32{
 		"UNKNOWN": 0,
 		"MANTIS":  1,
+		"GITLAB":  2,
+		"JIRA":    3,
 	}
 )
 
@@ -84,9 +94,30 @@ type BugTrackerConfig struct {
 	// Configuration for the bug tracker.
 	//
 	// Types that are assignable to Config:
-	//
 	//	*BugTrackerConfig_Mantis
+	//	*BugTrackerConfig_Gitlab
+	//	*BugTrackerConfig_Jira
 	Config isBugTrackerConfig_Config `protobuf_oneof:"config"`
+	// Output only. The resource name of the bug tracker config.
+	// Format: `bugTrackerConfigs/{bug_tracker_config}`
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// When the bug tracker config was created.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// When the bug tracker config was last updated.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Template of the tickets of import batches.
+	// Defaults to the template of the worker server.
+	TicketTemplate *TicketTemplate `protobuf:"bytes,10,opt,name=ticket_template,json=ticketTemplate,proto3" json:"ticket_template,omitempty"`
+	// Whether a ticket is created for every entry that fails to import, in
+	// addition to the batch ticket.
+	// Tickets of entries are labeled with the package name, and closed once
+	// the entry is rescued.
+	EntryTickets bool `protobuf:"varint,11,opt,name=entry_tickets,json=entryTickets,proto3" json:"entry_tickets,omitempty"`
+	// Whether the bug tracker is used for new tickets.
+	// Only one config can be active, activating a config deactivates the
+	// others. Existing tickets are still updated in the tracker that created
+	// them.
+	Active bool `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
 }
 
 func (x *BugTrackerConfig) Reset() {
@@ -149,6 +180,62 @@ func (x *BugTrackerConfig) GetMantis() *BugTrackerConfig_MantisConfig {
 	return nil
 }
 
+func (x *BugTrackerConfig) GetGitlab() *BugTrackerConfig_GitlabConfig {
+	if x, ok := x.GetConfig().(*BugTrackerConfig_Gitlab); ok {
+		return x.Gitlab
+	}
+	return nil
+}
+
+func (x *BugTrackerConfig) GetJira() *BugTrackerConfig_JiraConfig {
+	if x, ok := x.GetConfig().(*BugTrackerConfig_Jira); ok {
+		return x.Jira
+	}
+	return nil
+}
+
+func (x *BugTrackerConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BugTrackerConfig) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *BugTrackerConfig) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *BugTrackerConfig) GetTicketTemplate() *TicketTemplate {
+	if x != nil {
+		return x.TicketTemplate
+	}
+	return nil
+}
+
+func (x *BugTrackerConfig) GetEntryTickets() bool {
+	if x != nil {
+		return x.EntryTickets
+	}
+	return false
+}
+
+func (x *BugTrackerConfig) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
 type isBugTrackerConfig_Config interface {
 	isBugTrackerConfig_Config()
 }
@@ -158,8 +245,103 @@ type BugTrackerConfig_Mantis struct {
 	Mantis *BugTrackerConfig_MantisConfig `protobuf:"bytes,3,opt,name=mantis,proto3,oneof"`
 }
 
+type BugTrackerConfig_Gitlab struct {
+	// User-defined configuration for GitLab issues.
+	Gitlab *BugTrackerConfig_GitlabConfig `protobuf:"bytes,8,opt,name=gitlab,proto3,oneof"`
+}
+
+type BugTrackerConfig_Jira struct {
+	// User-defined configuration for Jira.
+	Jira *BugTrackerConfig_JiraConfig `protobuf:"bytes,9,opt,name=jira,proto3,oneof"`
+}
+
 func (*BugTrackerConfig_Mantis) isBugTrackerConfig_Config() {}
 
+func (*BugTrackerConfig_Gitlab) isBugTrackerConfig_Config() {}
+
+func (*BugTrackerConfig_Jira) isBugTrackerConfig_Config() {}
+
+// TicketTemplate customizes the tickets of import batches.
+// Title, body and conditions are Go templates (text/template), rendered with
+// the batch, its entries and their state.
+// Empty fields use the default template.
+type TicketTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Template of the ticket title.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Template of the ticket body.
+	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// Rules of the labels of the ticket.
+	// Replace the default labels if not empty.
+	LabelRules []*TicketTemplate_LabelRule `protobuf:"bytes,3,rep,name=label_rules,json=labelRules,proto3" json:"label_rules,omitempty"`
+	// Template that renders `true` if the ticket is closed, or `false`.
+	// Defaults to closing tickets once all entries are archived.
+	CloseCondition string `protobuf:"bytes,4,opt,name=close_condition,json=closeCondition,proto3" json:"close_condition,omitempty"`
+}
+
+func (x *TicketTemplate) Reset() {
+	*x = TicketTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_admin_v1_bugtracker_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TicketTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketTemplate) ProtoMessage() {}
+
+func (x *TicketTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_v1_bugtracker_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketTemplate.ProtoReflect.Descriptor instead.
+func (*TicketTemplate) Descriptor() ([]byte, []int) {
+	return file_proto_admin_v1_bugtracker_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TicketTemplate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TicketTemplate) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *TicketTemplate) GetLabelRules() []*TicketTemplate_LabelRule {
+	if x != nil {
+		return x.LabelRules
+	}
+	return nil
+}
+
+func (x *TicketTemplate) GetCloseCondition() string {
+	if x != nil {
+		return x.CloseCondition
+	}
+	return ""
+}
+
 // Configuration options for MantisBT
 type BugTrackerConfig_MantisConfig struct {
 	state         protoimpl.MessageState
@@ -167,6 +349,7 @@ type BugTrackerConfig_MantisConfig struct {
 	unknownFields protoimpl.UnknownFields
 
 	// API key for the bug tracker.
+	// Masked when read.
 	ApiKey string `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
 	// Project ID mapping.
 	// Maps major version to project ID.
@@ -176,7 +359,7 @@ type BugTrackerConfig_MantisConfig struct {
 func (x *BugTrackerConfig_MantisConfig) Reset() {
 	*x = BugTrackerConfig_MantisConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_admin_v1_bugtracker_proto_msgTypes[1]
+		mi := &file_proto_admin_v1_bugtracker_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -189,7 +372,7 @@ func (x *BugTrackerConfig_MantisConfig) String() string {
 func (*BugTrackerConfig_MantisConfig) ProtoMessage() {}
 
 func (x *BugTrackerConfig_MantisConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_v1_bugtracker_proto_msgTypes[1]
+	mi := &file_proto_admin_v1_bugtracker_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -219,24 +402,273 @@ func (x *BugTrackerConfig_MantisConfig) GetProjectIds() map[int32]int64 {
 	return nil
 }
 
+// Configuration options for GitLab issues
+type BugTrackerConfig_GitlabConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Access token for the project.
+	// Masked when read.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Path of the project issues are created in.
+	// Example: `openela/bugs`
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+}
+
+func (x *BugTrackerConfig_GitlabConfig) Reset() {
+	*x = BugTrackerConfig_GitlabConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_admin_v1_bugtracker_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BugTrackerConfig_GitlabConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BugTrackerConfig_GitlabConfig) ProtoMessage() {}
+
+func (x *BugTrackerConfig_GitlabConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_v1_bugtracker_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BugTrackerConfig_GitlabConfig.ProtoReflect.Descriptor instead.
+func (*BugTrackerConfig_GitlabConfig) Descriptor() ([]byte, []int) {
+	return file_proto_admin_v1_bugtracker_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *BugTrackerConfig_GitlabConfig) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *BugTrackerConfig_GitlabConfig) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+// Configuration options for Jira
+type BugTrackerConfig_JiraConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Email of the user the API token belongs to.
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// API token for the bug tracker.
+	// Masked when read.
+	ApiToken string `protobuf:"bytes,2,opt,name=api_token,json=apiToken,proto3" json:"api_token,omitempty"`
+	// Key of the project issues are created in.
+	ProjectKey string `protobuf:"bytes,3,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// Name of the issue type of created issues.
+	// Defaults to `Bug`.
+	IssueType string `protobuf:"bytes,4,opt,name=issue_type,json=issueType,proto3" json:"issue_type,omitempty"`
+	// Name of the transition that closes an issue.
+	// Defaults to `Done`.
+	CloseTransition string `protobuf:"bytes,5,opt,name=close_transition,json=closeTransition,proto3" json:"close_transition,omitempty"`
+	// Components of the project.
+	// Labels with the name of a component set the component instead.
+	Components []string `protobuf:"bytes,6,rep,name=components,proto3" json:"components,omitempty"`
+}
+
+func (x *BugTrackerConfig_JiraConfig) Reset() {
+	*x = BugTrackerConfig_JiraConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_admin_v1_bugtracker_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BugTrackerConfig_JiraConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BugTrackerConfig_JiraConfig) ProtoMessage() {}
+
+func (x *BugTrackerConfig_JiraConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_v1_bugtracker_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BugTrackerConfig_JiraConfig.ProtoReflect.Descriptor instead.
+func (*BugTrackerConfig_JiraConfig) Descriptor() ([]byte, []int) {
+	return file_proto_admin_v1_bugtracker_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *BugTrackerConfig_JiraConfig) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *BugTrackerConfig_JiraConfig) GetApiToken() string {
+	if x != nil {
+		return x.ApiToken
+	}
+	return ""
+}
+
+func (x *BugTrackerConfig_JiraConfig) GetProjectKey() string {
+	if x != nil {
+		return x.ProjectKey
+	}
+	return ""
+}
+
+func (x *BugTrackerConfig_JiraConfig) GetIssueType() string {
+	if x != nil {
+		return x.IssueType
+	}
+	return ""
+}
+
+func (x *BugTrackerConfig_JiraConfig) GetCloseTransition() string {
+	if x != nil {
+		return x.CloseTransition
+	}
+	return ""
+}
+
+func (x *BugTrackerConfig_JiraConfig) GetComponents() []string {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+// LabelRule adds a label to tickets matching a condition.
+type TicketTemplate_LabelRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Label to add.
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// Template that renders `true` if the label is added, or `false`.
+	// The label is always added if empty.
+	Condition string `protobuf:"bytes,2,opt,name=condition,proto3" json:"condition,omitempty"`
+}
+
+func (x *TicketTemplate_LabelRule) Reset() {
+	*x = TicketTemplate_LabelRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_admin_v1_bugtracker_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TicketTemplate_LabelRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketTemplate_LabelRule) ProtoMessage() {}
+
+func (x *TicketTemplate_LabelRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_v1_bugtracker_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketTemplate_LabelRule.ProtoReflect.Descriptor instead.
+func (*TicketTemplate_LabelRule) Descriptor() ([]byte, []int) {
+	return file_proto_admin_v1_bugtracker_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *TicketTemplate_LabelRule) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TicketTemplate_LabelRule) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
 var File_proto_admin_v1_bugtracker_proto protoreflect.FileDescriptor
 
 var file_proto_admin_v1_bugtracker_proto_rawDesc = []byte{
 	0x0a, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31,
 	0x2f, 0x62, 0x75, 0x67, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x13, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x22, 0xab, 0x03, 0x0a, 0x10, 0x42, 0x75, 0x67, 0x54, 0x72,
-	0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6d, 0x6f, 0x74, 0x68,
-	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x4c, 0x0a,
-	0x06, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8c, 0x09, 0x0a, 0x10, 0x42, 0x75, 0x67,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6d, 0x6f,
+	0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
+	0x4c, 0x0a, 0x06, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4d, 0x61, 0x6e, 0x74, 0x69, 0x73, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x73, 0x12, 0x4c, 0x0a,
+	0x06, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e,
 	0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
 	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4d, 0x61, 0x6e, 0x74, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x73, 0x1a, 0xcb, 0x01, 0x0a, 0x0c,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x48, 0x00, 0x52, 0x06, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x12, 0x46, 0x0a, 0x04, 0x6a,
+	0x69, 0x72, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6d, 0x6f, 0x74, 0x68,
+	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x4a, 0x69, 0x72, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x04, 0x6a,
+	0x69, 0x72, 0x61, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0b,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0,
+	0x41, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x40,
+	0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x4c, 0x0a, 0x0f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x74, 0x68,
+	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x0e,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x1a, 0xcb, 0x01, 0x0a, 0x0c,
 	0x4d, 0x61, 0x6e, 0x74, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x17, 0x0a, 0x07,
 	0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
 	0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x63, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
@@ -249,17 +681,51 @@ var file_proto_admin_v1_bugtracker_proto_rawDesc = []byte{
 	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
 	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
 	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1f, 0x0a, 0x04, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a,
-	0x0a, 0x06, 0x4d, 0x41, 0x4e, 0x54, 0x49, 0x53, 0x10, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x42, 0x6f, 0x0a, 0x1f, 0x6f, 0x72, 0x67, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x65, 0x6c, 0x61, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0f, 0x42, 0x75, 0x67, 0x74, 0x72, 0x61, 0x63,
-	0x6b, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x6c, 0x61, 0x2f, 0x6d,
-	0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x73, 0x68, 0x69, 0x70, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x0c, 0x47, 0x69, 0x74,
+	0x6c, 0x61, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0xca, 0x01, 0x0a, 0x0a, 0x4a, 0x69,
+	0x72, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1b,
+	0x0a, 0x09, 0x61, 0x70, 0x69, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x35, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
+	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d,
+	0x41, 0x4e, 0x54, 0x49, 0x53, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x49, 0x54, 0x4c, 0x41,
+	0x42, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x49, 0x52, 0x41, 0x10, 0x03, 0x42, 0x08, 0x0a,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xf4, 0x01, 0x0a, 0x0e, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x62, 0x6f, 0x64, 0x79, 0x12, 0x4e, 0x0a, 0x0b, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x6f, 0x74, 0x68,
+	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3f, 0x0a,
+	0x09, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f,
+	0x0a, 0x1f, 0x6f, 0x72, 0x67, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x6c, 0x61, 0x2e, 0x6d, 0x6f,
+	0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x42, 0x0f, 0x42, 0x75, 0x67, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x6c, 0x61, 0x2f, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x76, 0x31, 0x3b, 0x6d, 0x73, 0x68, 0x69, 0x70, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -275,22 +741,33 @@ func file_proto_admin_v1_bugtracker_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_admin_v1_bugtracker_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_admin_v1_bugtracker_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_proto_admin_v1_bugtracker_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_proto_admin_v1_bugtracker_proto_goTypes = []interface{}{
 	(BugTrackerConfig_Type)(0),            // 0: mothership.admin.v1.BugTrackerConfig.Type
 	(*BugTrackerConfig)(nil),              // 1: mothership.admin.v1.BugTrackerConfig
-	(*BugTrackerConfig_MantisConfig)(nil), // 2: mothership.admin.v1.BugTrackerConfig.MantisConfig
-	nil,                                   // 3: mothership.admin.v1.BugTrackerConfig.MantisConfig.ProjectIdsEntry
+	(*TicketTemplate)(nil),                // 2: mothership.admin.v1.TicketTemplate
+	(*BugTrackerConfig_MantisConfig)(nil), // 3: mothership.admin.v1.BugTrackerConfig.MantisConfig
+	(*BugTrackerConfig_GitlabConfig)(nil), // 4: mothership.admin.v1.BugTrackerConfig.GitlabConfig
+	(*BugTrackerConfig_JiraConfig)(nil),   // 5: mothership.admin.v1.BugTrackerConfig.JiraConfig
+	nil,                                   // 6: mothership.admin.v1.BugTrackerConfig.MantisConfig.ProjectIdsEntry
+	(*TicketTemplate_LabelRule)(nil),      // 7: mothership.admin.v1.TicketTemplate.LabelRule
+	(*timestamppb.Timestamp)(nil),         // 8: google.protobuf.Timestamp
 }
 var file_proto_admin_v1_bugtracker_proto_depIdxs = []int32{
 	0, // 0: mothership.admin.v1.BugTrackerConfig.type:type_name -> mothership.admin.v1.BugTrackerConfig.Type
-	2, // 1: mothership.admin.v1.BugTrackerConfig.mantis:type_name -> mothership.admin.v1.BugTrackerConfig.MantisConfig
-	3, // 2: mothership.admin.v1.BugTrackerConfig.MantisConfig.project_ids:type_name -> mothership.admin.v1.BugTrackerConfig.MantisConfig.ProjectIdsEntry
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3, // 1: mothership.admin.v1.BugTrackerConfig.mantis:type_name -> mothership.admin.v1.BugTrackerConfig.MantisConfig
+	4, // 2: mothership.admin.v1.BugTrackerConfig.gitlab:type_name -> mothership.admin.v1.BugTrackerConfig.GitlabConfig
+	5, // 3: mothership.admin.v1.BugTrackerConfig.jira:type_name -> mothership.admin.v1.BugTrackerConfig.JiraConfig
+	8, // 4: mothership.admin.v1.BugTrackerConfig.create_time:type_name -> google.protobuf.Timestamp
+	8, // 5: mothership.admin.v1.BugTrackerConfig.update_time:type_name -> google.protobuf.Timestamp
+	2, // 6: mothership.admin.v1.BugTrackerConfig.ticket_template:type_name -> mothership.admin.v1.TicketTemplate
+	7, // 7: mothership.admin.v1.TicketTemplate.label_rules:type_name -> mothership.admin.v1.TicketTemplate.LabelRule
+	6, // 8: mothership.admin.v1.BugTrackerConfig.MantisConfig.project_ids:type_name -> mothership.admin.v1.BugTrackerConfig.MantisConfig.ProjectIdsEntry
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_proto_admin_v1_bugtracker_proto_init() }
@@ -312,6 +789,18 @@ func file_proto_admin_v1_bugtracker_proto_init() {
 			}
 		}
 		file_proto_admin_v1_bugtracker_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TicketTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_admin_v1_bugtracker_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BugTrackerConfig_MantisConfig); i {
 			case 0:
 				return &v.state
@@ -323,9 +812,47 @@ func file_proto_admin_v1_bugtracker_proto_init() {
 				return nil
 			}
 		}
+		file_proto_admin_v1_bugtracker_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BugTrackerConfig_GitlabConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_admin_v1_bugtracker_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BugTrackerConfig_JiraConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_admin_v1_bugtracker_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TicketTemplate_LabelRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_proto_admin_v1_bugtracker_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*BugTrackerConfig_Mantis)(nil),
+		(*BugTrackerConfig_Gitlab)(nil),
+		(*BugTrackerConfig_Jira)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -333,7 +860,7 @@ func file_proto_admin_v1_bugtracker_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_admin_v1_bugtracker_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   3,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
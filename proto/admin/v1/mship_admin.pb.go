@@ -12,6 +12,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -229,6 +230,8 @@ type CreateWorkerRequest struct {
 	// Required. The worker name to use.
 	// This id has to be at least 4 characters long and must be unique.
 	WorkerId string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	// Organization that operates the worker.
+	Organization string `protobuf:"bytes,2,opt,name=organization,proto3" json:"organization,omitempty"`
 }
 
 func (x *CreateWorkerRequest) Reset() {
@@ -270,6 +273,13 @@ func (x *CreateWorkerRequest) GetWorkerId() string {
 	return ""
 }
 
+func (x *CreateWorkerRequest) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
 // DeleteWorkerRequest is the request message for DeleteWorker.
 type DeleteWorkerRequest struct {
 	state         protoimpl.MessageState
@@ -319,6 +329,308 @@ func (x *DeleteWorkerRequest) GetName() string {
 	return ""
 }
 
+// ListBugTrackerConfigsRequest is the request message for ListBugTrackerConfigs.
+type ListBugTrackerConfigsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of bug tracker configs to return.
+	// If not specified, the server will pick an appropriate default.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `ListBugTrackerConfigs` call.
+	// Provide this to retrieve the subsequent page.
+	// When paginating, all other parameters provided to `ListBugTrackerConfigs`
+	// must match the call that provided the page token.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The filter to apply to list of bug tracker configs.
+	// Supports the `name`, `create_time`, `update_time` and `active` fields.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The order to apply to the list of bug tracker configs.
+	// Supports the `name`, `create_time`, `update_time` and `active` fields.
+	// Needs a suffix of either `asc` or `desc`.
+	// Example: `name asc`, `create_time desc`.
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+}
+
+func (x *ListBugTrackerConfigsRequest) Reset() {
+	*x = ListBugTrackerConfigsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBugTrackerConfigsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBugTrackerConfigsRequest) ProtoMessage() {}
+
+func (x *ListBugTrackerConfigsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBugTrackerConfigsRequest.ProtoReflect.Descriptor instead.
+func (*ListBugTrackerConfigsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_v1_mship_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListBugTrackerConfigsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBugTrackerConfigsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListBugTrackerConfigsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListBugTrackerConfigsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+// ListBugTrackerConfigsResponse is the response message for ListBugTrackerConfigs.
+type ListBugTrackerConfigsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The bug tracker configs.
+	BugTrackerConfigs []*BugTrackerConfig `protobuf:"bytes,1,rep,name=bug_tracker_configs,json=bugTrackerConfigs,proto3" json:"bug_tracker_configs,omitempty"`
+	// A token, which can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListBugTrackerConfigsResponse) Reset() {
+	*x = ListBugTrackerConfigsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBugTrackerConfigsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBugTrackerConfigsResponse) ProtoMessage() {}
+
+func (x *ListBugTrackerConfigsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBugTrackerConfigsResponse.ProtoReflect.Descriptor instead.
+func (*ListBugTrackerConfigsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_v1_mship_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListBugTrackerConfigsResponse) GetBugTrackerConfigs() []*BugTrackerConfig {
+	if x != nil {
+		return x.BugTrackerConfigs
+	}
+	return nil
+}
+
+func (x *ListBugTrackerConfigsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// CreateBugTrackerConfigRequest is the request message for CreateBugTrackerConfig.
+type CreateBugTrackerConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. The bug tracker config to create.
+	BugTrackerConfig *BugTrackerConfig `protobuf:"bytes,1,opt,name=bug_tracker_config,json=bugTrackerConfig,proto3" json:"bug_tracker_config,omitempty"`
+}
+
+func (x *CreateBugTrackerConfigRequest) Reset() {
+	*x = CreateBugTrackerConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBugTrackerConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBugTrackerConfigRequest) ProtoMessage() {}
+
+func (x *CreateBugTrackerConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBugTrackerConfigRequest.ProtoReflect.Descriptor instead.
+func (*CreateBugTrackerConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_v1_mship_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateBugTrackerConfigRequest) GetBugTrackerConfig() *BugTrackerConfig {
+	if x != nil {
+		return x.BugTrackerConfig
+	}
+	return nil
+}
+
+// UpdateBugTrackerConfigRequest is the request message for UpdateBugTrackerConfig.
+type UpdateBugTrackerConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. The bug tracker config to update.
+	BugTrackerConfig *BugTrackerConfig `protobuf:"bytes,1,opt,name=bug_tracker_config,json=bugTrackerConfig,proto3" json:"bug_tracker_config,omitempty"`
+	// The fields to update.
+	// Supports `type`, `uri`, `mantis`, `gitlab`, `jira`, `ticket_template`,
+	// `entry_tickets` and `active`.
+	// If not set, all fields are updated.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdateBugTrackerConfigRequest) Reset() {
+	*x = UpdateBugTrackerConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBugTrackerConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBugTrackerConfigRequest) ProtoMessage() {}
+
+func (x *UpdateBugTrackerConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBugTrackerConfigRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBugTrackerConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_v1_mship_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateBugTrackerConfigRequest) GetBugTrackerConfig() *BugTrackerConfig {
+	if x != nil {
+		return x.BugTrackerConfig
+	}
+	return nil
+}
+
+func (x *UpdateBugTrackerConfigRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// DeleteBugTrackerConfigRequest is the request message for DeleteBugTrackerConfig.
+type DeleteBugTrackerConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. The name of the bug tracker config to delete.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteBugTrackerConfigRequest) Reset() {
+	*x = DeleteBugTrackerConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBugTrackerConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBugTrackerConfigRequest) ProtoMessage() {}
+
+func (x *DeleteBugTrackerConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBugTrackerConfigRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBugTrackerConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_v1_mship_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteBugTrackerConfigRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 // RescueEntryImportRequest is the request message for RescueEntryImport.
 type RescueEntryImportRequest struct {
 	state         protoimpl.MessageState
@@ -332,7 +644,7 @@ type RescueEntryImportRequest struct {
 func (x *RescueEntryImportRequest) Reset() {
 	*x = RescueEntryImportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[5]
+		mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -345,7 +657,7 @@ func (x *RescueEntryImportRequest) String() string {
 func (*RescueEntryImportRequest) ProtoMessage() {}
 
 func (x *RescueEntryImportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[5]
+	mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,7 +670,7 @@ func (x *RescueEntryImportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RescueEntryImportRequest.ProtoReflect.Descriptor instead.
 func (*RescueEntryImportRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_v1_mship_admin_proto_rawDescGZIP(), []int{5}
+	return file_proto_admin_v1_mship_admin_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RescueEntryImportRequest) GetName() string {
@@ -368,6 +680,65 @@ func (x *RescueEntryImportRequest) GetName() string {
 	return ""
 }
 
+// ResolveEntryConflictRequest is the request message for ResolveEntryConflict.
+type ResolveEntryConflictRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. The name of the entry in conflict.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The name of the entry to keep, either the entry in conflict
+	// or the entry it conflicts with.
+	ChosenEntry string `protobuf:"bytes,2,opt,name=chosen_entry,json=chosenEntry,proto3" json:"chosen_entry,omitempty"`
+}
+
+func (x *ResolveEntryConflictRequest) Reset() {
+	*x = ResolveEntryConflictRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveEntryConflictRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveEntryConflictRequest) ProtoMessage() {}
+
+func (x *ResolveEntryConflictRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveEntryConflictRequest.ProtoReflect.Descriptor instead.
+func (*ResolveEntryConflictRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_v1_mship_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ResolveEntryConflictRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResolveEntryConflictRequest) GetChosenEntry() string {
+	if x != nil {
+		return x.ChosenEntry
+	}
+	return ""
+}
+
 // RetractEntryRequest is the request message for RetractEntry.
 type RetractEntryRequest struct {
 	state         protoimpl.MessageState
@@ -381,7 +752,7 @@ type RetractEntryRequest struct {
 func (x *RetractEntryRequest) Reset() {
 	*x = RetractEntryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[6]
+		mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -394,7 +765,7 @@ func (x *RetractEntryRequest) String() string {
 func (*RetractEntryRequest) ProtoMessage() {}
 
 func (x *RetractEntryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[6]
+	mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,7 +778,7 @@ func (x *RetractEntryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetractEntryRequest.ProtoReflect.Descriptor instead.
 func (*RetractEntryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_v1_mship_admin_proto_rawDescGZIP(), []int{6}
+	return file_proto_admin_v1_mship_admin_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *RetractEntryRequest) GetName() string {
@@ -430,7 +801,7 @@ type RetractEntryResponse struct {
 func (x *RetractEntryResponse) Reset() {
 	*x = RetractEntryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[7]
+		mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -443,7 +814,7 @@ func (x *RetractEntryResponse) String() string {
 func (*RetractEntryResponse) ProtoMessage() {}
 
 func (x *RetractEntryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[7]
+	mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -456,7 +827,7 @@ func (x *RetractEntryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetractEntryResponse.ProtoReflect.Descriptor instead.
 func (*RetractEntryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_v1_mship_admin_proto_rawDescGZIP(), []int{7}
+	return file_proto_admin_v1_mship_admin_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *RetractEntryResponse) GetName() string {
@@ -481,7 +852,7 @@ type RetractEntryMetadata struct {
 func (x *RetractEntryMetadata) Reset() {
 	*x = RetractEntryMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[8]
+		mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -494,7 +865,7 @@ func (x *RetractEntryMetadata) String() string {
 func (*RetractEntryMetadata) ProtoMessage() {}
 
 func (x *RetractEntryMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[8]
+	mi := &file_proto_admin_v1_mship_admin_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -507,7 +878,7 @@ func (x *RetractEntryMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetractEntryMetadata.ProtoReflect.Descriptor instead.
 func (*RetractEntryMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_admin_v1_mship_admin_proto_rawDescGZIP(), []int{8}
+	return file_proto_admin_v1_mship_admin_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RetractEntryMetadata) GetStartTime() *timestamppb.Timestamp {
@@ -540,112 +911,223 @@ var file_proto_admin_v1_mship_admin_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x67, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
-	0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x2b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x83, 0x01, 0x0a,
-	0x12, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x42, 0x79, 0x22, 0x74, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x77, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x6f, 0x74,
-	0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73,
-	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
-	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x37, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
+	0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x67, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x2b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x83, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0x74, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07,
+	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5b, 0x0a, 0x13, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2e, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65,
 	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x20, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0x2e, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x33, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x63, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2e, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x14, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x0a, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x32, 0xb0, 0x06,
-	0x0a, 0x0a, 0x4d, 0x73, 0x68, 0x69, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x74, 0x0a, 0x09,
-	0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x6d, 0x6f, 0x74, 0x68,
+	0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
+	0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a,
+	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0x9e, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x13, 0x62, 0x75,
+	0x67, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75,
+	0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11,
+	0x62, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x79, 0x0a, 0x1d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x42, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x12, 0x62, 0x75,
+	0x67, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x67,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x03, 0xe0,
+	0x41, 0x02, 0x52, 0x10, 0x62, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x22, 0xb6, 0x01, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
+	0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x12, 0x62, 0x75, 0x67, 0x5f, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x10,
+	0x62, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x38, 0x0a,
+	0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
+	0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x33, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x63, 0x75,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5e, 0x0a, 0x1b,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x5f, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
+	0x0b, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x2e, 0x0a, 0x13,
+	0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x14,
+	0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x32, 0xb2, 0x0d, 0x0a, 0x0a, 0x4d, 0x73, 0x68, 0x69, 0x70, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x12, 0x74, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12,
+	0x25, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x22, 0x23, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0x75, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12,
+	0x79, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12,
+	0x28, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x6f, 0x74, 0x68,
 	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x22, 0x23, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
-	0x3d, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x2f, 0x2a, 0x7d, 0xda, 0x41, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x75, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x73, 0x12, 0x27, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x6f, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x22, 0x22, 0xda, 0x41, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f,
+	0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x75, 0x0a, 0x0c, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x6d, 0x6f, 0x74,
 	0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76,
-	0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x79, 0x0a, 0x0c, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x6d, 0x6f, 0x74, 0x68,
-	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0xda, 0x41, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x12, 0x75, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69,
-	0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14,
-	0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x73, 0x2f, 0x2a, 0x7d, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x11,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x23, 0xda, 0x41,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f, 0x76, 0x31,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x2f, 0x2a,
+	0x7d, 0x12, 0x9d, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x67, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x31, 0x2e, 0x6d, 0x6f,
+	0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32,
+	0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f,
+	0x62, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x73, 0x12, 0xbb, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x67, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x2e, 0x6d,
+	0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x46, 0xda, 0x41, 0x12, 0x62, 0x75, 0x67, 0x5f,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x12, 0x62, 0x75, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75,
+	0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12,
+	0xe3, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x67, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x2e, 0x6d, 0x6f, 0x74,
+	0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x6e, 0xda, 0x41, 0x1e, 0x62, 0x75, 0x67, 0x5f, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2c, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x47, 0x3a, 0x12,
+	0x62, 0x75, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x32, 0x31, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x62, 0x75, 0x67, 0x5f, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x6e, 0x61, 0x6d, 0x65,
+	0x3d, 0x62, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0x93, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x32, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x67,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2d, 0xda, 0x41,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x2a, 0x1e, 0x2f, 0x76, 0x31,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x62, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2f, 0x2a, 0x7d, 0x12, 0x8c, 0x01, 0x0a, 0x11,
 	0x52, 0x65, 0x73, 0x63, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72,
 	0x74, 0x12, 0x2d, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61,
 	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x75, 0x65, 0x45, 0x6e,
 	0x74, 0x72, 0x79, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23,
-	0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x65, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x72, 0x65, 0x73, 0x63, 0x75, 0x65, 0x49, 0x6d, 0x70,
-	0x6f, 0x72, 0x74, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0xb3, 0x01, 0x0a, 0x0c, 0x52,
-	0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x28, 0x2e, 0x6d, 0x6f,
-	0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c,
-	0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x1c, 0x2f, 0x76,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x30, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x3d, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x2a, 0x7d, 0x3a, 0x72, 0x65,
+	0x73, 0x63, 0x75, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0xa5, 0x01, 0x0a, 0x14, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x12, 0x30, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x43, 0xda,
+	0x41, 0x11, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x5f, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22, 0x24, 0x2f, 0x76,
 	0x31, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2f,
-	0x2a, 0x7d, 0x3a, 0x72, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0xda, 0x41, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0xca, 0x41, 0x2c, 0x0a, 0x14, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x52, 0x65, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x42, 0x6f, 0x0a, 0x1f, 0x6f, 0x72, 0x67, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x6c, 0x61, 0x2e,
-	0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x76, 0x31, 0x42, 0x0f, 0x4d, 0x73, 0x68, 0x69, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x6c, 0x61, 0x2f, 0x6d, 0x6f, 0x74, 0x68, 0x65,
-	0x72, 0x73, 0x68, 0x69, 0x70, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x73, 0x68, 0x69, 0x70, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x70,
-	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2a, 0x7d, 0x3a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x12, 0xb3, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x28, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6c, 0x6f, 0x6e, 0x67, 0x72, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5a, 0xca, 0x41,
+	0x2c, 0x0a, 0x14, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x52, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xda, 0x41, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3d, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x2a, 0x7d,
+	0x3a, 0x72, 0x65, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x6f, 0x0a, 0x1f, 0x6f, 0x72, 0x67, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x65, 0x6c, 0x61, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0f, 0x4d, 0x73, 0x68,
+	0x69, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x65,
+	0x6c, 0x61, 0x2f, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x73, 0x68,
+	0x69, 0x70, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -660,43 +1142,65 @@ func file_proto_admin_v1_mship_admin_proto_rawDescGZIP() []byte {
 	return file_proto_admin_v1_mship_admin_proto_rawDescData
 }
 
-var file_proto_admin_v1_mship_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_admin_v1_mship_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_proto_admin_v1_mship_admin_proto_goTypes = []interface{}{
-	(*GetWorkerRequest)(nil),         // 0: mothership.admin.v1.GetWorkerRequest
-	(*ListWorkersRequest)(nil),       // 1: mothership.admin.v1.ListWorkersRequest
-	(*ListWorkersResponse)(nil),      // 2: mothership.admin.v1.ListWorkersResponse
-	(*CreateWorkerRequest)(nil),      // 3: mothership.admin.v1.CreateWorkerRequest
-	(*DeleteWorkerRequest)(nil),      // 4: mothership.admin.v1.DeleteWorkerRequest
-	(*RescueEntryImportRequest)(nil), // 5: mothership.admin.v1.RescueEntryImportRequest
-	(*RetractEntryRequest)(nil),      // 6: mothership.admin.v1.RetractEntryRequest
-	(*RetractEntryResponse)(nil),     // 7: mothership.admin.v1.RetractEntryResponse
-	(*RetractEntryMetadata)(nil),     // 8: mothership.admin.v1.RetractEntryMetadata
-	(*Worker)(nil),                   // 9: mothership.admin.v1.Worker
-	(*timestamppb.Timestamp)(nil),    // 10: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),            // 11: google.protobuf.Empty
-	(*longrunning.Operation)(nil),    // 12: google.longrunning.Operation
+	(*GetWorkerRequest)(nil),              // 0: mothership.admin.v1.GetWorkerRequest
+	(*ListWorkersRequest)(nil),            // 1: mothership.admin.v1.ListWorkersRequest
+	(*ListWorkersResponse)(nil),           // 2: mothership.admin.v1.ListWorkersResponse
+	(*CreateWorkerRequest)(nil),           // 3: mothership.admin.v1.CreateWorkerRequest
+	(*DeleteWorkerRequest)(nil),           // 4: mothership.admin.v1.DeleteWorkerRequest
+	(*ListBugTrackerConfigsRequest)(nil),  // 5: mothership.admin.v1.ListBugTrackerConfigsRequest
+	(*ListBugTrackerConfigsResponse)(nil), // 6: mothership.admin.v1.ListBugTrackerConfigsResponse
+	(*CreateBugTrackerConfigRequest)(nil), // 7: mothership.admin.v1.CreateBugTrackerConfigRequest
+	(*UpdateBugTrackerConfigRequest)(nil), // 8: mothership.admin.v1.UpdateBugTrackerConfigRequest
+	(*DeleteBugTrackerConfigRequest)(nil), // 9: mothership.admin.v1.DeleteBugTrackerConfigRequest
+	(*RescueEntryImportRequest)(nil),      // 10: mothership.admin.v1.RescueEntryImportRequest
+	(*ResolveEntryConflictRequest)(nil),   // 11: mothership.admin.v1.ResolveEntryConflictRequest
+	(*RetractEntryRequest)(nil),           // 12: mothership.admin.v1.RetractEntryRequest
+	(*RetractEntryResponse)(nil),          // 13: mothership.admin.v1.RetractEntryResponse
+	(*RetractEntryMetadata)(nil),          // 14: mothership.admin.v1.RetractEntryMetadata
+	(*Worker)(nil),                        // 15: mothership.admin.v1.Worker
+	(*BugTrackerConfig)(nil),              // 16: mothership.admin.v1.BugTrackerConfig
+	(*fieldmaskpb.FieldMask)(nil),         // 17: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),         // 18: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                 // 19: google.protobuf.Empty
+	(*longrunning.Operation)(nil),         // 20: google.longrunning.Operation
 }
 var file_proto_admin_v1_mship_admin_proto_depIdxs = []int32{
-	9,  // 0: mothership.admin.v1.ListWorkersResponse.workers:type_name -> mothership.admin.v1.Worker
-	10, // 1: mothership.admin.v1.RetractEntryMetadata.start_time:type_name -> google.protobuf.Timestamp
-	10, // 2: mothership.admin.v1.RetractEntryMetadata.end_time:type_name -> google.protobuf.Timestamp
-	0,  // 3: mothership.admin.v1.MshipAdmin.GetWorker:input_type -> mothership.admin.v1.GetWorkerRequest
-	1,  // 4: mothership.admin.v1.MshipAdmin.ListWorkers:input_type -> mothership.admin.v1.ListWorkersRequest
-	3,  // 5: mothership.admin.v1.MshipAdmin.CreateWorker:input_type -> mothership.admin.v1.CreateWorkerRequest
-	4,  // 6: mothership.admin.v1.MshipAdmin.DeleteWorker:input_type -> mothership.admin.v1.DeleteWorkerRequest
-	5,  // 7: mothership.admin.v1.MshipAdmin.RescueEntryImport:input_type -> mothership.admin.v1.RescueEntryImportRequest
-	6,  // 8: mothership.admin.v1.MshipAdmin.RetractEntry:input_type -> mothership.admin.v1.RetractEntryRequest
-	9,  // 9: mothership.admin.v1.MshipAdmin.GetWorker:output_type -> mothership.admin.v1.Worker
-	2,  // 10: mothership.admin.v1.MshipAdmin.ListWorkers:output_type -> mothership.admin.v1.ListWorkersResponse
-	9,  // 11: mothership.admin.v1.MshipAdmin.CreateWorker:output_type -> mothership.admin.v1.Worker
-	11, // 12: mothership.admin.v1.MshipAdmin.DeleteWorker:output_type -> google.protobuf.Empty
-	11, // 13: mothership.admin.v1.MshipAdmin.RescueEntryImport:output_type -> google.protobuf.Empty
-	12, // 14: mothership.admin.v1.MshipAdmin.RetractEntry:output_type -> google.longrunning.Operation
-	9,  // [9:15] is the sub-list for method output_type
-	3,  // [3:9] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	15, // 0: mothership.admin.v1.ListWorkersResponse.workers:type_name -> mothership.admin.v1.Worker
+	16, // 1: mothership.admin.v1.ListBugTrackerConfigsResponse.bug_tracker_configs:type_name -> mothership.admin.v1.BugTrackerConfig
+	16, // 2: mothership.admin.v1.CreateBugTrackerConfigRequest.bug_tracker_config:type_name -> mothership.admin.v1.BugTrackerConfig
+	16, // 3: mothership.admin.v1.UpdateBugTrackerConfigRequest.bug_tracker_config:type_name -> mothership.admin.v1.BugTrackerConfig
+	17, // 4: mothership.admin.v1.UpdateBugTrackerConfigRequest.update_mask:type_name -> google.protobuf.FieldMask
+	18, // 5: mothership.admin.v1.RetractEntryMetadata.start_time:type_name -> google.protobuf.Timestamp
+	18, // 6: mothership.admin.v1.RetractEntryMetadata.end_time:type_name -> google.protobuf.Timestamp
+	0,  // 7: mothership.admin.v1.MshipAdmin.GetWorker:input_type -> mothership.admin.v1.GetWorkerRequest
+	1,  // 8: mothership.admin.v1.MshipAdmin.ListWorkers:input_type -> mothership.admin.v1.ListWorkersRequest
+	3,  // 9: mothership.admin.v1.MshipAdmin.CreateWorker:input_type -> mothership.admin.v1.CreateWorkerRequest
+	4,  // 10: mothership.admin.v1.MshipAdmin.DeleteWorker:input_type -> mothership.admin.v1.DeleteWorkerRequest
+	5,  // 11: mothership.admin.v1.MshipAdmin.ListBugTrackerConfigs:input_type -> mothership.admin.v1.ListBugTrackerConfigsRequest
+	7,  // 12: mothership.admin.v1.MshipAdmin.CreateBugTrackerConfig:input_type -> mothership.admin.v1.CreateBugTrackerConfigRequest
+	8,  // 13: mothership.admin.v1.MshipAdmin.UpdateBugTrackerConfig:input_type -> mothership.admin.v1.UpdateBugTrackerConfigRequest
+	9,  // 14: mothership.admin.v1.MshipAdmin.DeleteBugTrackerConfig:input_type -> mothership.admin.v1.DeleteBugTrackerConfigRequest
+	10, // 15: mothership.admin.v1.MshipAdmin.RescueEntryImport:input_type -> mothership.admin.v1.RescueEntryImportRequest
+	11, // 16: mothership.admin.v1.MshipAdmin.ResolveEntryConflict:input_type -> mothership.admin.v1.ResolveEntryConflictRequest
+	12, // 17: mothership.admin.v1.MshipAdmin.RetractEntry:input_type -> mothership.admin.v1.RetractEntryRequest
+	15, // 18: mothership.admin.v1.MshipAdmin.GetWorker:output_type -> mothership.admin.v1.Worker
+	2,  // 19: mothership.admin.v1.MshipAdmin.ListWorkers:output_type -> mothership.admin.v1.ListWorkersResponse
+	15, // 20: mothership.admin.v1.MshipAdmin.CreateWorker:output_type -> mothership.admin.v1.Worker
+	19, // 21: mothership.admin.v1.MshipAdmin.DeleteWorker:output_type -> google.protobuf.Empty
+	6,  // 22: mothership.admin.v1.MshipAdmin.ListBugTrackerConfigs:output_type -> mothership.admin.v1.ListBugTrackerConfigsResponse
+	16, // 23: mothership.admin.v1.MshipAdmin.CreateBugTrackerConfig:output_type -> mothership.admin.v1.BugTrackerConfig
+	16, // 24: mothership.admin.v1.MshipAdmin.UpdateBugTrackerConfig:output_type -> mothership.admin.v1.BugTrackerConfig
+	19, // 25: mothership.admin.v1.MshipAdmin.DeleteBugTrackerConfig:output_type -> google.protobuf.Empty
+	19, // 26: mothership.admin.v1.MshipAdmin.RescueEntryImport:output_type -> google.protobuf.Empty
+	19, // 27: mothership.admin.v1.MshipAdmin.ResolveEntryConflict:output_type -> google.protobuf.Empty
+	20, // 28: mothership.admin.v1.MshipAdmin.RetractEntry:output_type -> google.longrunning.Operation
+	18, // [18:29] is the sub-list for method output_type
+	7,  // [7:18] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_proto_admin_v1_mship_admin_proto_init() }
@@ -704,6 +1208,7 @@ func file_proto_admin_v1_mship_admin_proto_init() {
 	if File_proto_admin_v1_mship_admin_proto != nil {
 		return
 	}
+	file_proto_admin_v1_bugtracker_proto_init()
 	file_proto_admin_v1_worker_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_proto_admin_v1_mship_admin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
@@ -767,7 +1272,7 @@ func file_proto_admin_v1_mship_admin_proto_init() {
 			}
 		}
 		file_proto_admin_v1_mship_admin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RescueEntryImportRequest); i {
+			switch v := v.(*ListBugTrackerConfigsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -779,7 +1284,7 @@ func file_proto_admin_v1_mship_admin_proto_init() {
 			}
 		}
 		file_proto_admin_v1_mship_admin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetractEntryRequest); i {
+			switch v := v.(*ListBugTrackerConfigsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -791,7 +1296,7 @@ func file_proto_admin_v1_mship_admin_proto_init() {
 			}
 		}
 		file_proto_admin_v1_mship_admin_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetractEntryResponse); i {
+			switch v := v.(*CreateBugTrackerConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -803,6 +1308,78 @@ func file_proto_admin_v1_mship_admin_proto_init() {
 			}
 		}
 		file_proto_admin_v1_mship_admin_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBugTrackerConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_admin_v1_mship_admin_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBugTrackerConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_admin_v1_mship_admin_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescueEntryImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_admin_v1_mship_admin_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveEntryConflictRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_admin_v1_mship_admin_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetractEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_admin_v1_mship_admin_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetractEntryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_admin_v1_mship_admin_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RetractEntryMetadata); i {
 			case 0:
 				return &v.state
@@ -821,7 +1398,7 @@ func file_proto_admin_v1_mship_admin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_admin_v1_mship_admin_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
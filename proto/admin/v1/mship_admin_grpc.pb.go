@@ -37,10 +37,27 @@ type MshipAdminClient interface {
 	// Deletes a worker
 	// Worker cannot be deleted if it has created an entry.
 	DeleteWorker(ctx context.Context, in *DeleteWorkerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists the bug tracker configs
+	// Secrets are masked.
+	ListBugTrackerConfigs(ctx context.Context, in *ListBugTrackerConfigsRequest, opts ...grpc.CallOption) (*ListBugTrackerConfigsResponse, error)
+	// Creates a bug tracker config
+	CreateBugTrackerConfig(ctx context.Context, in *CreateBugTrackerConfigRequest, opts ...grpc.CallOption) (*BugTrackerConfig, error)
+	// Updates a bug tracker config
+	// Masked or empty secrets keep their current value.
+	UpdateBugTrackerConfig(ctx context.Context, in *UpdateBugTrackerConfigRequest, opts ...grpc.CallOption) (*BugTrackerConfig, error)
+	// Deletes a bug tracker config
+	// The active bug tracker config cannot be deleted.
+	DeleteBugTrackerConfig(ctx context.Context, in *DeleteBugTrackerConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Rescue an entry import attempt
 	// This should be called after fixing patches that caused the import to fail.
 	// This will re-run the import attempt.
 	RescueEntryImport(ctx context.Context, in *RescueEntryImportRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Resolve the conflict of an entry in the `CONFLICT` state
+	// Choosing the entry in conflict retracts the conflicting entry, then
+	// imports the entry in conflict. The import of a conflicting entry that
+	// isn't archived yet must be cancelled first.
+	// Choosing the conflicting entry cancels the entry in conflict.
+	ResolveEntryConflict(ctx context.Context, in *ResolveEntryConflictRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Retract the entry
 	// To be able to retract an entry, the entry must be in the `ARCHIVED` state.
 	// This will allow an NVR to be re-imported.
@@ -91,6 +108,42 @@ func (c *mshipAdminClient) DeleteWorker(ctx context.Context, in *DeleteWorkerReq
 	return out, nil
 }
 
+func (c *mshipAdminClient) ListBugTrackerConfigs(ctx context.Context, in *ListBugTrackerConfigsRequest, opts ...grpc.CallOption) (*ListBugTrackerConfigsResponse, error) {
+	out := new(ListBugTrackerConfigsResponse)
+	err := c.cc.Invoke(ctx, "/mothership.admin.v1.MshipAdmin/ListBugTrackerConfigs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mshipAdminClient) CreateBugTrackerConfig(ctx context.Context, in *CreateBugTrackerConfigRequest, opts ...grpc.CallOption) (*BugTrackerConfig, error) {
+	out := new(BugTrackerConfig)
+	err := c.cc.Invoke(ctx, "/mothership.admin.v1.MshipAdmin/CreateBugTrackerConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mshipAdminClient) UpdateBugTrackerConfig(ctx context.Context, in *UpdateBugTrackerConfigRequest, opts ...grpc.CallOption) (*BugTrackerConfig, error) {
+	out := new(BugTrackerConfig)
+	err := c.cc.Invoke(ctx, "/mothership.admin.v1.MshipAdmin/UpdateBugTrackerConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mshipAdminClient) DeleteBugTrackerConfig(ctx context.Context, in *DeleteBugTrackerConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/mothership.admin.v1.MshipAdmin/DeleteBugTrackerConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mshipAdminClient) RescueEntryImport(ctx context.Context, in *RescueEntryImportRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/mothership.admin.v1.MshipAdmin/RescueEntryImport", in, out, opts...)
@@ -100,6 +153,15 @@ func (c *mshipAdminClient) RescueEntryImport(ctx context.Context, in *RescueEntr
 	return out, nil
 }
 
+func (c *mshipAdminClient) ResolveEntryConflict(ctx context.Context, in *ResolveEntryConflictRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/mothership.admin.v1.MshipAdmin/ResolveEntryConflict", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mshipAdminClient) RetractEntry(ctx context.Context, in *RetractEntryRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
 	out := new(longrunning.Operation)
 	err := c.cc.Invoke(ctx, "/mothership.admin.v1.MshipAdmin/RetractEntry", in, out, opts...)
@@ -126,10 +188,27 @@ type MshipAdminServer interface {
 	// Deletes a worker
 	// Worker cannot be deleted if it has created an entry.
 	DeleteWorker(context.Context, *DeleteWorkerRequest) (*emptypb.Empty, error)
+	// Lists the bug tracker configs
+	// Secrets are masked.
+	ListBugTrackerConfigs(context.Context, *ListBugTrackerConfigsRequest) (*ListBugTrackerConfigsResponse, error)
+	// Creates a bug tracker config
+	CreateBugTrackerConfig(context.Context, *CreateBugTrackerConfigRequest) (*BugTrackerConfig, error)
+	// Updates a bug tracker config
+	// Masked or empty secrets keep their current value.
+	UpdateBugTrackerConfig(context.Context, *UpdateBugTrackerConfigRequest) (*BugTrackerConfig, error)
+	// Deletes a bug tracker config
+	// The active bug tracker config cannot be deleted.
+	DeleteBugTrackerConfig(context.Context, *DeleteBugTrackerConfigRequest) (*emptypb.Empty, error)
 	// Rescue an entry import attempt
 	// This should be called after fixing patches that caused the import to fail.
 	// This will re-run the import attempt.
 	RescueEntryImport(context.Context, *RescueEntryImportRequest) (*emptypb.Empty, error)
+	// Resolve the conflict of an entry in the `CONFLICT` state
+	// Choosing the entry in conflict retracts the conflicting entry, then
+	// imports the entry in conflict. The import of a conflicting entry that
+	// isn't archived yet must be cancelled first.
+	// Choosing the conflicting entry cancels the entry in conflict.
+	ResolveEntryConflict(context.Context, *ResolveEntryConflictRequest) (*emptypb.Empty, error)
 	// Retract the entry
 	// To be able to retract an entry, the entry must be in the `ARCHIVED` state.
 	// This will allow an NVR to be re-imported.
@@ -153,9 +232,24 @@ func (UnimplementedMshipAdminServer) CreateWorker(context.Context, *CreateWorker
 func (UnimplementedMshipAdminServer) DeleteWorker(context.Context, *DeleteWorkerRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorker not implemented")
 }
+func (UnimplementedMshipAdminServer) ListBugTrackerConfigs(context.Context, *ListBugTrackerConfigsRequest) (*ListBugTrackerConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBugTrackerConfigs not implemented")
+}
+func (UnimplementedMshipAdminServer) CreateBugTrackerConfig(context.Context, *CreateBugTrackerConfigRequest) (*BugTrackerConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBugTrackerConfig not implemented")
+}
+func (UnimplementedMshipAdminServer) UpdateBugTrackerConfig(context.Context, *UpdateBugTrackerConfigRequest) (*BugTrackerConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBugTrackerConfig not implemented")
+}
+func (UnimplementedMshipAdminServer) DeleteBugTrackerConfig(context.Context, *DeleteBugTrackerConfigRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBugTrackerConfig not implemented")
+}
 func (UnimplementedMshipAdminServer) RescueEntryImport(context.Context, *RescueEntryImportRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RescueEntryImport not implemented")
 }
+func (UnimplementedMshipAdminServer) ResolveEntryConflict(context.Context, *ResolveEntryConflictRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveEntryConflict not implemented")
+}
 func (UnimplementedMshipAdminServer) RetractEntry(context.Context, *RetractEntryRequest) (*longrunning.Operation, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetractEntry not implemented")
 }
@@ -244,6 +338,78 @@ func _MshipAdmin_DeleteWorker_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MshipAdmin_ListBugTrackerConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBugTrackerConfigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MshipAdminServer).ListBugTrackerConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mothership.admin.v1.MshipAdmin/ListBugTrackerConfigs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MshipAdminServer).ListBugTrackerConfigs(ctx, req.(*ListBugTrackerConfigsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MshipAdmin_CreateBugTrackerConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBugTrackerConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MshipAdminServer).CreateBugTrackerConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mothership.admin.v1.MshipAdmin/CreateBugTrackerConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MshipAdminServer).CreateBugTrackerConfig(ctx, req.(*CreateBugTrackerConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MshipAdmin_UpdateBugTrackerConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBugTrackerConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MshipAdminServer).UpdateBugTrackerConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mothership.admin.v1.MshipAdmin/UpdateBugTrackerConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MshipAdminServer).UpdateBugTrackerConfig(ctx, req.(*UpdateBugTrackerConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MshipAdmin_DeleteBugTrackerConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBugTrackerConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MshipAdminServer).DeleteBugTrackerConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mothership.admin.v1.MshipAdmin/DeleteBugTrackerConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MshipAdminServer).DeleteBugTrackerConfig(ctx, req.(*DeleteBugTrackerConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MshipAdmin_RescueEntryImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RescueEntryImportRequest)
 	if err := dec(in); err != nil {
@@ -262,6 +428,24 @@ func _MshipAdmin_RescueEntryImport_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MshipAdmin_ResolveEntryConflict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveEntryConflictRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MshipAdminServer).ResolveEntryConflict(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mothership.admin.v1.MshipAdmin/ResolveEntryConflict",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MshipAdminServer).ResolveEntryConflict(ctx, req.(*ResolveEntryConflictRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MshipAdmin_RetractEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RetractEntryRequest)
 	if err := dec(in); err != nil {
@@ -303,10 +487,30 @@ var MshipAdmin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteWorker",
 			Handler:    _MshipAdmin_DeleteWorker_Handler,
 		},
+		{
+			MethodName: "ListBugTrackerConfigs",
+			Handler:    _MshipAdmin_ListBugTrackerConfigs_Handler,
+		},
+		{
+			MethodName: "CreateBugTrackerConfig",
+			Handler:    _MshipAdmin_CreateBugTrackerConfig_Handler,
+		},
+		{
+			MethodName: "UpdateBugTrackerConfig",
+			Handler:    _MshipAdmin_UpdateBugTrackerConfig_Handler,
+		},
+		{
+			MethodName: "DeleteBugTrackerConfig",
+			Handler:    _MshipAdmin_DeleteBugTrackerConfig_Handler,
+		},
 		{
 			MethodName: "RescueEntryImport",
 			Handler:    _MshipAdmin_RescueEntryImport_Handler,
 		},
+		{
+			MethodName: "ResolveEntryConflict",
+			Handler:    _MshipAdmin_ResolveEntryConflict_Handler,
+		},
 		{
 			MethodName: "RetractEntry",
 			Handler:    _MshipAdmin_RetractEntry_Handler,
@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: proto/v1/attestation.proto
+
+package mothershippb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AttestationEnvelope is a DSSE envelope holding a signed in-toto statement.
+// The JSON representation is a DSSE envelope, so it can be verified with
+// any DSSE tooling.
+type AttestationEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the payload, "application/vnd.in-toto+json"
+	PayloadType string `protobuf:"bytes,1,opt,name=payload_type,json=payloadType,proto3" json:"payload_type,omitempty"`
+	// The in-toto statement, JSON encoded
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Signatures over the DSSE pre-authentication encoding of the payload.
+	// Never empty, entries aren't attested if the worker server has no
+	// signing key.
+	Signatures []*AttestationSignature `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *AttestationEnvelope) Reset() {
+	*x = AttestationEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_attestation_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttestationEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationEnvelope) ProtoMessage() {}
+
+func (x *AttestationEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_attestation_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestationEnvelope.ProtoReflect.Descriptor instead.
+func (*AttestationEnvelope) Descriptor() ([]byte, []int) {
+	return file_proto_v1_attestation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AttestationEnvelope) GetPayloadType() string {
+	if x != nil {
+		return x.PayloadType
+	}
+	return ""
+}
+
+func (x *AttestationEnvelope) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *AttestationEnvelope) GetSignatures() []*AttestationSignature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+// AttestationSignature is a signature of an attestation.
+type AttestationSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the signing key, see ListSigningKeys
+	Keyid string `protobuf:"bytes,1,opt,name=keyid,proto3" json:"keyid,omitempty"`
+	// Raw OpenPGP or SSH signature, depending on the format of the key.
+	// SSH signatures use the "git" namespace.
+	Sig []byte `protobuf:"bytes,2,opt,name=sig,proto3" json:"sig,omitempty"`
+}
+
+func (x *AttestationSignature) Reset() {
+	*x = AttestationSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_attestation_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttestationSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationSignature) ProtoMessage() {}
+
+func (x *AttestationSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_attestation_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestationSignature.ProtoReflect.Descriptor instead.
+func (*AttestationSignature) Descriptor() ([]byte, []int) {
+	return file_proto_v1_attestation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttestationSignature) GetKeyid() string {
+	if x != nil {
+		return x.Keyid
+	}
+	return ""
+}
+
+func (x *AttestationSignature) GetSig() []byte {
+	if x != nil {
+		return x.Sig
+	}
+	return nil
+}
+
+var File_proto_v1_attestation_proto protoreflect.FileDescriptor
+
+var file_proto_v1_attestation_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6d, 0x6f,
+	0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x22, 0x97, 0x01, 0x0a, 0x13,
+	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x43, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x3e, 0x0a, 0x14, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6b, 0x65, 0x79, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65,
+	0x79, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x73, 0x69, 0x67, 0x42, 0x64, 0x0a, 0x19, 0x6f, 0x72, 0x67, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x65, 0x6c, 0x61, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e,
+	0x76, 0x31, 0x42, 0x10, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x6c, 0x61, 0x2f, 0x6d, 0x6f, 0x74, 0x68, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x6d,
+	0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_v1_attestation_proto_rawDescOnce sync.Once
+	file_proto_v1_attestation_proto_rawDescData = file_proto_v1_attestation_proto_rawDesc
+)
+
+func file_proto_v1_attestation_proto_rawDescGZIP() []byte {
+	file_proto_v1_attestation_proto_rawDescOnce.Do(func() {
+		file_proto_v1_attestation_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_v1_attestation_proto_rawDescData)
+	})
+	return file_proto_v1_attestation_proto_rawDescData
+}
+
+var file_proto_v1_attestation_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_v1_attestation_proto_goTypes = []interface{}{
+	(*AttestationEnvelope)(nil),  // 0: mothership.v1.AttestationEnvelope
+	(*AttestationSignature)(nil), // 1: mothership.v1.AttestationSignature
+}
+var file_proto_v1_attestation_proto_depIdxs = []int32{
+	1, // 0: mothership.v1.AttestationEnvelope.signatures:type_name -> mothership.v1.AttestationSignature
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_attestation_proto_init() }
+func file_proto_v1_attestation_proto_init() {
+	if File_proto_v1_attestation_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_v1_attestation_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttestationEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_attestation_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttestationSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_v1_attestation_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_v1_attestation_proto_goTypes,
+		DependencyIndexes: file_proto_v1_attestation_proto_depIdxs,
+		MessageInfos:      file_proto_v1_attestation_proto_msgTypes,
+	}.Build()
+	File_proto_v1_attestation_proto = out.File
+	file_proto_v1_attestation_proto_rawDesc = nil
+	file_proto_v1_attestation_proto_goTypes = nil
+	file_proto_v1_attestation_proto_depIdxs = nil
+}
@@ -51,6 +51,15 @@ const (
 	// Another import may have happened, retraction is usually done
 	// if debranding was not complete but successful.
 	Entry_RETRACTED Entry_State = 7
+	// The entry is waiting for workers of other organizations to submit
+	// the same SRPM.
+	// If the quorum isn't reached in time, the entry is put "on hold".
+	Entry_AWAITING_QUORUM Entry_State = 8
+	// Another entry with the same entry ID and OS release has a different
+	// checksum.
+	// The entry waits until an admin resolves the conflict by choosing one
+	// of the entries.
+	Entry_CONFLICT Entry_State = 9
 )
 
 // Enum value maps for Entry_State.
@@ -64,6 +73,8 @@ var (
 		5: "FAILED",
 		6: "RETRACTING",
 		7: "RETRACTED",
+		8: "AWAITING_QUORUM",
+		9: "CONFLICT",
 	}
 	Entry_State_value = map[string]int32{
 		"STATE_UNSPECIFIED": 0,
@@ -74,6 +85,8 @@ var (
 		"FAILED":            5,
 		"RETRACTING":        6,
 		"RETRACTED":         7,
+		"AWAITING_QUORUM":   8,
+		"CONFLICT":          9,
 	}
 )
 
@@ -157,8 +170,14 @@ type Entry struct {
 	State Entry_State `protobuf:"varint,14,opt,name=state,proto3,enum=mothership.v1.Entry_State" json:"state,omitempty"`
 	// Name of the package being archived.
 	Pkg string `protobuf:"bytes,15,opt,name=pkg,proto3" json:"pkg,omitempty"`
-	// Error message if on hold
+	// Error message if on hold or failed
 	ErrorMessage string `protobuf:"bytes,16,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// Status of the import on every mirror forge.
+	// Empty if the forge isn't mirrored.
+	Mirrors []*MirrorStatus `protobuf:"bytes,17,rep,name=mirrors,proto3" json:"mirrors,omitempty"`
+	// Output only. Bugtracker URI of the ticket of the failed import.
+	// Only set if the bug tracker creates tickets for failed entries.
+	BugtrackerUri *wrapperspb.StringValue `protobuf:"bytes,18,opt,name=bugtracker_uri,json=bugtrackerUri,proto3" json:"bugtracker_uri,omitempty"`
 }
 
 func (x *Entry) Reset() {
@@ -305,6 +324,97 @@ func (x *Entry) GetErrorMessage() string {
 	return ""
 }
 
+func (x *Entry) GetMirrors() []*MirrorStatus {
+	if x != nil {
+		return x.Mirrors
+	}
+	return nil
+}
+
+func (x *Entry) GetBugtrackerUri() *wrapperspb.StringValue {
+	if x != nil {
+		return x.BugtrackerUri
+	}
+	return nil
+}
+
+// MirrorStatus is the status of an import on a mirror forge
+type MirrorStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the mirror
+	// e.g. gitlab
+	Mirror string `protobuf:"bytes,1,opt,name=mirror,proto3" json:"mirror,omitempty"`
+	// Whether the mirror has the import
+	Synced bool `protobuf:"varint,2,opt,name=synced,proto3" json:"synced,omitempty"`
+	// Error of the last push to the mirror, if it failed
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// When the mirror was last pushed to
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+}
+
+func (x *MirrorStatus) Reset() {
+	*x = MirrorStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_entry_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MirrorStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MirrorStatus) ProtoMessage() {}
+
+func (x *MirrorStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_entry_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MirrorStatus.ProtoReflect.Descriptor instead.
+func (*MirrorStatus) Descriptor() ([]byte, []int) {
+	return file_proto_v1_entry_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MirrorStatus) GetMirror() string {
+	if x != nil {
+		return x.Mirror
+	}
+	return ""
+}
+
+func (x *MirrorStatus) GetSynced() bool {
+	if x != nil {
+		return x.Synced
+	}
+	return false
+}
+
+func (x *MirrorStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MirrorStatus) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
 var File_proto_v1_entry_proto protoreflect.FileDescriptor
 
 var file_proto_v1_entry_proto_rawDesc = []byte{
@@ -316,7 +426,7 @@ var file_proto_v1_entry_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
 	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xba, 0x06, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x07, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72,
 	0x79, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x03, 0xe0, 0x41, 0x03, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x08, 0x65, 0x6e,
 	0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
@@ -359,22 +469,42 @@ var file_proto_v1_entry_proto_rawDesc = []byte{
 	0x0a, 0x03, 0x70, 0x6b, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03,
 	0x52, 0x03, 0x70, 0x6b, 0x67, 0x12, 0x28, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
-	0x03, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x82, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41,
-	0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x0d, 0x0a, 0x09, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
-	0x0c, 0x0a, 0x08, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a,
-	0x07, 0x4f, 0x4e, 0x5f, 0x48, 0x4f, 0x4c, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41,
-	0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49,
-	0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x54, 0x52, 0x41, 0x43, 0x54,
-	0x49, 0x4e, 0x47, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x54, 0x52, 0x41, 0x43, 0x54,
-	0x45, 0x44, 0x10, 0x07, 0x42, 0x5e, 0x0a, 0x19, 0x6f, 0x72, 0x67, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x65, 0x6c, 0x61, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76,
-	0x31, 0x42, 0x0a, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e,
-	0x65, 0x6c, 0x61, 0x2f, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68,
-	0x69, 0x70, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x03, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x3a, 0x0a, 0x07, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x03, 0xe0,
+	0x41, 0x03, 0x52, 0x07, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x0e, 0x62,
+	0x75, 0x67, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0d, 0x62, 0x75, 0x67, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x55, 0x72, 0x69, 0x22, 0xa5, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x4e, 0x5f, 0x48, 0x4f, 0x4c, 0x44, 0x10, 0x03,
+	0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12,
+	0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x52,
+	0x45, 0x54, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x06, 0x12, 0x0d, 0x0a, 0x09, 0x52,
+	0x45, 0x54, 0x52, 0x41, 0x43, 0x54, 0x45, 0x44, 0x10, 0x07, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x57,
+	0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x51, 0x55, 0x4f, 0x52, 0x55, 0x4d, 0x10, 0x08, 0x12,
+	0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x09, 0x22, 0x91, 0x01,
+	0x0a, 0x0c, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x42, 0x5e, 0x0a, 0x19, 0x6f, 0x72, 0x67, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x6c, 0x61,
+	0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0a,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x6c, 0x61,
+	0x2f, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -390,24 +520,28 @@ func file_proto_v1_entry_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_v1_entry_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_v1_entry_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_v1_entry_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_proto_v1_entry_proto_goTypes = []interface{}{
 	(Entry_State)(0),               // 0: mothership.v1.Entry.State
 	(*Entry)(nil),                  // 1: mothership.v1.Entry
-	(*timestamppb.Timestamp)(nil),  // 2: google.protobuf.Timestamp
-	(*wrapperspb.StringValue)(nil), // 3: google.protobuf.StringValue
+	(*MirrorStatus)(nil),           // 2: mothership.v1.MirrorStatus
+	(*timestamppb.Timestamp)(nil),  // 3: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil), // 4: google.protobuf.StringValue
 }
 var file_proto_v1_entry_proto_depIdxs = []int32{
-	2, // 0: mothership.v1.Entry.create_time:type_name -> google.protobuf.Timestamp
-	3, // 1: mothership.v1.Entry.worker_id:type_name -> google.protobuf.StringValue
-	3, // 2: mothership.v1.Entry.batch:type_name -> google.protobuf.StringValue
-	3, // 3: mothership.v1.Entry.user_email:type_name -> google.protobuf.StringValue
+	3, // 0: mothership.v1.Entry.create_time:type_name -> google.protobuf.Timestamp
+	4, // 1: mothership.v1.Entry.worker_id:type_name -> google.protobuf.StringValue
+	4, // 2: mothership.v1.Entry.batch:type_name -> google.protobuf.StringValue
+	4, // 3: mothership.v1.Entry.user_email:type_name -> google.protobuf.StringValue
 	0, // 4: mothership.v1.Entry.state:type_name -> mothership.v1.Entry.State
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	2, // 5: mothership.v1.Entry.mirrors:type_name -> mothership.v1.MirrorStatus
+	4, // 6: mothership.v1.Entry.bugtracker_uri:type_name -> google.protobuf.StringValue
+	3, // 7: mothership.v1.MirrorStatus.update_time:type_name -> google.protobuf.Timestamp
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_proto_v1_entry_proto_init() }
@@ -428,6 +562,18 @@ func file_proto_v1_entry_proto_init() {
 				return nil
 			}
 		}
+		file_proto_v1_entry_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MirrorStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -435,7 +581,7 @@ func file_proto_v1_entry_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_v1_entry_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
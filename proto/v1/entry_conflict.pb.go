@@ -0,0 +1,330 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: proto/v1/entry_conflict.proto
+
+package mothershippb
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EntryConflict is a conflict between an entry and an earlier entry with the
+// same entry ID and OS release, but a different checksum.
+// The entry is in the `CONFLICT` state until an admin chooses one of the
+// two entries.
+type EntryConflict struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the entry in conflict.
+	// For example: "entries/1234".
+	Entry string `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// The name of the earlier entry it conflicts with.
+	ConflictingEntry string `protobuf:"bytes,2,opt,name=conflicting_entry,json=conflictingEntry,proto3" json:"conflicting_entry,omitempty"`
+	// When the conflict was detected.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Header values that differ between the two SRPMs.
+	HeaderDiffs []*HeaderDiff `protobuf:"bytes,4,rep,name=header_diffs,json=headerDiffs,proto3" json:"header_diffs,omitempty"`
+	// URI of the ticket the conflict was reported in.
+	// Empty if no bugtracker is configured.
+	BugtrackerUri string `protobuf:"bytes,5,opt,name=bugtracker_uri,json=bugtrackerUri,proto3" json:"bugtracker_uri,omitempty"`
+	// When the conflict was resolved.
+	ResolveTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=resolve_time,json=resolveTime,proto3" json:"resolve_time,omitempty"`
+	// The entry that was chosen when resolving the conflict.
+	// Empty until the conflict is resolved.
+	ChosenEntry string `protobuf:"bytes,7,opt,name=chosen_entry,json=chosenEntry,proto3" json:"chosen_entry,omitempty"`
+}
+
+func (x *EntryConflict) Reset() {
+	*x = EntryConflict{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_entry_conflict_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntryConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntryConflict) ProtoMessage() {}
+
+func (x *EntryConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_entry_conflict_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntryConflict.ProtoReflect.Descriptor instead.
+func (*EntryConflict) Descriptor() ([]byte, []int) {
+	return file_proto_v1_entry_conflict_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EntryConflict) GetEntry() string {
+	if x != nil {
+		return x.Entry
+	}
+	return ""
+}
+
+func (x *EntryConflict) GetConflictingEntry() string {
+	if x != nil {
+		return x.ConflictingEntry
+	}
+	return ""
+}
+
+func (x *EntryConflict) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *EntryConflict) GetHeaderDiffs() []*HeaderDiff {
+	if x != nil {
+		return x.HeaderDiffs
+	}
+	return nil
+}
+
+func (x *EntryConflict) GetBugtrackerUri() string {
+	if x != nil {
+		return x.BugtrackerUri
+	}
+	return ""
+}
+
+func (x *EntryConflict) GetResolveTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolveTime
+	}
+	return nil
+}
+
+func (x *EntryConflict) GetChosenEntry() string {
+	if x != nil {
+		return x.ChosenEntry
+	}
+	return ""
+}
+
+// HeaderDiff is a header value that differs between two SRPMs
+type HeaderDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Header that differs
+	// e.g. Vendor, or File efi-rpm-macros-3.tar.bz2 for the digest of a file
+	Header string `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// Value in the SRPM of the entry in conflict
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Value in the SRPM of the conflicting entry
+	ConflictingValue string `protobuf:"bytes,3,opt,name=conflicting_value,json=conflictingValue,proto3" json:"conflicting_value,omitempty"`
+}
+
+func (x *HeaderDiff) Reset() {
+	*x = HeaderDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_entry_conflict_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaderDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaderDiff) ProtoMessage() {}
+
+func (x *HeaderDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_entry_conflict_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaderDiff.ProtoReflect.Descriptor instead.
+func (*HeaderDiff) Descriptor() ([]byte, []int) {
+	return file_proto_v1_entry_conflict_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HeaderDiff) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *HeaderDiff) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *HeaderDiff) GetConflictingValue() string {
+	if x != nil {
+		return x.ConflictingValue
+	}
+	return ""
+}
+
+var File_proto_v1_entry_conflict_proto protoreflect.FileDescriptor
+
+var file_proto_v1_entry_conflict_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0d, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xf9, 0x02, 0x0a, 0x0d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x30, 0x0a,
+	0x11, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x10, 0x63,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x40, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x41, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x66, 0x66,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x44, 0x69,
+	0x66, 0x66, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x44,
+	0x69, 0x66, 0x66, 0x73, 0x12, 0x2a, 0x0a, 0x0e, 0x62, 0x75, 0x67, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
+	0x03, 0x52, 0x0d, 0x62, 0x75, 0x67, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x55, 0x72, 0x69,
+	0x12, 0x42, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x5f, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52,
+	0x0b, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x67, 0x0a, 0x0a,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x44, 0x69, 0x66, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x66, 0x0a, 0x19, 0x6f, 0x72, 0x67, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x65, 0x6c, 0x61, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e,
+	0x76, 0x31, 0x42, 0x12, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x6c, 0x61, 0x2f, 0x6d, 0x6f, 0x74,
+	0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31,
+	0x3b, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_v1_entry_conflict_proto_rawDescOnce sync.Once
+	file_proto_v1_entry_conflict_proto_rawDescData = file_proto_v1_entry_conflict_proto_rawDesc
+)
+
+func file_proto_v1_entry_conflict_proto_rawDescGZIP() []byte {
+	file_proto_v1_entry_conflict_proto_rawDescOnce.Do(func() {
+		file_proto_v1_entry_conflict_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_v1_entry_conflict_proto_rawDescData)
+	})
+	return file_proto_v1_entry_conflict_proto_rawDescData
+}
+
+var file_proto_v1_entry_conflict_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_v1_entry_conflict_proto_goTypes = []interface{}{
+	(*EntryConflict)(nil),         // 0: mothership.v1.EntryConflict
+	(*HeaderDiff)(nil),            // 1: mothership.v1.HeaderDiff
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_proto_v1_entry_conflict_proto_depIdxs = []int32{
+	2, // 0: mothership.v1.EntryConflict.create_time:type_name -> google.protobuf.Timestamp
+	1, // 1: mothership.v1.EntryConflict.header_diffs:type_name -> mothership.v1.HeaderDiff
+	2, // 2: mothership.v1.EntryConflict.resolve_time:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_entry_conflict_proto_init() }
+func file_proto_v1_entry_conflict_proto_init() {
+	if File_proto_v1_entry_conflict_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_v1_entry_conflict_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntryConflict); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_entry_conflict_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeaderDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_v1_entry_conflict_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_v1_entry_conflict_proto_goTypes,
+		DependencyIndexes: file_proto_v1_entry_conflict_proto_depIdxs,
+		MessageInfos:      file_proto_v1_entry_conflict_proto_msgTypes,
+	}.Build()
+	File_proto_v1_entry_conflict_proto = out.File
+	file_proto_v1_entry_conflict_proto_rawDesc = nil
+	file_proto_v1_entry_conflict_proto_goTypes = nil
+	file_proto_v1_entry_conflict_proto_depIdxs = nil
+}
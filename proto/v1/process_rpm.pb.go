@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -121,6 +122,15 @@ type ProcessRPMInternalRequest struct {
 
 	// Worker ID of the worker processing the RPM
 	WorkerId string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	// Organization of the worker processing the RPM
+	// Workers without an organization are their own organization
+	Organization string `protobuf:"bytes,2,opt,name=organization,proto3" json:"organization,omitempty"`
+	// Number of distinct organizations that must submit the same RPM
+	// before it is imported.
+	// The quorum is disabled if less than 2.
+	Quorum int32 `protobuf:"varint,3,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// How long to wait for the quorum before putting the entry on hold
+	QuorumTimeout *durationpb.Duration `protobuf:"bytes,4,opt,name=quorum_timeout,json=quorumTimeout,proto3" json:"quorum_timeout,omitempty"`
 }
 
 func (x *ProcessRPMInternalRequest) Reset() {
@@ -162,6 +172,95 @@ func (x *ProcessRPMInternalRequest) GetWorkerId() string {
 	return ""
 }
 
+func (x *ProcessRPMInternalRequest) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *ProcessRPMInternalRequest) GetQuorum() int32 {
+	if x != nil {
+		return x.Quorum
+	}
+	return 0
+}
+
+func (x *ProcessRPMInternalRequest) GetQuorumTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.QuorumTimeout
+	}
+	return nil
+}
+
+// QuorumVote is sent to a ProcessRPM workflow awaiting quorum when another
+// worker submits the same RPM
+type QuorumVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Worker ID of the submitting worker
+	WorkerId string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	// Organization of the submitting worker
+	Organization string `protobuf:"bytes,2,opt,name=organization,proto3" json:"organization,omitempty"`
+	// OS Release the worker pulled the RPM from
+	OsRelease string `protobuf:"bytes,3,opt,name=os_release,json=osRelease,proto3" json:"os_release,omitempty"`
+}
+
+func (x *QuorumVote) Reset() {
+	*x = QuorumVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_process_rpm_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuorumVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuorumVote) ProtoMessage() {}
+
+func (x *QuorumVote) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_process_rpm_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuorumVote.ProtoReflect.Descriptor instead.
+func (*QuorumVote) Descriptor() ([]byte, []int) {
+	return file_proto_v1_process_rpm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QuorumVote) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *QuorumVote) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *QuorumVote) GetOsRelease() string {
+	if x != nil {
+		return x.OsRelease
+	}
+	return ""
+}
+
 // ProcessRPMArgs is the arguments for the ProcessRPM workflow
 type ProcessRPMArgs struct {
 	state         protoimpl.MessageState
@@ -177,7 +276,7 @@ type ProcessRPMArgs struct {
 func (x *ProcessRPMArgs) Reset() {
 	*x = ProcessRPMArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_process_rpm_proto_msgTypes[2]
+		mi := &file_proto_v1_process_rpm_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -190,7 +289,7 @@ func (x *ProcessRPMArgs) String() string {
 func (*ProcessRPMArgs) ProtoMessage() {}
 
 func (x *ProcessRPMArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_process_rpm_proto_msgTypes[2]
+	mi := &file_proto_v1_process_rpm_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -203,7 +302,7 @@ func (x *ProcessRPMArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessRPMArgs.ProtoReflect.Descriptor instead.
 func (*ProcessRPMArgs) Descriptor() ([]byte, []int) {
-	return file_proto_v1_process_rpm_proto_rawDescGZIP(), []int{2}
+	return file_proto_v1_process_rpm_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ProcessRPMArgs) GetRequest() *ProcessRPMRequest {
@@ -235,7 +334,7 @@ type ProcessRPMMetadata struct {
 func (x *ProcessRPMMetadata) Reset() {
 	*x = ProcessRPMMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_process_rpm_proto_msgTypes[3]
+		mi := &file_proto_v1_process_rpm_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -248,7 +347,7 @@ func (x *ProcessRPMMetadata) String() string {
 func (*ProcessRPMMetadata) ProtoMessage() {}
 
 func (x *ProcessRPMMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_process_rpm_proto_msgTypes[3]
+	mi := &file_proto_v1_process_rpm_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,7 +360,7 @@ func (x *ProcessRPMMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessRPMMetadata.ProtoReflect.Descriptor instead.
 func (*ProcessRPMMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_v1_process_rpm_proto_rawDescGZIP(), []int{3}
+	return file_proto_v1_process_rpm_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ProcessRPMMetadata) GetStartTime() *timestamppb.Timestamp {
@@ -291,7 +390,7 @@ type ProcessRPMResponse struct {
 func (x *ProcessRPMResponse) Reset() {
 	*x = ProcessRPMResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_process_rpm_proto_msgTypes[4]
+		mi := &file_proto_v1_process_rpm_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -304,7 +403,7 @@ func (x *ProcessRPMResponse) String() string {
 func (*ProcessRPMResponse) ProtoMessage() {}
 
 func (x *ProcessRPMResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_process_rpm_proto_msgTypes[4]
+	mi := &file_proto_v1_process_rpm_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -317,7 +416,7 @@ func (x *ProcessRPMResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessRPMResponse.ProtoReflect.Descriptor instead.
 func (*ProcessRPMResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_process_rpm_proto_rawDescGZIP(), []int{4}
+	return file_proto_v1_process_rpm_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ProcessRPMResponse) GetEntry() *Entry {
@@ -348,12 +447,16 @@ type ImportRPMResponse struct {
 	// Package name of the imported RPM
 	// e.g. rpm
 	Pkg string `protobuf:"bytes,6,opt,name=pkg,proto3" json:"pkg,omitempty"`
+	// Where each file of the RPM was stored and which rule decided it
+	LookasideClassifications []*LookasideClassification `protobuf:"bytes,7,rep,name=lookaside_classifications,json=lookasideClassifications,proto3" json:"lookaside_classifications,omitempty"`
+	// Status of the push to every mirror forge
+	Mirrors []*MirrorStatus `protobuf:"bytes,8,rep,name=mirrors,proto3" json:"mirrors,omitempty"`
 }
 
 func (x *ImportRPMResponse) Reset() {
 	*x = ImportRPMResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_v1_process_rpm_proto_msgTypes[5]
+		mi := &file_proto_v1_process_rpm_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -366,7 +469,7 @@ func (x *ImportRPMResponse) String() string {
 func (*ImportRPMResponse) ProtoMessage() {}
 
 func (x *ImportRPMResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_process_rpm_proto_msgTypes[5]
+	mi := &file_proto_v1_process_rpm_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -379,7 +482,7 @@ func (x *ImportRPMResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportRPMResponse.ProtoReflect.Descriptor instead.
 func (*ImportRPMResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_process_rpm_proto_rawDescGZIP(), []int{5}
+	return file_proto_v1_process_rpm_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ImportRPMResponse) GetCommitHash() string {
@@ -424,6 +527,108 @@ func (x *ImportRPMResponse) GetPkg() string {
 	return ""
 }
 
+func (x *ImportRPMResponse) GetLookasideClassifications() []*LookasideClassification {
+	if x != nil {
+		return x.LookasideClassifications
+	}
+	return nil
+}
+
+func (x *ImportRPMResponse) GetMirrors() []*MirrorStatus {
+	if x != nil {
+		return x.Mirrors
+	}
+	return nil
+}
+
+// LookasideClassification records whether a file of an imported RPM was
+// stored in the lookaside or in git
+type LookasideClassification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the file
+	// e.g. bash-5.1.tar.gz
+	File string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// Whether the file was stored in the lookaside
+	Lookaside bool `protobuf:"varint,2,opt,name=lookaside,proto3" json:"lookaside,omitempty"`
+	// Rule that matched the file
+	// e.g. "include *.tar*", "size > 5242880" or "override *.patch"
+	Rule string `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
+	// Hex encoded SHA-256 of the file, only set if stored in the lookaside
+	Sha256 string `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// Hex encoded SHA-512 of the file, only set if stored in the lookaside
+	Sha512 string `protobuf:"bytes,5,opt,name=sha512,proto3" json:"sha512,omitempty"`
+}
+
+func (x *LookasideClassification) Reset() {
+	*x = LookasideClassification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_process_rpm_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookasideClassification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookasideClassification) ProtoMessage() {}
+
+func (x *LookasideClassification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_process_rpm_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookasideClassification.ProtoReflect.Descriptor instead.
+func (*LookasideClassification) Descriptor() ([]byte, []int) {
+	return file_proto_v1_process_rpm_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LookasideClassification) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *LookasideClassification) GetLookaside() bool {
+	if x != nil {
+		return x.Lookaside
+	}
+	return false
+}
+
+func (x *LookasideClassification) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *LookasideClassification) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *LookasideClassification) GetSha512() string {
+	if x != nil {
+		return x.Sha512
+	}
+	return ""
+}
+
 var File_proto_v1_process_rpm_proto protoreflect.FileDescriptor
 
 var file_proto_v1_process_rpm_proto_rawDesc = []byte{
@@ -431,7 +636,9 @@ var file_proto_v1_process_rpm_proto_rawDesc = []byte{
 	0x73, 0x73, 0x5f, 0x72, 0x70, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6d, 0x6f,
 	0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65,
-	0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72,
@@ -446,55 +653,89 @@ var file_proto_v1_process_rpm_proto_rawDesc = []byte{
 	0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
 	0x79, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22, 0x3d, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x52, 0x50, 0x4d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x08, 0x77, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x22, 0xab, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x52, 0x50, 0x4d, 0x41, 0x72, 0x67, 0x73, 0x12, 0x3f, 0x0a, 0x07, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x6f, 0x74,
-	0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x52, 0x50, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x03, 0xe0, 0x41,
-	0x02, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x10, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x50, 0x4d, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x03,
-	0xe0, 0x41, 0x02, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x52, 0x50, 0x4d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x0a, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x40, 0x0a,
-	0x12, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x50, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22,
-	0xdd, 0x01, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x50, 0x4d, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
-	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x55, 0x72, 0x69, 0x12,
-	0x28, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
-	0x41, 0x02, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x61, 0x67, 0x12, 0x19, 0x0a,
-	0x05, 0x6e, 0x65, 0x76, 0x72, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
-	0x02, 0x52, 0x05, 0x6e, 0x65, 0x76, 0x72, 0x61, 0x12, 0x15, 0x0a, 0x03, 0x70, 0x6b, 0x67, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x03, 0x70, 0x6b, 0x67, 0x42,
-	0x63, 0x0a, 0x19, 0x6f, 0x72, 0x67, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x6c, 0x61, 0x2e, 0x6d,
-	0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0f, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x70, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e,
-	0x65, 0x6c, 0x61, 0x2f, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68,
-	0x69, 0x70, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22, 0xbb, 0x01, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x50, 0x4d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x08, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x71,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x71, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x12, 0x40, 0x0a, 0x0e, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x6c, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x56,
+	0x6f, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x73, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x73, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x22, 0xab, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x50, 0x4d, 0x41, 0x72, 0x67, 0x73, 0x12, 0x3f, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x50, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x50, 0x4d, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x03, 0xe0, 0x41, 0x02,
+	0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x86, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x50, 0x4d,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x40, 0x0a, 0x12, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x50, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2a, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xf9, 0x02, 0x0a,
+	0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x50, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x24, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
+	0x02, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x55, 0x72, 0x69, 0x12, 0x28, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x5f, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x61, 0x67, 0x12, 0x19, 0x0a, 0x05, 0x6e, 0x65,
+	0x76, 0x72, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x05,
+	0x6e, 0x65, 0x76, 0x72, 0x61, 0x12, 0x15, 0x0a, 0x03, 0x70, 0x6b, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x03, 0x70, 0x6b, 0x67, 0x12, 0x63, 0x0a, 0x19,
+	0x6c, 0x6f, 0x6f, 0x6b, 0x61, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x61, 0x73, 0x69, 0x64, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x18, 0x6c, 0x6f, 0x6f, 0x6b, 0x61, 0x73, 0x69,
+	0x64, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x07, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x17, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x61, 0x73, 0x69, 0x64, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6f, 0x6b,
+	0x61, 0x73, 0x69, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x6f, 0x6f,
+	0x6b, 0x61, 0x73, 0x69, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32,
+	0x35, 0x36, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x35, 0x31, 0x32, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x35, 0x31, 0x32, 0x42, 0x63, 0x0a, 0x19, 0x6f, 0x72,
+	0x67, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x6c, 0x61, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0f, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x70, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x6c, 0x61, 0x2f, 0x6d,
+	0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -509,28 +750,35 @@ func file_proto_v1_process_rpm_proto_rawDescGZIP() []byte {
 	return file_proto_v1_process_rpm_proto_rawDescData
 }
 
-var file_proto_v1_process_rpm_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_v1_process_rpm_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_proto_v1_process_rpm_proto_goTypes = []interface{}{
 	(*ProcessRPMRequest)(nil),         // 0: mothership.v1.ProcessRPMRequest
 	(*ProcessRPMInternalRequest)(nil), // 1: mothership.v1.ProcessRPMInternalRequest
-	(*ProcessRPMArgs)(nil),            // 2: mothership.v1.ProcessRPMArgs
-	(*ProcessRPMMetadata)(nil),        // 3: mothership.v1.ProcessRPMMetadata
-	(*ProcessRPMResponse)(nil),        // 4: mothership.v1.ProcessRPMResponse
-	(*ImportRPMResponse)(nil),         // 5: mothership.v1.ImportRPMResponse
-	(*timestamppb.Timestamp)(nil),     // 6: google.protobuf.Timestamp
-	(*Entry)(nil),                     // 7: mothership.v1.Entry
+	(*QuorumVote)(nil),                // 2: mothership.v1.QuorumVote
+	(*ProcessRPMArgs)(nil),            // 3: mothership.v1.ProcessRPMArgs
+	(*ProcessRPMMetadata)(nil),        // 4: mothership.v1.ProcessRPMMetadata
+	(*ProcessRPMResponse)(nil),        // 5: mothership.v1.ProcessRPMResponse
+	(*ImportRPMResponse)(nil),         // 6: mothership.v1.ImportRPMResponse
+	(*LookasideClassification)(nil),   // 7: mothership.v1.LookasideClassification
+	(*durationpb.Duration)(nil),       // 8: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),     // 9: google.protobuf.Timestamp
+	(*Entry)(nil),                     // 10: mothership.v1.Entry
+	(*MirrorStatus)(nil),              // 11: mothership.v1.MirrorStatus
 }
 var file_proto_v1_process_rpm_proto_depIdxs = []int32{
-	0, // 0: mothership.v1.ProcessRPMArgs.request:type_name -> mothership.v1.ProcessRPMRequest
-	1, // 1: mothership.v1.ProcessRPMArgs.internal_request:type_name -> mothership.v1.ProcessRPMInternalRequest
-	6, // 2: mothership.v1.ProcessRPMMetadata.start_time:type_name -> google.protobuf.Timestamp
-	6, // 3: mothership.v1.ProcessRPMMetadata.end_time:type_name -> google.protobuf.Timestamp
-	7, // 4: mothership.v1.ProcessRPMResponse.entry:type_name -> mothership.v1.Entry
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	8,  // 0: mothership.v1.ProcessRPMInternalRequest.quorum_timeout:type_name -> google.protobuf.Duration
+	0,  // 1: mothership.v1.ProcessRPMArgs.request:type_name -> mothership.v1.ProcessRPMRequest
+	1,  // 2: mothership.v1.ProcessRPMArgs.internal_request:type_name -> mothership.v1.ProcessRPMInternalRequest
+	9,  // 3: mothership.v1.ProcessRPMMetadata.start_time:type_name -> google.protobuf.Timestamp
+	9,  // 4: mothership.v1.ProcessRPMMetadata.end_time:type_name -> google.protobuf.Timestamp
+	10, // 5: mothership.v1.ProcessRPMResponse.entry:type_name -> mothership.v1.Entry
+	7,  // 6: mothership.v1.ImportRPMResponse.lookaside_classifications:type_name -> mothership.v1.LookasideClassification
+	11, // 7: mothership.v1.ImportRPMResponse.mirrors:type_name -> mothership.v1.MirrorStatus
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_proto_v1_process_rpm_proto_init() }
@@ -565,7 +813,7 @@ func file_proto_v1_process_rpm_proto_init() {
 			}
 		}
 		file_proto_v1_process_rpm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessRPMArgs); i {
+			switch v := v.(*QuorumVote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -577,7 +825,7 @@ func file_proto_v1_process_rpm_proto_init() {
 			}
 		}
 		file_proto_v1_process_rpm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessRPMMetadata); i {
+			switch v := v.(*ProcessRPMArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -589,7 +837,7 @@ func file_proto_v1_process_rpm_proto_init() {
 			}
 		}
 		file_proto_v1_process_rpm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessRPMResponse); i {
+			switch v := v.(*ProcessRPMMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -601,6 +849,18 @@ func file_proto_v1_process_rpm_proto_init() {
 			}
 		}
 		file_proto_v1_process_rpm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessRPMResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_process_rpm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ImportRPMResponse); i {
 			case 0:
 				return &v.state
@@ -612,6 +872,18 @@ func file_proto_v1_process_rpm_proto_init() {
 				return nil
 			}
 		}
+		file_proto_v1_process_rpm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookasideClassification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -619,7 +891,7 @@ func file_proto_v1_process_rpm_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_v1_process_rpm_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
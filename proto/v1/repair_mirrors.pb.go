@@ -0,0 +1,176 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: proto/v1/repair_mirrors.proto
+
+package mothershippb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RepairMirrorsResponse is the response message for the RepairMirrors workflow
+type RepairMirrorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of packages with at least one mirror behind
+	CheckedPackages int64 `protobuf:"varint,1,opt,name=checked_packages,json=checkedPackages,proto3" json:"checked_packages,omitempty"`
+	// Number of mirror pushes that brought a mirror up to date
+	RepairedMirrors int64 `protobuf:"varint,2,opt,name=repaired_mirrors,json=repairedMirrors,proto3" json:"repaired_mirrors,omitempty"`
+	// Number of mirror pushes that failed
+	FailedMirrors int64 `protobuf:"varint,3,opt,name=failed_mirrors,json=failedMirrors,proto3" json:"failed_mirrors,omitempty"`
+}
+
+func (x *RepairMirrorsResponse) Reset() {
+	*x = RepairMirrorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_repair_mirrors_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepairMirrorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairMirrorsResponse) ProtoMessage() {}
+
+func (x *RepairMirrorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_repair_mirrors_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairMirrorsResponse.ProtoReflect.Descriptor instead.
+func (*RepairMirrorsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_repair_mirrors_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RepairMirrorsResponse) GetCheckedPackages() int64 {
+	if x != nil {
+		return x.CheckedPackages
+	}
+	return 0
+}
+
+func (x *RepairMirrorsResponse) GetRepairedMirrors() int64 {
+	if x != nil {
+		return x.RepairedMirrors
+	}
+	return 0
+}
+
+func (x *RepairMirrorsResponse) GetFailedMirrors() int64 {
+	if x != nil {
+		return x.FailedMirrors
+	}
+	return 0
+}
+
+var File_proto_v1_repair_mirrors_proto protoreflect.FileDescriptor
+
+var file_proto_v1_repair_mirrors_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x5f, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0d, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x22, 0x94,
+	0x01, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x5f,
+	0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72,
+	0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4d, 0x69,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x42, 0x66, 0x0a, 0x19, 0x6f, 0x72, 0x67, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x65, 0x6c, 0x61, 0x2e, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e,
+	0x76, 0x31, 0x42, 0x12, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x6c, 0x61, 0x2f, 0x6d, 0x6f, 0x74,
+	0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31,
+	0x3b, 0x6d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_v1_repair_mirrors_proto_rawDescOnce sync.Once
+	file_proto_v1_repair_mirrors_proto_rawDescData = file_proto_v1_repair_mirrors_proto_rawDesc
+)
+
+func file_proto_v1_repair_mirrors_proto_rawDescGZIP() []byte {
+	file_proto_v1_repair_mirrors_proto_rawDescOnce.Do(func() {
+		file_proto_v1_repair_mirrors_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_v1_repair_mirrors_proto_rawDescData)
+	})
+	return file_proto_v1_repair_mirrors_proto_rawDescData
+}
+
+var file_proto_v1_repair_mirrors_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_v1_repair_mirrors_proto_goTypes = []interface{}{
+	(*RepairMirrorsResponse)(nil), // 0: mothership.v1.RepairMirrorsResponse
+}
+var file_proto_v1_repair_mirrors_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_repair_mirrors_proto_init() }
+func file_proto_v1_repair_mirrors_proto_init() {
+	if File_proto_v1_repair_mirrors_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_v1_repair_mirrors_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepairMirrorsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_v1_repair_mirrors_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_v1_repair_mirrors_proto_goTypes,
+		DependencyIndexes: file_proto_v1_repair_mirrors_proto_depIdxs,
+		MessageInfos:      file_proto_v1_repair_mirrors_proto_msgTypes,
+	}.Build()
+	File_proto_v1_repair_mirrors_proto = out.File
+	file_proto_v1_repair_mirrors_proto_rawDesc = nil
+	file_proto_v1_repair_mirrors_proto_goTypes = nil
+	file_proto_v1_repair_mirrors_proto_depIdxs = nil
+}
@@ -36,6 +36,15 @@ type SrpmArchiverClient interface {
 	SealBatch(ctx context.Context, in *SealBatchRequest, opts ...grpc.CallOption) (*longrunning.Operation, error)
 	// Returns an entry
 	GetEntry(ctx context.Context, in *GetEntryRequest, opts ...grpc.CallOption) (*Entry, error)
+	// Returns the SLSA provenance attestation of an archived entry.
+	// The attestation is an in-toto statement in a DSSE envelope, its subjects
+	// are the SRPM and the import commit.
+	// Entries are only attested if the worker server has a signing key,
+	// returns NOT_FOUND otherwise.
+	GetEntryAttestation(ctx context.Context, in *GetEntryAttestationRequest, opts ...grpc.CallOption) (*AttestationEnvelope, error)
+	// Returns the conflict of an entry in the `CONFLICT` state.
+	// Resolved conflicts are still returned.
+	GetEntryConflict(ctx context.Context, in *GetEntryConflictRequest, opts ...grpc.CallOption) (*EntryConflict, error)
 	// Returns a list of entries that match the filter criteria.
 	ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error)
 	// Submits an SRPM to be archived.
@@ -59,6 +68,29 @@ type SrpmArchiverClient interface {
 	// This doesn't necessarily mean that the worker should stop processing,
 	// especially if it acquired a lease to process this particular SRPM.
 	WorkerUploadObject(ctx context.Context, opts ...grpc.CallOption) (SrpmArchiver_WorkerUploadObjectClient, error)
+	// CreateUploadURL is used by workers to upload objects directly to the
+	// object storage service, without holding storage credentials.
+	// Returns a short-lived presigned URL for an object named by the
+	// SHA-256 checksum of the SRPM.
+	// Returns AlreadyExists if the object already exists, and Unimplemented
+	// if the object storage service doesn't support presigned URLs.
+	CreateUploadURL(ctx context.Context, in *CreateUploadURLRequest, opts ...grpc.CallOption) (*CreateUploadURLResponse, error)
+	// Returns the keys import commits and tags are signed with.
+	// Commits can be verified with `git verify-commit` after importing the
+	// OpenPGP key, or adding the SSH key to gpg.ssh.allowedSignersFile.
+	ListSigningKeys(ctx context.Context, in *ListSigningKeysRequest, opts ...grpc.CallOption) (*ListSigningKeysResponse, error)
+	// Returns a signed tree head of the transparency log.
+	// Every time an entry is archived or retracted, a leaf is appended to the
+	// log. Clients should store checkpoints and use GetConsistencyProof to
+	// verify that later checkpoints extend them.
+	// Nothing is logged if the worker server has no signing key.
+	GetLogCheckpoint(ctx context.Context, in *GetLogCheckpointRequest, opts ...grpc.CallOption) (*LogCheckpoint, error)
+	// Returns the proof that the latest leaf of an entry is included in the
+	// transparency log.
+	GetInclusionProof(ctx context.Context, in *GetInclusionProofRequest, opts ...grpc.CallOption) (*InclusionProof, error)
+	// Returns the proof that a tree head of the transparency log is a prefix
+	// of a later tree head.
+	GetConsistencyProof(ctx context.Context, in *GetConsistencyProofRequest, opts ...grpc.CallOption) (*ConsistencyProof, error)
 	// WorkerPing is used by workers to ping the server.
 	// This is used to check if the worker is still alive.
 	WorkerPing(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -117,6 +149,24 @@ func (c *srpmArchiverClient) GetEntry(ctx context.Context, in *GetEntryRequest,
 	return out, nil
 }
 
+func (c *srpmArchiverClient) GetEntryAttestation(ctx context.Context, in *GetEntryAttestationRequest, opts ...grpc.CallOption) (*AttestationEnvelope, error) {
+	out := new(AttestationEnvelope)
+	err := c.cc.Invoke(ctx, "/mothership.v1.SrpmArchiver/GetEntryAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srpmArchiverClient) GetEntryConflict(ctx context.Context, in *GetEntryConflictRequest, opts ...grpc.CallOption) (*EntryConflict, error) {
+	out := new(EntryConflict)
+	err := c.cc.Invoke(ctx, "/mothership.v1.SrpmArchiver/GetEntryConflict", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *srpmArchiverClient) ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error) {
 	out := new(ListEntriesResponse)
 	err := c.cc.Invoke(ctx, "/mothership.v1.SrpmArchiver/ListEntries", in, out, opts...)
@@ -169,6 +219,51 @@ func (x *srpmArchiverWorkerUploadObjectClient) CloseAndRecv() (*WorkerUploadObje
 	return m, nil
 }
 
+func (c *srpmArchiverClient) CreateUploadURL(ctx context.Context, in *CreateUploadURLRequest, opts ...grpc.CallOption) (*CreateUploadURLResponse, error) {
+	out := new(CreateUploadURLResponse)
+	err := c.cc.Invoke(ctx, "/mothership.v1.SrpmArchiver/CreateUploadURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srpmArchiverClient) ListSigningKeys(ctx context.Context, in *ListSigningKeysRequest, opts ...grpc.CallOption) (*ListSigningKeysResponse, error) {
+	out := new(ListSigningKeysResponse)
+	err := c.cc.Invoke(ctx, "/mothership.v1.SrpmArchiver/ListSigningKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srpmArchiverClient) GetLogCheckpoint(ctx context.Context, in *GetLogCheckpointRequest, opts ...grpc.CallOption) (*LogCheckpoint, error) {
+	out := new(LogCheckpoint)
+	err := c.cc.Invoke(ctx, "/mothership.v1.SrpmArchiver/GetLogCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srpmArchiverClient) GetInclusionProof(ctx context.Context, in *GetInclusionProofRequest, opts ...grpc.CallOption) (*InclusionProof, error) {
+	out := new(InclusionProof)
+	err := c.cc.Invoke(ctx, "/mothership.v1.SrpmArchiver/GetInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srpmArchiverClient) GetConsistencyProof(ctx context.Context, in *GetConsistencyProofRequest, opts ...grpc.CallOption) (*ConsistencyProof, error) {
+	out := new(ConsistencyProof)
+	err := c.cc.Invoke(ctx, "/mothership.v1.SrpmArchiver/GetConsistencyProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *srpmArchiverClient) WorkerPing(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/mothership.v1.SrpmArchiver/WorkerPing", in, out, opts...)
@@ -194,6 +289,15 @@ type SrpmArchiverServer interface {
 	SealBatch(context.Context, *SealBatchRequest) (*longrunning.Operation, error)
 	// Returns an entry
 	GetEntry(context.Context, *GetEntryRequest) (*Entry, error)
+	// Returns the SLSA provenance attestation of an archived entry.
+	// The attestation is an in-toto statement in a DSSE envelope, its subjects
+	// are the SRPM and the import commit.
+	// Entries are only attested if the worker server has a signing key,
+	// returns NOT_FOUND otherwise.
+	GetEntryAttestation(context.Context, *GetEntryAttestationRequest) (*AttestationEnvelope, error)
+	// Returns the conflict of an entry in the `CONFLICT` state.
+	// Resolved conflicts are still returned.
+	GetEntryConflict(context.Context, *GetEntryConflictRequest) (*EntryConflict, error)
 	// Returns a list of entries that match the filter criteria.
 	ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error)
 	// Submits an SRPM to be archived.
@@ -217,6 +321,29 @@ type SrpmArchiverServer interface {
 	// This doesn't necessarily mean that the worker should stop processing,
 	// especially if it acquired a lease to process this particular SRPM.
 	WorkerUploadObject(SrpmArchiver_WorkerUploadObjectServer) error
+	// CreateUploadURL is used by workers to upload objects directly to the
+	// object storage service, without holding storage credentials.
+	// Returns a short-lived presigned URL for an object named by the
+	// SHA-256 checksum of the SRPM.
+	// Returns AlreadyExists if the object already exists, and Unimplemented
+	// if the object storage service doesn't support presigned URLs.
+	CreateUploadURL(context.Context, *CreateUploadURLRequest) (*CreateUploadURLResponse, error)
+	// Returns the keys import commits and tags are signed with.
+	// Commits can be verified with `git verify-commit` after importing the
+	// OpenPGP key, or adding the SSH key to gpg.ssh.allowedSignersFile.
+	ListSigningKeys(context.Context, *ListSigningKeysRequest) (*ListSigningKeysResponse, error)
+	// Returns a signed tree head of the transparency log.
+	// Every time an entry is archived or retracted, a leaf is appended to the
+	// log. Clients should store checkpoints and use GetConsistencyProof to
+	// verify that later checkpoints extend them.
+	// Nothing is logged if the worker server has no signing key.
+	GetLogCheckpoint(context.Context, *GetLogCheckpointRequest) (*LogCheckpoint, error)
+	// Returns the proof that the latest leaf of an entry is included in the
+	// transparency log.
+	GetInclusionProof(context.Context, *GetInclusionProofRequest) (*InclusionProof, error)
+	// Returns the proof that a tree head of the transparency log is a prefix
+	// of a later tree head.
+	GetConsistencyProof(context.Context, *GetConsistencyProofRequest) (*ConsistencyProof, error)
 	// WorkerPing is used by workers to ping the server.
 	// This is used to check if the worker is still alive.
 	WorkerPing(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
@@ -242,6 +369,12 @@ func (UnimplementedSrpmArchiverServer) SealBatch(context.Context, *SealBatchRequ
 func (UnimplementedSrpmArchiverServer) GetEntry(context.Context, *GetEntryRequest) (*Entry, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEntry not implemented")
 }
+func (UnimplementedSrpmArchiverServer) GetEntryAttestation(context.Context, *GetEntryAttestationRequest) (*AttestationEnvelope, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntryAttestation not implemented")
+}
+func (UnimplementedSrpmArchiverServer) GetEntryConflict(context.Context, *GetEntryConflictRequest) (*EntryConflict, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntryConflict not implemented")
+}
 func (UnimplementedSrpmArchiverServer) ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEntries not implemented")
 }
@@ -251,6 +384,21 @@ func (UnimplementedSrpmArchiverServer) SubmitEntry(context.Context, *SubmitEntry
 func (UnimplementedSrpmArchiverServer) WorkerUploadObject(SrpmArchiver_WorkerUploadObjectServer) error {
 	return status.Errorf(codes.Unimplemented, "method WorkerUploadObject not implemented")
 }
+func (UnimplementedSrpmArchiverServer) CreateUploadURL(context.Context, *CreateUploadURLRequest) (*CreateUploadURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUploadURL not implemented")
+}
+func (UnimplementedSrpmArchiverServer) ListSigningKeys(context.Context, *ListSigningKeysRequest) (*ListSigningKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSigningKeys not implemented")
+}
+func (UnimplementedSrpmArchiverServer) GetLogCheckpoint(context.Context, *GetLogCheckpointRequest) (*LogCheckpoint, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogCheckpoint not implemented")
+}
+func (UnimplementedSrpmArchiverServer) GetInclusionProof(context.Context, *GetInclusionProofRequest) (*InclusionProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInclusionProof not implemented")
+}
+func (UnimplementedSrpmArchiverServer) GetConsistencyProof(context.Context, *GetConsistencyProofRequest) (*ConsistencyProof, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsistencyProof not implemented")
+}
 func (UnimplementedSrpmArchiverServer) WorkerPing(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WorkerPing not implemented")
 }
@@ -357,6 +505,42 @@ func _SrpmArchiver_GetEntry_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SrpmArchiver_GetEntryAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntryAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SrpmArchiverServer).GetEntryAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mothership.v1.SrpmArchiver/GetEntryAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SrpmArchiverServer).GetEntryAttestation(ctx, req.(*GetEntryAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SrpmArchiver_GetEntryConflict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntryConflictRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SrpmArchiverServer).GetEntryConflict(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mothership.v1.SrpmArchiver/GetEntryConflict",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SrpmArchiverServer).GetEntryConflict(ctx, req.(*GetEntryConflictRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SrpmArchiver_ListEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListEntriesRequest)
 	if err := dec(in); err != nil {
@@ -419,6 +603,96 @@ func (x *srpmArchiverWorkerUploadObjectServer) Recv() (*WorkerUploadObjectReques
 	return m, nil
 }
 
+func _SrpmArchiver_CreateUploadURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUploadURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SrpmArchiverServer).CreateUploadURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mothership.v1.SrpmArchiver/CreateUploadURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SrpmArchiverServer).CreateUploadURL(ctx, req.(*CreateUploadURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SrpmArchiver_ListSigningKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSigningKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SrpmArchiverServer).ListSigningKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mothership.v1.SrpmArchiver/ListSigningKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SrpmArchiverServer).ListSigningKeys(ctx, req.(*ListSigningKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SrpmArchiver_GetLogCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SrpmArchiverServer).GetLogCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mothership.v1.SrpmArchiver/GetLogCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SrpmArchiverServer).GetLogCheckpoint(ctx, req.(*GetLogCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SrpmArchiver_GetInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SrpmArchiverServer).GetInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mothership.v1.SrpmArchiver/GetInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SrpmArchiverServer).GetInclusionProof(ctx, req.(*GetInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SrpmArchiver_GetConsistencyProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsistencyProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SrpmArchiverServer).GetConsistencyProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mothership.v1.SrpmArchiver/GetConsistencyProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SrpmArchiverServer).GetConsistencyProof(ctx, req.(*GetConsistencyProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SrpmArchiver_WorkerPing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -464,6 +738,14 @@ var SrpmArchiver_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEntry",
 			Handler:    _SrpmArchiver_GetEntry_Handler,
 		},
+		{
+			MethodName: "GetEntryAttestation",
+			Handler:    _SrpmArchiver_GetEntryAttestation_Handler,
+		},
+		{
+			MethodName: "GetEntryConflict",
+			Handler:    _SrpmArchiver_GetEntryConflict_Handler,
+		},
 		{
 			MethodName: "ListEntries",
 			Handler:    _SrpmArchiver_ListEntries_Handler,
@@ -472,6 +754,26 @@ var SrpmArchiver_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitEntry",
 			Handler:    _SrpmArchiver_SubmitEntry_Handler,
 		},
+		{
+			MethodName: "CreateUploadURL",
+			Handler:    _SrpmArchiver_CreateUploadURL_Handler,
+		},
+		{
+			MethodName: "ListSigningKeys",
+			Handler:    _SrpmArchiver_ListSigningKeys_Handler,
+		},
+		{
+			MethodName: "GetLogCheckpoint",
+			Handler:    _SrpmArchiver_GetLogCheckpoint_Handler,
+		},
+		{
+			MethodName: "GetInclusionProof",
+			Handler:    _SrpmArchiver_GetInclusionProof_Handler,
+		},
+		{
+			MethodName: "GetConsistencyProof",
+			Handler:    _SrpmArchiver_GetConsistencyProof_Handler,
+		},
 		{
 			MethodName: "WorkerPing",
 			Handler:    _SrpmArchiver_WorkerPing_Handler,
@@ -0,0 +1,60 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/openela/mothership/base"
+	"github.com/openela/mothership/base/attestation"
+	"github.com/openela/mothership/base/storage"
+	mothership_db "github.com/openela/mothership/db"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) GetEntryAttestation(_ context.Context, req *mothershippb.GetEntryAttestationRequest) (*mothershippb.AttestationEnvelope, error) {
+	entry, err := base.Q[mothership_db.Entry](s.db).F("name", req.Name).GetOrNil()
+	if err != nil {
+		base.LogErrorf("failed to get entry: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get entry")
+	}
+
+	if entry == nil {
+		return nil, status.Error(codes.NotFound, "entry not found")
+	}
+
+	// Entries are attested once they're archived
+	data, err := s.storage.Get(attestation.ObjectName(entry.Name))
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "attestation not found")
+		}
+		base.LogErrorf("failed to get attestation: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get attestation")
+	}
+
+	var envelope attestation.Envelope
+	err = json.Unmarshal(data, &envelope)
+	if err != nil {
+		base.LogErrorf("failed to unmarshal attestation: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get attestation")
+	}
+
+	pb := &mothershippb.AttestationEnvelope{
+		PayloadType: envelope.PayloadType,
+		Payload:     envelope.Payload,
+	}
+	for _, sig := range envelope.Signatures {
+		pb.Signatures = append(pb.Signatures, &mothershippb.AttestationSignature{
+			Keyid: sig.KeyID,
+			Sig:   sig.Sig,
+		})
+	}
+
+	return pb, nil
+}
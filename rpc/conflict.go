@@ -0,0 +1,28 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_rpc
+
+import (
+	"context"
+
+	"github.com/openela/mothership/base"
+	mothership_db "github.com/openela/mothership/db"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) GetEntryConflict(_ context.Context, req *mothershippb.GetEntryConflictRequest) (*mothershippb.EntryConflict, error) {
+	conflict, err := base.Q[mothership_db.EntryConflict](s.db).F("name", mothership_db.EntryConflictName(req.Name)).GetOrNil()
+	if err != nil {
+		base.LogErrorf("failed to get entry conflict: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get entry conflict")
+	}
+
+	if conflict == nil {
+		return nil, status.Error(codes.NotFound, "entry conflict not found")
+	}
+
+	return conflict.ToPB(), nil
+}
@@ -19,8 +19,12 @@ import (
 	"go.temporal.io/sdk/client"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
+// importRPMActivity is the activity type name of Worker.ImportRPM.
+const importRPMActivity = "ImportRPM"
+
 var codenameRegexp = regexp.MustCompile(` \(([^)]+)\)`)
 
 func cleanupTrademarks(s string) string {
@@ -41,29 +45,71 @@ func (s *Server) GetEntry(ctx context.Context, req *mothershippb.GetEntryRequest
 	pb := entry.ToPB()
 	pb.OsRelease = cleanupTrademarks(pb.OsRelease)
 
-	// If on hold, let's query temporal for more info.
-	if entry.State == mothershippb.Entry_ON_HOLD {
+	mirrors, err := base.Q[mothership_db.EntryMirror](s.db).F("entry_name", entry.Name).All()
+	if err != nil {
+		base.LogErrorf("failed to get entry mirrors: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get entry mirrors")
+	}
+	for _, mirror := range mirrors {
+		pb.Mirrors = append(pb.Mirrors, mirror.ToPB())
+	}
+
+	// If on hold without a recorded error, let's query temporal for more info.
+	if entry.State == mothershippb.Entry_ON_HOLD && entry.ErrorMessage == "" {
 		events := s.temporal.GetWorkflowHistory(ctx, "operations/"+entry.Sha256Sum, "", false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
-		// We only need to find the latest ImportRPM event.
-		// Return the error from that event.
-		pb.ErrorMessage = "Unknown error"
-		for events.HasNext() {
-			event, err := events.Next()
-			if err != nil {
-				base.LogErrorf("failed to get next event: %v", err)
-				continue
+		pb.ErrorMessage = holdReason(events)
+	}
+
+	return pb, nil
+}
+
+// holdReason returns why a ProcessRPM workflow put its entry on hold.
+// Only the latest failed ImportRPM activity, or the quorum timeout if the
+// entry was put on hold while awaiting quorum, are considered.
+func holdReason(events client.HistoryEventIterator) string {
+	reason := "Unknown error"
+
+	// Failed events only reference the event that scheduled the activity,
+	// or started the timer.
+	importRPMEvents := map[int64]bool{}
+	quorumTimerID := ""
+	for events.HasNext() {
+		event, err := events.Next()
+		if err != nil {
+			base.LogErrorf("failed to get next event: %v", err)
+			continue
+		}
+
+		if attrs := event.GetActivityTaskScheduledEventAttributes(); attrs != nil {
+			if attrs.GetActivityType().GetName() == importRPMActivity {
+				importRPMEvents[event.GetEventId()] = true
+			}
+			continue
+		}
+
+		// The quorum timer is the first timer of the workflow.
+		if attrs := event.GetTimerStartedEventAttributes(); attrs != nil {
+			if quorumTimerID == "" {
+				quorumTimerID = attrs.GetTimerId()
 			}
-			failedAttrs := event.GetActivityTaskFailedEventAttributes()
-			if failedAttrs == nil {
-				continue
+			continue
+		}
+
+		if attrs := event.GetTimerFiredEventAttributes(); attrs != nil {
+			if attrs.GetTimerId() == quorumTimerID {
+				reason = "Quorum not reached"
 			}
+			continue
+		}
 
-			pb.ErrorMessage = failedAttrs.Failure.Message
-			break
+		if attrs := event.GetActivityTaskFailedEventAttributes(); attrs != nil {
+			if importRPMEvents[attrs.GetScheduledEventId()] {
+				reason = attrs.GetFailure().GetMessage()
+			}
 		}
 	}
 
-	return pb, nil
+	return reason
 }
 
 func (s *Server) ListEntries(_ context.Context, req *mothershippb.ListEntriesRequest) (*mothershippb.ListEntriesResponse, error) {
@@ -100,6 +146,17 @@ func (s *Server) ListEntries(_ context.Context, req *mothershippb.ListEntriesReq
 	}, nil
 }
 
+// checkQuorumOrganization makes sure the worker belongs to an organization
+// if a quorum is required. Otherwise, the worker can't count towards the
+// quorum of distinct organizations.
+func (s *Server) checkQuorumOrganization(worker *mothership_db.Worker) error {
+	if s.quorum > 1 && worker.Organization == "" {
+		return status.Error(codes.FailedPrecondition, "worker must belong to an organization to submit entries that require a quorum")
+	}
+
+	return nil
+}
+
 // SubmitEntry handles the RPC request for submitting an entry. This is usually
 // called by the worker. The worker must be authenticated. The checksum will "lease"
 // the entry for the worker, so that other workers will not submit the same entry.
@@ -109,6 +166,9 @@ func (s *Server) SubmitEntry(ctx context.Context, req *mothershippb.SubmitEntryR
 	if err != nil {
 		return nil, err
 	}
+	if err := s.checkQuorumOrganization(worker); err != nil {
+		return nil, err
+	}
 
 	// Now make sure the entry doesn't already exist in the ARCHIVED state.
 	// If it does, return an error. It should be retracted first.
@@ -129,19 +189,44 @@ func (s *Server) SubmitEntry(ctx context.Context, req *mothershippb.SubmitEntryR
 		WorkflowExecutionErrorWhenAlreadyStarted: true,
 		WorkflowIDReusePolicy:                    enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
 	}
+	args := &mothershippb.ProcessRPMArgs{
+		Request: req.ProcessRpmRequest,
+		InternalRequest: &mothershippb.ProcessRPMInternalRequest{
+			WorkerId:     worker.WorkerID,
+			Organization: worker.Organization,
+		},
+	}
 
 	// Submit to Temporal
-	run, err := s.temporal.ExecuteWorkflow(
-		context.Background(),
-		startWorkflowOpts,
-		mothership_worker_server.ProcessRPMWorkflow,
-		&mothershippb.ProcessRPMArgs{
-			Request: req.ProcessRpmRequest,
-			InternalRequest: &mothershippb.ProcessRPMInternalRequest{
-				WorkerId: worker.WorkerID,
+	var run client.WorkflowRun
+	if s.quorum > 1 {
+		// The first submission starts the workflow, and the workflow waits
+		// for the submissions of other organizations.
+		// Every submission votes for the same checksum, since the checksum
+		// identifies the workflow.
+		args.InternalRequest.Quorum = s.quorum
+		args.InternalRequest.QuorumTimeout = durationpb.New(s.quorumTimeout)
+		run, err = s.temporal.SignalWithStartWorkflow(
+			context.Background(),
+			startWorkflowOpts.ID,
+			mothership_worker_server.QuorumSignal,
+			&mothershippb.QuorumVote{
+				WorkerId:     worker.WorkerID,
+				Organization: worker.Organization,
+				OsRelease:    req.ProcessRpmRequest.OsRelease,
 			},
-		},
-	)
+			startWorkflowOpts,
+			mothership_worker_server.ProcessRPMWorkflow,
+			args,
+		)
+	} else {
+		run, err = s.temporal.ExecuteWorkflow(
+			context.Background(),
+			startWorkflowOpts,
+			mothership_worker_server.ProcessRPMWorkflow,
+			args,
+		)
+	}
 	if err != nil {
 		if strings.Contains(err.Error(), "is already running") {
 			return nil, status.Error(codes.AlreadyExists, "entry is already running")
@@ -0,0 +1,120 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_rpc
+
+import (
+	"testing"
+
+	mothership_db "github.com/openela/mothership/db"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeHistory iterates over the given events.
+type fakeHistory struct {
+	events []*historypb.HistoryEvent
+}
+
+func (f *fakeHistory) HasNext() bool {
+	return len(f.events) > 0
+}
+
+func (f *fakeHistory) Next() (*historypb.HistoryEvent, error) {
+	event := f.events[0]
+	f.events = f.events[1:]
+	return event, nil
+}
+
+func activityScheduled(id int64, activityType string) *historypb.HistoryEvent {
+	return &historypb.HistoryEvent{
+		EventId: id,
+		Attributes: &historypb.HistoryEvent_ActivityTaskScheduledEventAttributes{
+			ActivityTaskScheduledEventAttributes: &historypb.ActivityTaskScheduledEventAttributes{
+				ActivityType: &commonpb.ActivityType{Name: activityType},
+			},
+		},
+	}
+}
+
+func activityFailed(id int64, scheduledID int64, message string) *historypb.HistoryEvent {
+	return &historypb.HistoryEvent{
+		EventId: id,
+		Attributes: &historypb.HistoryEvent_ActivityTaskFailedEventAttributes{
+			ActivityTaskFailedEventAttributes: &historypb.ActivityTaskFailedEventAttributes{
+				ScheduledEventId: scheduledID,
+				Failure:          &failurepb.Failure{Message: message},
+			},
+		},
+	}
+}
+
+func timerStarted(id int64, timerID string) *historypb.HistoryEvent {
+	return &historypb.HistoryEvent{
+		EventId: id,
+		Attributes: &historypb.HistoryEvent_TimerStartedEventAttributes{
+			TimerStartedEventAttributes: &historypb.TimerStartedEventAttributes{TimerId: timerID},
+		},
+	}
+}
+
+func timerFired(id int64, timerID string) *historypb.HistoryEvent {
+	return &historypb.HistoryEvent{
+		EventId: id,
+		Attributes: &historypb.HistoryEvent_TimerFiredEventAttributes{
+			TimerFiredEventAttributes: &historypb.TimerFiredEventAttributes{TimerId: timerID},
+		},
+	}
+}
+
+func TestHoldReason_Unknown(t *testing.T) {
+	require.Equal(t, "Unknown error", holdReason(&fakeHistory{}))
+}
+
+func TestHoldReason_ImportRPM(t *testing.T) {
+	events := []*historypb.HistoryEvent{
+		activityScheduled(1, "ImportRPM"),
+		activityFailed(2, 1, "import error"),
+		// Failures of other activities are ignored
+		activityScheduled(3, "CreateEntryTicket"),
+		activityFailed(4, 3, "bugtracker error"),
+	}
+	require.Equal(t, "import error", holdReason(&fakeHistory{events: events}))
+}
+
+func TestHoldReason_Quorum(t *testing.T) {
+	events := []*historypb.HistoryEvent{
+		timerStarted(1, "1"),
+		timerFired(2, "1"),
+	}
+	require.Equal(t, "Quorum not reached", holdReason(&fakeHistory{events: events}))
+}
+
+func TestHoldReason_ImportAfterQuorum(t *testing.T) {
+	events := []*historypb.HistoryEvent{
+		timerStarted(1, "1"),
+		timerFired(2, "1"),
+		activityScheduled(3, "ImportRPM"),
+		activityFailed(4, 3, "import error"),
+		// Other timers are ignored
+		timerStarted(5, "5"),
+		timerFired(6, "5"),
+	}
+	require.Equal(t, "import error", holdReason(&fakeHistory{events: events}))
+}
+
+func TestCheckQuorumOrganization(t *testing.T) {
+	s := &Server{quorum: 2}
+	require.Nil(t, s.checkQuorumOrganization(&mothership_db.Worker{WorkerID: "worker", Organization: "org"}))
+
+	err := s.checkQuorumOrganization(&mothership_db.Worker{WorkerID: "worker"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Without a quorum, the organization is optional
+	s = &Server{quorum: 1}
+	require.Nil(t, s.checkQuorumOrganization(&mothership_db.Worker{WorkerID: "worker"}))
+}
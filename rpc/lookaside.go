@@ -0,0 +1,244 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_rpc
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/openela/mothership/base"
+	"github.com/openela/mothership/base/storage"
+	mothership_db "github.com/openela/mothership/db"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// lookasidePath is the path of lookaside blobs, in the layout expected by
+// rpkg based tooling such as fedpkg and centpkg.
+// The lookaside URL of those tools should be set to <gateway>/lookaside.
+const lookasidePath = "/lookaside/{repo}/{file}/{hashtype}/{hash}/{filename}"
+
+// lookasideHashes are the supported hash types, by their name in the
+// lookaside path.
+// Blobs are stored under their hash, so only hash types srpm_import names
+// blobs by can be served.
+// SHA-512 is what the dist-git sources file refers to blobs by.
+var lookasideHashes = map[string]func() hash.Hash{
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// lookasideIndex decides which blobs the lookaside serves.
+type lookasideIndex interface {
+	// servable returns true if the blob is referenced by the lookaside
+	// metadata an archived entry committed, or is the SRPM of an archived
+	// entry.
+	servable(hashType string, hash string) (bool, error)
+}
+
+// dbLookasideIndex looks up blobs in the database.
+type dbLookasideIndex struct {
+	db *base.DB
+}
+
+func (d *dbLookasideIndex) servable(hashType string, hash string) (bool, error) {
+	// The hash types are also the column names
+	blobs, err := base.Q[mothership_db.EntryLookasideBlob](d.db).F(hashType, hash).All()
+	if err != nil {
+		return false, err
+	}
+	for _, blob := range blobs {
+		entry, err := base.Q[mothership_db.Entry](d.db).F(
+			"name", blob.EntryName,
+			"state", mothershippb.Entry_ARCHIVED,
+		).GetOrNil()
+		if err != nil {
+			return false, err
+		}
+		if entry != nil {
+			return true, nil
+		}
+	}
+
+	// SRPMs are stored under their SHA-256 hash
+	if hashType != "sha256" {
+		return false, nil
+	}
+	entry, err := base.Q[mothership_db.Entry](d.db).F(
+		"sha256_sum", hash,
+		"state", mothershippb.Entry_ARCHIVED,
+	).GetOrNil()
+	if err != nil {
+		return false, err
+	}
+
+	return entry != nil, nil
+}
+
+// lookasideBufferSize is the size of the buffers used when streaming blobs.
+const lookasideBufferSize = 32 * 1024
+
+// registerLookaside mounts the lookaside handler on the gateway mux.
+func (s *Server) registerLookaside(mux *runtime.ServeMux) error {
+	for _, method := range []string{http.MethodGet, http.MethodHead} {
+		err := mux.HandlePath(method, lookasidePath, s.handleLookaside)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// handleLookaside serves a lookaside blob.
+// Only blobs of archived entries are served, everything else in storage,
+// for example uploads that were never imported, is not found.
+// The repository isn't checked, blobs are content addressed and shared
+// between repositories.
+// The content is hashed while it is sent, and the response is cut short if
+// the blob doesn't match its hash, so a client never receives a complete
+// response for a corrupted blob.
+func (s *Server) handleLookaside(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	// The file name is repeated in the path
+	if params["file"] != params["filename"] {
+		http.NotFound(w, r)
+		return
+	}
+
+	hashType := strings.ToLower(params["hashtype"])
+	newHash, ok := lookasideHashes[hashType]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	expected := strings.ToLower(params["hash"])
+	if len(expected) != newHash().Size()*2 {
+		http.Error(w, "invalid hash", http.StatusBadRequest)
+		return
+	}
+	if _, err := hex.DecodeString(expected); err != nil {
+		http.Error(w, "invalid hash", http.StatusBadRequest)
+		return
+	}
+
+	servable, err := s.lookaside.servable(hashType, expected)
+	if err != nil {
+		base.LogErrorf("failed to look up lookaside blob %s: %v", expected, err)
+		http.Error(w, "failed to look up blob", http.StatusInternalServerError)
+		return
+	}
+	if !servable {
+		http.NotFound(w, r)
+		return
+	}
+
+	rc, size, err := s.storage.Open(expected)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			http.NotFound(w, r)
+			return
+		}
+		base.LogErrorf("failed to open lookaside blob %s: %v", expected, err)
+		http.Error(w, "failed to open blob", http.StatusInternalServerError)
+		return
+	}
+	defer rc.Close()
+
+	w.Header().Set("Accept-Ranges", "bytes")
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("ETag", strconv.Quote(expected))
+
+	if r.Method == http.MethodHead {
+		w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.Header.Get("Range") != "" {
+		serveLookasideRange(w, r, params["file"], rc, newHash(), expected)
+		return
+	}
+
+	w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+	w.WriteHeader(http.StatusOK)
+
+	err = copyVerified(w, rc, newHash(), expected)
+	if err != nil {
+		base.LogErrorf("failed to serve lookaside blob %s: %v", expected, err)
+		// The status is already sent, aborting closes the connection
+		// without completing the response
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// errHashMismatch is returned if a blob doesn't match its hash.
+var errHashMismatch = errors.New("blob does not match its hash")
+
+// copyVerified copies r to w while hashing it.
+// The last read is held back until the hash is verified, so w never receives
+// the complete content of a blob that doesn't match its hash.
+func copyVerified(w io.Writer, r io.Reader, h hash.Hash, expected string) error {
+	buf := make([]byte, lookasideBufferSize)
+	pending := make([]byte, 0, lookasideBufferSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if len(pending) > 0 {
+				_, werr := w.Write(pending)
+				if werr != nil {
+					return werr
+				}
+			}
+			h.Write(buf[:n])
+			// Swap the buffers, pending now holds the last read
+			pending, buf = buf[:n], pending[:cap(pending)]
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if hex.EncodeToString(h.Sum(nil)) != expected {
+		return errHashMismatch
+	}
+
+	_, err := w.Write(pending)
+	return err
+}
+
+// serveLookasideRange serves a range request.
+// Ranges can't be verified on their own, so the whole blob is verified into
+// a temporary file first, which then serves the range.
+func serveLookasideRange(w http.ResponseWriter, r *http.Request, name string, rc io.Reader, h hash.Hash, expected string) {
+	f, err := os.CreateTemp("", "mship-lookaside-*")
+	if err != nil {
+		base.LogErrorf("failed to create temporary file: %v", err)
+		http.Error(w, "failed to serve blob", http.StatusInternalServerError)
+		return
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	err = copyVerified(f, rc, h, expected)
+	if err != nil {
+		base.LogErrorf("failed to serve lookaside blob %s: %v", expected, err)
+		http.Error(w, "failed to serve blob", http.StatusInternalServerError)
+		return
+	}
+
+	// The blob never changes, so there is no modification time to compare
+	http.ServeContent(w, r, name, time.Time{}, f)
+}
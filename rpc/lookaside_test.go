@@ -0,0 +1,200 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_rpc
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	storage_memory "github.com/openela/mothership/base/storage/memory"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// fakeLookasideIndex serves the blobs whose hash it contains.
+type fakeLookasideIndex map[string]bool
+
+func (f fakeLookasideIndex) servable(hashType string, hash string) (bool, error) {
+	return f[hash], nil
+}
+
+func newLookasideTestServer(t *testing.T) (*httptest.Server, *storage_memory.InMemory) {
+	st := storage_memory.New(memfs.New())
+	s := &Server{storage: st, lookaside: testLookasideIndex}
+
+	mux := runtime.NewServeMux()
+	require.Nil(t, s.registerLookaside(mux))
+
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+
+	return ts, st
+}
+
+// testLookasideIndex is shared by all lookaside tests, blobs are content
+// addressed so tests don't interfere.
+var testLookasideIndex = fakeLookasideIndex{}
+
+// putBlob stores a blob and marks it as referenced.
+func putBlob(t *testing.T, st *storage_memory.InMemory, data []byte) string {
+	hash := putUnreferencedBlob(t, st, data)
+	testLookasideIndex[hash] = true
+
+	return hash
+}
+
+func putUnreferencedBlob(t *testing.T, st *storage_memory.InMemory, data []byte) string {
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+	_, err := st.PutBytes(hash, data)
+	require.Nil(t, err)
+
+	return hash
+}
+
+func lookasideURL(ts *httptest.Server, hashType string, hash string) string {
+	return ts.URL + "/lookaside/bash/bash-5.2.tar.gz/" + hashType + "/" + hash + "/bash-5.2.tar.gz"
+}
+
+func TestLookaside_Get(t *testing.T) {
+	ts, st := newLookasideTestServer(t)
+	data := bytes.Repeat([]byte("bash"), lookasideBufferSize)
+	hash := putBlob(t, st, data)
+
+	resp, err := http.Get(lookasideURL(ts, "sha256", hash))
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, int64(len(data)), resp.ContentLength)
+
+	body, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	require.Equal(t, data, body)
+}
+
+func TestLookaside_Head(t *testing.T) {
+	ts, st := newLookasideTestServer(t)
+	hash := putBlob(t, st, []byte("hello"))
+
+	resp, err := http.Head(lookasideURL(ts, "SHA256", hash))
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, int64(5), resp.ContentLength)
+	require.Equal(t, "bytes", resp.Header.Get("Accept-Ranges"))
+}
+
+func TestLookaside_Range(t *testing.T) {
+	ts, st := newLookasideTestServer(t)
+	hash := putBlob(t, st, []byte("hello world"))
+
+	req, err := http.NewRequest(http.MethodGet, lookasideURL(ts, "sha256", hash), nil)
+	require.Nil(t, err)
+	req.Header.Set("Range", "bytes=6-")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusPartialContent, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	require.Equal(t, []byte("world"), body)
+}
+
+func TestLookaside_NotFound(t *testing.T) {
+	ts, _ := newLookasideTestServer(t)
+	sum := sha256.Sum256([]byte("missing"))
+
+	resp, err := http.Get(lookasideURL(ts, "sha256", hex.EncodeToString(sum[:])))
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestLookaside_Unreferenced(t *testing.T) {
+	ts, st := newLookasideTestServer(t)
+	hash := putUnreferencedBlob(t, st, []byte("uploaded, never imported"))
+
+	for _, method := range []string{http.MethodGet, http.MethodHead} {
+		req, err := http.NewRequest(method, lookasideURL(ts, "sha256", hash), nil)
+		require.Nil(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.Nil(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	}
+}
+
+func TestLookaside_UnsupportedHashType(t *testing.T) {
+	ts, st := newLookasideTestServer(t)
+	hash := putBlob(t, st, []byte("hello"))
+
+	resp, err := http.Get(lookasideURL(ts, "md5", hash))
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestLookaside_InvalidHash(t *testing.T) {
+	ts, _ := newLookasideTestServer(t)
+
+	resp, err := http.Get(lookasideURL(ts, "sha256", "not-a-hash"))
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestLookaside_FileMismatch(t *testing.T) {
+	ts, st := newLookasideTestServer(t)
+	hash := putBlob(t, st, []byte("hello"))
+
+	resp, err := http.Get(ts.URL + "/lookaside/bash/a.tar.gz/sha256/" + hash + "/b.tar.gz")
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestLookaside_Corrupted(t *testing.T) {
+	ts, st := newLookasideTestServer(t)
+	data := bytes.Repeat([]byte("bash"), lookasideBufferSize)
+	hash := putBlob(t, st, data)
+	_, err := st.PutBytes(hash, bytes.Repeat([]byte("evil"), lookasideBufferSize))
+	require.Nil(t, err)
+
+	// The response is cut short
+	resp, err := http.Get(lookasideURL(ts, "sha256", hash))
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NotNil(t, err)
+	require.Less(t, len(body), len(data))
+
+	req, err := http.NewRequest(http.MethodGet, lookasideURL(ts, "sha256", hash), nil)
+	require.Nil(t, err)
+	req.Header.Set("Range", "bytes=0-3")
+	resp, err = http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+}
+
+func TestCopyVerified(t *testing.T) {
+	data := []byte("hello")
+	sum := sha256.Sum256(data)
+
+	var buf bytes.Buffer
+	require.Nil(t, copyVerified(&buf, bytes.NewReader(data), sha256.New(), hex.EncodeToString(sum[:])))
+	require.Equal(t, data, buf.Bytes())
+
+	buf.Reset()
+	err := copyVerified(&buf, bytes.NewReader([]byte("hellO")), sha256.New(), hex.EncodeToString(sum[:]))
+	require.Equal(t, errHashMismatch, err)
+	require.Less(t, buf.Len(), len(data))
+}
@@ -4,7 +4,10 @@
 package mothership_rpc
 
 import (
+	"time"
+
 	"github.com/openela/mothership/base"
+	"github.com/openela/mothership/base/storage"
 	mothershippb "github.com/openela/mothership/proto/v1"
 	"github.com/openela/mothership/third_party/googleapis/google/longrunning"
 	"go.temporal.io/sdk/client"
@@ -18,19 +21,32 @@ type Server struct {
 	longrunning.UnimplementedOperationsServer
 
 	db       *base.DB
+	storage  storage.Storage
 	temporal client.Client
+
+	// lookaside decides which blobs the lookaside endpoint serves.
+	lookaside lookasideIndex
+
+	// quorum is the number of distinct worker organizations that must submit
+	// an SRPM before it is imported, disabled if less than 2.
+	quorum        int32
+	quorumTimeout time.Duration
 }
 
-func NewServer(db *base.DB, temporalClient client.Client, opts ...base.GRPCServerOption) (*Server, error) {
+func NewServer(db *base.DB, storage storage.Storage, temporalClient client.Client, quorum int32, quorumTimeout time.Duration, opts ...base.GRPCServerOption) (*Server, error) {
 	grpcServer, err := base.NewGRPCServer(opts...)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Server{
-		GRPCServer: *grpcServer,
-		db:         db,
-		temporal:   temporalClient,
+		GRPCServer:    *grpcServer,
+		db:            db,
+		storage:       storage,
+		temporal:      temporalClient,
+		lookaside:     &dbLookasideIndex{db: db},
+		quorum:        quorum,
+		quorumTimeout: quorumTimeout,
 	}, nil
 }
 
@@ -45,6 +61,9 @@ func (s *Server) Start() error {
 	); err != nil {
 		return err
 	}
+	if err := s.registerLookaside(s.GatewayMux()); err != nil {
+		return err
+	}
 
 	return s.GRPCServer.Start()
 }
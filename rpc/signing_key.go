@@ -0,0 +1,30 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_rpc
+
+import (
+	"context"
+
+	"github.com/openela/mothership/base"
+	mothership_db "github.com/openela/mothership/db"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"go.ciq.dev/pika"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) ListSigningKeys(_ context.Context, req *mothershippb.ListSigningKeysRequest) (*mothershippb.ListSigningKeysResponse, error) {
+	aipOptions := pika.ProtoReflect(&mothershippb.SigningKey{})
+
+	page, nt, err := base.Q[mothership_db.SigningKey](s.db).GetPage(req, aipOptions)
+	if err != nil {
+		base.LogErrorf("failed to get signing key page: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get signing key page")
+	}
+
+	return &mothershippb.ListSigningKeysResponse{
+		SigningKeys:   base.SliceToPB[*mothershippb.SigningKey, *mothership_db.SigningKey](page),
+		NextPageToken: nt,
+	}, nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_rpc
+
+import (
+	"context"
+
+	"github.com/openela/mothership/base"
+	"github.com/openela/mothership/base/tlog"
+	mothership_db "github.com/openela/mothership/db"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getLogCheckpoint returns the checkpoint of the tree of size, or the latest
+// checkpoint if size is 0.
+// Unsigned checkpoints, which older worker servers created without a
+// signing key, aren't published.
+func (s *Server) getLogCheckpoint(size int64) (*mothership_db.LogCheckpoint, error) {
+	if size < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid tree size")
+	}
+
+	q := base.Q[mothership_db.LogCheckpoint](s.db)
+	if size > 0 {
+		q = q.F("tree_size", size)
+	}
+
+	// Checkpoints are ordered by descending tree size
+	checkpoints, err := q.Limit(1).All()
+	if err != nil {
+		base.LogErrorf("failed to get log checkpoints: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get log checkpoint")
+	}
+
+	if len(checkpoints) == 0 || checkpoints[0].Signature == "" {
+		return nil, status.Error(codes.NotFound, "log checkpoint not found")
+	}
+
+	return checkpoints[0], nil
+}
+
+func (s *Server) GetLogCheckpoint(_ context.Context, req *mothershippb.GetLogCheckpointRequest) (*mothershippb.LogCheckpoint, error) {
+	checkpoint, err := s.getLogCheckpoint(req.TreeSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkpoint.ToPB(), nil
+}
+
+func (s *Server) GetInclusionProof(_ context.Context, req *mothershippb.GetInclusionProofRequest) (*mothershippb.InclusionProof, error) {
+	checkpoint, err := s.getLogCheckpoint(req.TreeSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prove the latest state of the entry in the tree. An entry only has a
+	// leaf for each time it was archived or retracted.
+	leaves, err := base.Q[mothership_db.LogLeaf](s.db).F("entry_name", req.Name).OrderBy("-leaf_index").All()
+	if err != nil {
+		base.LogErrorf("failed to get log leaves: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get log leaves")
+	}
+	var leaf *mothership_db.LogLeaf
+	for _, l := range leaves {
+		if l.LeafIndex < checkpoint.TreeSize {
+			leaf = l
+			break
+		}
+	}
+	if leaf == nil {
+		return nil, status.Error(codes.NotFound, "entry not found in log")
+	}
+
+	proof, err := tlog.ProveInclusion(&mothership_db.LogHashReader{DB: s.db}, checkpoint.TreeSize, leaf.LeafIndex)
+	if err != nil {
+		base.LogErrorf("failed to compute inclusion proof: %v", err)
+		return nil, status.Error(codes.Internal, "failed to compute inclusion proof")
+	}
+
+	return &mothershippb.InclusionProof{
+		Leaf:     leaf.ToPB(),
+		TreeSize: checkpoint.TreeSize,
+		Hashes:   proof,
+	}, nil
+}
+
+func (s *Server) GetConsistencyProof(_ context.Context, req *mothershippb.GetConsistencyProofRequest) (*mothershippb.ConsistencyProof, error) {
+	checkpoint, err := s.getLogCheckpoint(req.SecondTreeSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.FirstTreeSize < 1 || req.FirstTreeSize > checkpoint.TreeSize {
+		return nil, status.Error(codes.InvalidArgument, "first tree size must be between 1 and the second tree size")
+	}
+
+	proof, err := tlog.ProveConsistency(&mothership_db.LogHashReader{DB: s.db}, req.FirstTreeSize, checkpoint.TreeSize)
+	if err != nil {
+		base.LogErrorf("failed to compute consistency proof: %v", err)
+		return nil, status.Error(codes.Internal, "failed to compute consistency proof")
+	}
+
+	return &mothershippb.ConsistencyProof{
+		FirstTreeSize:  req.FirstTreeSize,
+		SecondTreeSize: checkpoint.TreeSize,
+		Hashes:         proof,
+	}, nil
+}
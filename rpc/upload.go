@@ -0,0 +1,156 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_rpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/openela/mothership/base"
+	"github.com/openela/mothership/base/storage"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// uploadURLExpiry is how long a presigned upload URL is valid for.
+const uploadURLExpiry = 15 * time.Minute
+
+// maxUploadSize is the largest object workers can upload through
+// WorkerUploadObject. It's a variable so tests can lower it.
+var maxUploadSize int64 = 8 << 30
+
+// WorkerUploadObject handles the RPC request for uploading an object. This is
+// called by workers that don't have direct access to the object storage.
+// The object is spooled to a temporary file while it is being hashed, then
+// stored under its SHA-256 hash. The returned URI can be used in SubmitEntry.
+func (s *Server) WorkerUploadObject(stream mothershippb.SrpmArchiver_WorkerUploadObjectServer) error {
+	_, err := s.getWorkerIdentity(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	return s.uploadObject(stream)
+}
+
+// uploadObject receives an object from a worker and stores it.
+// The temporary file is removed, whether the upload succeeds or not.
+func (s *Server) uploadObject(stream mothershippb.SrpmArchiver_WorkerUploadObjectServer) error {
+	f, err := os.CreateTemp("", "mship-upload-*")
+	if err != nil {
+		base.LogErrorf("failed to create temporary file: %v", err)
+		return status.Error(codes.Internal, "failed to create temporary file")
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	hash := sha256.New()
+	w := io.MultiWriter(f, hash)
+	var size int64
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if size+int64(len(req.Chunk)) > maxUploadSize {
+			return status.Errorf(codes.InvalidArgument, "object is larger than %d bytes", maxUploadSize)
+		}
+
+		n, err := w.Write(req.Chunk)
+		if err != nil {
+			base.LogErrorf("failed to write chunk: %v", err)
+			return status.Error(codes.Internal, "failed to write chunk")
+		}
+		size += int64(n)
+	}
+
+	if size == 0 {
+		return status.Error(codes.InvalidArgument, "object is empty")
+	}
+
+	if err := f.Close(); err != nil {
+		base.LogErrorf("failed to close temporary file: %v", err)
+		return status.Error(codes.Internal, "failed to close temporary file")
+	}
+
+	object := hex.EncodeToString(hash.Sum(nil))
+	exists, err := s.storage.Exists(object)
+	if err != nil {
+		base.LogErrorf("failed to check if object exists: %v", err)
+		return status.Error(codes.Internal, "failed to check if object exists")
+	}
+	if exists {
+		return status.Errorf(codes.AlreadyExists, "object %s already exists", object)
+	}
+
+	info, err := s.storage.Put(object, f.Name())
+	if err != nil {
+		base.LogErrorf("failed to upload object: %v", err)
+		return status.Error(codes.Internal, "failed to upload object")
+	}
+
+	return stream.SendAndClose(&mothershippb.WorkerUploadObjectResponse{
+		Uri: info.Location,
+	})
+}
+
+// CreateUploadURL handles the RPC request for creating a presigned upload URL.
+// The object is named by the checksum the worker declares, and the storage
+// backend rejects uploads that don't match the checksum.
+func (s *Server) CreateUploadURL(ctx context.Context, req *mothershippb.CreateUploadURLRequest) (*mothershippb.CreateUploadURLResponse, error) {
+	_, err := s.getWorkerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.createUploadURL(req)
+}
+
+// createUploadURL presigns the upload of the object named by the checksum in
+// req.
+// The checksum is lowercased, objects are named by lowercase hashes
+// everywhere else, so an uppercase name would never be found again.
+func (s *Server) createUploadURL(req *mothershippb.CreateUploadURLRequest) (*mothershippb.CreateUploadURLResponse, error) {
+	checksum := strings.ToLower(req.Checksum)
+	sum, err := hex.DecodeString(checksum)
+	if err != nil || len(sum) != sha256.Size {
+		return nil, status.Error(codes.InvalidArgument, "checksum must be a hex encoded SHA-256 checksum")
+	}
+
+	presigner, ok := s.storage.(storage.Presigner)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "storage backend does not support presigned uploads")
+	}
+
+	exists, err := s.storage.Exists(checksum)
+	if err != nil {
+		base.LogErrorf("failed to check if object exists: %v", err)
+		return nil, status.Error(codes.Internal, "failed to check if object exists")
+	}
+	if exists {
+		return nil, status.Errorf(codes.AlreadyExists, "object %s already exists", checksum)
+	}
+
+	presigned, err := presigner.PresignPut(checksum, checksum, uploadURLExpiry)
+	if err != nil {
+		base.LogErrorf("failed to presign upload: %v", err)
+		return nil, status.Error(codes.Internal, "failed to presign upload")
+	}
+
+	return &mothershippb.CreateUploadURLResponse{
+		Url:        presigned.URL,
+		Headers:    presigned.Headers,
+		Uri:        presigned.Location,
+		ExpireTime: timestamppb.New(presigned.Expires),
+	}, nil
+}
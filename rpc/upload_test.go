@@ -0,0 +1,214 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_rpc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/openela/mothership/base/storage"
+	storage_file "github.com/openela/mothership/base/storage/file"
+	storage_memory "github.com/openela/mothership/base/storage/memory"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeUploadStream sends the chunks, then err (io.EOF if nil).
+type fakeUploadStream struct {
+	grpc.ServerStream
+
+	chunks [][]byte
+	err    error
+	resp   *mothershippb.WorkerUploadObjectResponse
+}
+
+func (f *fakeUploadStream) Recv() (*mothershippb.WorkerUploadObjectRequest, error) {
+	if len(f.chunks) == 0 {
+		if f.err != nil {
+			return nil, f.err
+		}
+		return nil, io.EOF
+	}
+
+	chunk := f.chunks[0]
+	f.chunks = f.chunks[1:]
+	return &mothershippb.WorkerUploadObjectRequest{Chunk: chunk}, nil
+}
+
+func (f *fakeUploadStream) SendAndClose(resp *mothershippb.WorkerUploadObjectResponse) error {
+	f.resp = resp
+	return nil
+}
+
+// newUploadTestServer returns a server with file storage, and the directory
+// temporary files are spooled to.
+func newUploadTestServer(t *testing.T) (*Server, *storage_file.File, string) {
+	tmpDir := t.TempDir()
+	t.Setenv("TMPDIR", tmpDir)
+
+	st, err := storage_file.New(t.TempDir())
+	require.Nil(t, err)
+
+	return &Server{storage: st}, st, tmpDir
+}
+
+func requireNoTempFiles(t *testing.T, tmpDir string) {
+	entries, err := os.ReadDir(tmpDir)
+	require.Nil(t, err)
+	require.Empty(t, entries)
+}
+
+func TestUploadObject(t *testing.T) {
+	s, st, tmpDir := newUploadTestServer(t)
+
+	stream := &fakeUploadStream{chunks: [][]byte{[]byte("hel"), []byte("lo")}}
+	require.Nil(t, s.uploadObject(stream))
+
+	// sha256 of "hello"
+	object := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	uriObject, err := st.ObjectFromURI(stream.resp.Uri)
+	require.Nil(t, err)
+	require.Equal(t, object, uriObject)
+
+	data, err := st.Get(object)
+	require.Nil(t, err)
+	require.Equal(t, []byte("hello"), data)
+
+	requireNoTempFiles(t, tmpDir)
+}
+
+func TestUploadObject_Empty(t *testing.T) {
+	s, _, tmpDir := newUploadTestServer(t)
+
+	err := s.uploadObject(&fakeUploadStream{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	requireNoTempFiles(t, tmpDir)
+}
+
+func TestUploadObject_TooLarge(t *testing.T) {
+	s, st, tmpDir := newUploadTestServer(t)
+
+	oldMaxUploadSize := maxUploadSize
+	maxUploadSize = 4
+	t.Cleanup(func() { maxUploadSize = oldMaxUploadSize })
+
+	err := s.uploadObject(&fakeUploadStream{chunks: [][]byte{[]byte("hel"), []byte("lo")}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	objects, err := st.List("")
+	require.Nil(t, err)
+	require.Empty(t, objects)
+	requireNoTempFiles(t, tmpDir)
+}
+
+func TestUploadObject_AlreadyExists(t *testing.T) {
+	s, st, tmpDir := newUploadTestServer(t)
+
+	_, err := st.PutBytes("2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", []byte("hello"))
+	require.Nil(t, err)
+
+	stream := &fakeUploadStream{chunks: [][]byte{[]byte("hello")}}
+	err = s.uploadObject(stream)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	require.Nil(t, stream.resp)
+
+	requireNoTempFiles(t, tmpDir)
+}
+
+func TestUploadObject_Aborted(t *testing.T) {
+	s, st, tmpDir := newUploadTestServer(t)
+
+	stream := &fakeUploadStream{
+		chunks: [][]byte{[]byte("hel")},
+		err:    status.Error(codes.Canceled, "context canceled"),
+	}
+	err := s.uploadObject(stream)
+	require.Equal(t, codes.Canceled, status.Code(err))
+
+	objects, err := st.List("")
+	require.Nil(t, err)
+	require.Empty(t, objects)
+	requireNoTempFiles(t, tmpDir)
+}
+
+// presignStorage is in-memory storage that presigns uploads.
+type presignStorage struct {
+	*storage_memory.InMemory
+}
+
+func (p *presignStorage) PresignPut(object string, checksumSha256 string, expires time.Duration) (*storage.PresignedRequest, error) {
+	return &storage.PresignedRequest{
+		URL:      "https://uploads.example.com/" + object,
+		Headers:  map[string]string{"x-checksum-sha256": checksumSha256},
+		Location: "memory://" + object,
+		Expires:  time.Unix(0, 0).Add(expires),
+	}, nil
+}
+
+func TestCreateUploadURL(t *testing.T) {
+	s := &Server{storage: &presignStorage{storage_memory.New(memfs.New())}}
+
+	sum := sha256.Sum256([]byte("hello"))
+	checksum := hex.EncodeToString(sum[:])
+	resp, err := s.createUploadURL(&mothershippb.CreateUploadURLRequest{Checksum: checksum})
+	require.Nil(t, err)
+	require.Equal(t, "https://uploads.example.com/"+checksum, resp.Url)
+	require.Equal(t, checksum, resp.Headers["x-checksum-sha256"])
+	require.Equal(t, "memory://"+checksum, resp.Uri)
+	require.Equal(t, time.Unix(0, 0).Add(uploadURLExpiry).Unix(), resp.ExpireTime.AsTime().Unix())
+}
+
+func TestCreateUploadURL_Uppercase(t *testing.T) {
+	s := &Server{storage: &presignStorage{storage_memory.New(memfs.New())}}
+
+	sum := sha256.Sum256([]byte("hello"))
+	checksum := hex.EncodeToString(sum[:])
+	resp, err := s.createUploadURL(&mothershippb.CreateUploadURLRequest{Checksum: strings.ToUpper(checksum)})
+	require.Nil(t, err)
+	require.Equal(t, "https://uploads.example.com/"+checksum, resp.Url)
+	require.Equal(t, checksum, resp.Headers["x-checksum-sha256"])
+	require.Equal(t, "memory://"+checksum, resp.Uri)
+}
+
+func TestCreateUploadURL_InvalidChecksum(t *testing.T) {
+	s := &Server{storage: &presignStorage{storage_memory.New(memfs.New())}}
+
+	_, err := s.createUploadURL(&mothershippb.CreateUploadURLRequest{Checksum: "not-hex"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// SHA-512 checksums aren't accepted
+	sum := sha256.Sum256([]byte("hello"))
+	_, err = s.createUploadURL(&mothershippb.CreateUploadURLRequest{Checksum: hex.EncodeToString(sum[:]) + hex.EncodeToString(sum[:])})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCreateUploadURL_AlreadyExists(t *testing.T) {
+	st := storage_memory.New(memfs.New())
+	s := &Server{storage: &presignStorage{st}}
+
+	sum := sha256.Sum256([]byte("hello"))
+	checksum := hex.EncodeToString(sum[:])
+	_, err := st.PutBytes(checksum, []byte("hello"))
+	require.Nil(t, err)
+
+	_, err = s.createUploadURL(&mothershippb.CreateUploadURLRequest{Checksum: checksum})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestCreateUploadURL_Unsupported(t *testing.T) {
+	s := &Server{storage: storage_memory.New(memfs.New())}
+
+	sum := sha256.Sum256([]byte("hello"))
+	_, err := s.createUploadURL(&mothershippb.CreateUploadURLRequest{Checksum: hex.EncodeToString(sum[:])})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -161,7 +161,11 @@ export default function Entries() {
             <SelectContent>
               <SelectItem value={'state="ARCHIVED"'}>Archived</SelectItem>
               <SelectItem value={'state="ARCHIVING"'}>In progress</SelectItem>
+              <SelectItem value={'state="AWAITING_QUORUM"'}>
+                Awaiting quorum
+              </SelectItem>
               <SelectItem value={'state="ON_HOLD"'}>On hold</SelectItem>
+              <SelectItem value={'state="CONFLICT"'}>Conflict</SelectItem>
               <SelectItem value={'state="RETRACTED" OR state="RETRACTING"'}>
                 Retracted
               </SelectItem>
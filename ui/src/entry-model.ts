@@ -12,6 +12,8 @@ export enum EntryState {
   FAILED = 5,
   RETRACTING = 6,
   RETRACTED = 7,
+  AWAITING_QUORUM = 8,
+  CONFLICT = 9,
 }
 
 export interface Entry {
@@ -100,10 +100,12 @@ export default function Entry() {
                 variant={
                   data.state.toString() === 'FAILED' ||
                   data.state.toString() === 'RETRACTED' ||
-                  data.state.toString() === 'ON_HOLD'
+                  data.state.toString() === 'ON_HOLD' ||
+                  data.state.toString() === 'CONFLICT'
                     ? 'destructive'
                     : data.state.toString() === 'ARCHIVING' ||
-                        data.state.toString() === 'RETRACTING'
+                        data.state.toString() === 'RETRACTING' ||
+                        data.state.toString() === 'AWAITING_QUORUM'
                       ? 'default'
                       : 'outline'
                 }
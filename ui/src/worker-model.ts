@@ -22,6 +22,10 @@ export interface Worker {
   // This is only returned when creating a new worker.
   // Can not be retrieved or changed later.
   apiSecret?: string;
+
+  // Organization that operates the worker.
+  // Workers without an organization are their own organization.
+  organization: string;
 }
 
 export interface WorkersResponse {
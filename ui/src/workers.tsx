@@ -102,11 +102,12 @@ export default function Workers() {
   const create = async (e: React.FormEvent<HTMLFormElement>) => {
     e.preventDefault();
     const workerId = e.currentTarget.workerId.value;
+    const organization = e.currentTarget.organization.value;
 
     setSubmitting(true);
     const res: Worker = await fetchAdminAPI('/v1/workers', {
       method: 'POST',
-      body: JSON.stringify({ workerId }),
+      body: JSON.stringify({ workerId, organization }),
     });
 
     setWorker(res);
@@ -161,6 +162,7 @@ export default function Workers() {
                 </DialogHeader>
                 <form onSubmit={create} className="flex flex-col space-y-4">
                   <Input name="workerId" placeholder="Worker ID" />
+                  <Input name="organization" placeholder="Organization" />
                   <DialogFooter>
                     <Button type="submit" disabled={submitting}>
                       {submitting && (
@@ -0,0 +1,149 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/openela/mothership/base/attestation"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/pkg/errors"
+	"go.temporal.io/sdk/temporal"
+)
+
+const (
+	// importBuildType is the SLSA build type of imports.
+	importBuildType = "https://github.com/openela/mothership/import/v1"
+
+	// defaultBuilderID identifies the worker server if it has no public URI.
+	defaultBuilderID = "https://github.com/openela/mothership/worker_server"
+)
+
+// builderID returns the SLSA builder ID of the worker server.
+func (w *Worker) builderID() string {
+	if w.publicURI == "" {
+		return defaultBuilderID
+	}
+
+	return strings.TrimSuffix(w.publicURI, "/") + "/worker_server"
+}
+
+// lookasideMaterialURI returns the URI of a lookaside blob on the API gateway
+// at publicURI.
+// The URI is relative if the worker server has no public URI.
+func lookasideMaterialURI(publicURI string, pkg string, file string, sha256 string) string {
+	path := fmt.Sprintf("lookaside/%s/%s/sha256/%s/%[2]s", pkg, file, sha256)
+	if publicURI == "" {
+		return path
+	}
+
+	return strings.TrimSuffix(publicURI, "/") + "/" + path
+}
+
+// entryStatement returns the SLSA provenance statement of an archived entry.
+// The subjects are the SRPM and the import commit, the materials are the
+// SRPM and every lookaside blob of the import.
+// Lookaside blobs are referred to by their SHA-256 path on the API gateway,
+// blobs are stored under both hashes so the path resolves whatever metadata
+// format the import wrote.
+func entryStatement(builderID string, publicURI string, entry *mothershippb.Entry, args *mothershippb.ProcessRPMArgs, importRpmRes *mothershippb.ImportRPMResponse, finished time.Time) *attestation.Statement {
+	materials := []*attestation.Material{
+		{
+			URI:    args.Request.RpmUri,
+			Digest: attestation.DigestSet{"sha256": entry.Sha256Sum},
+		},
+	}
+	for _, c := range importRpmRes.LookasideClassifications {
+		if !c.Lookaside {
+			continue
+		}
+		materials = append(materials, &attestation.Material{
+			URI: lookasideMaterialURI(publicURI, importRpmRes.Pkg, c.File, c.Sha256),
+			Digest: attestation.DigestSet{
+				"sha256": c.Sha256,
+				"sha512": c.Sha512,
+			},
+		})
+	}
+
+	started := entry.CreateTime.AsTime()
+	provenance := &attestation.Provenance{
+		Builder:   &attestation.Builder{ID: builderID},
+		BuildType: importBuildType,
+		Invocation: &attestation.Invocation{
+			Parameters: map[string]string{
+				"rpmUri":     args.Request.RpmUri,
+				"checksum":   args.Request.Checksum,
+				"osRelease":  entry.OsRelease,
+				"repository": entry.Repository,
+				"batch":      entry.Batch.GetValue(),
+			},
+			Environment: map[string]string{
+				"workerId": entry.WorkerId.GetValue(),
+			},
+		},
+		Metadata: &attestation.Metadata{
+			BuildInvocationID: entry.Name,
+			BuildStartedOn:    &started,
+			BuildFinishedOn:   &finished,
+			Completeness: &attestation.Completeness{
+				Parameters: true,
+				Materials:  true,
+			},
+		},
+		Materials: materials,
+	}
+
+	return attestation.NewProvenanceStatement(
+		provenance,
+		&attestation.Subject{
+			Name:   entry.EntryId + ".rpm",
+			Digest: attestation.DigestSet{"sha256": entry.Sha256Sum},
+		},
+		&attestation.Subject{
+			Name:   importRpmRes.CommitUri,
+			Digest: attestation.DigestSet{"gitCommit": importRpmRes.CommitHash},
+		},
+	)
+}
+
+// AttestEntry creates the SLSA provenance attestation of an archived entry,
+// signs it with the signing key of the worker server and stores it.
+// Entries aren't attested if the worker server has no signing key.
+// This is a Temporal activity.
+func (w *Worker) AttestEntry(entry *mothershippb.Entry, args *mothershippb.ProcessRPMArgs, importRpmRes *mothershippb.ImportRPMResponse) error {
+	if entry.State != mothershippb.Entry_ARCHIVED {
+		return temporal.NewNonRetryableApplicationError(
+			"entry is not archived",
+			"entryNotArchived",
+			errors.New("only archived entries can be attested"),
+		)
+	}
+	if w.signer == nil {
+		slog.Info("no signing key, not attesting entry", "entry", entry.Name)
+		return nil
+	}
+
+	statement := entryStatement(w.builderID(), w.publicURI, entry, args, importRpmRes, time.Now())
+	envelope, err := attestation.Sign(statement, w.signer)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign attestation")
+	}
+
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal attestation")
+	}
+
+	_, err = w.storage.PutBytes(attestation.ObjectName(entry.Name), data)
+	if err != nil {
+		return errors.Wrap(err, "failed to store attestation")
+	}
+
+	return nil
+}
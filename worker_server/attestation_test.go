@@ -0,0 +1,157 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/openela/mothership/base/attestation"
+	"github.com/openela/mothership/base/signing"
+	storage_memory "github.com/openela/mothership/base/storage/memory"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func testAttestationArgs() (*mothershippb.Entry, *mothershippb.ProcessRPMArgs, *mothershippb.ImportRPMResponse) {
+	entry := &mothershippb.Entry{
+		Name:       "entries/123",
+		EntryId:    "efi-rpm-macros-3-3.el8.src",
+		CreateTime: timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		OsRelease:  "Rocky Linux release 8.8 (Green Obsidian)",
+		Sha256Sum:  "518a9418fec1deaeb4c636615d8d81fb60146883c431ea15ab1127893d075d28",
+		WorkerId:   wrapperspb.String("worker-1"),
+		State:      mothershippb.Entry_ARCHIVED,
+	}
+	args := &mothershippb.ProcessRPMArgs{
+		Request: &mothershippb.ProcessRPMRequest{
+			RpmUri:   "memory://efi-rpm-macros-3-3.el8.src.rpm",
+			Checksum: entry.Sha256Sum,
+		},
+	}
+	importRpmRes := &mothershippb.ImportRPMResponse{
+		CommitHash: "0123456789abcdef0123456789abcdef01234567",
+		CommitUri:  "https://git.example.com/efi-rpm-macros/commit/0123456789abcdef0123456789abcdef01234567",
+		Pkg:        "efi-rpm-macros",
+		LookasideClassifications: []*mothershippb.LookasideClassification{
+			{File: "0001-fix.patch", Rule: "default"},
+			{File: "efi-rpm-macros-3.tar.bz2", Lookaside: true, Rule: "include *.tar*", Sha256: "f002", Sha512: "0fba"},
+		},
+	}
+
+	return entry, args, importRpmRes
+}
+
+func TestEntryStatement(t *testing.T) {
+	entry, args, importRpmRes := testAttestationArgs()
+	finished := time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC)
+	statement := entryStatement(defaultBuilderID, "https://mship.example.com/", entry, args, importRpmRes, finished)
+
+	require.Equal(t, attestation.StatementType, statement.Type)
+	require.Equal(t, attestation.PredicateTypeSLSAProvenance, statement.PredicateType)
+	require.Equal(t, []*attestation.Subject{
+		{Name: "efi-rpm-macros-3-3.el8.src.rpm", Digest: attestation.DigestSet{"sha256": entry.Sha256Sum}},
+		{Name: importRpmRes.CommitUri, Digest: attestation.DigestSet{"gitCommit": importRpmRes.CommitHash}},
+	}, statement.Subject)
+
+	provenance := statement.Predicate.(*attestation.Provenance)
+	require.Equal(t, defaultBuilderID, provenance.Builder.ID)
+	require.Equal(t, "entries/123", provenance.Metadata.BuildInvocationID)
+	require.Equal(t, entry.CreateTime.AsTime(), *provenance.Metadata.BuildStartedOn)
+	require.Equal(t, finished, *provenance.Metadata.BuildFinishedOn)
+
+	// Only lookaside blobs are materials, files in git are part of the commit
+	require.Equal(t, []*attestation.Material{
+		{URI: args.Request.RpmUri, Digest: attestation.DigestSet{"sha256": entry.Sha256Sum}},
+		{
+			URI:    "https://mship.example.com/lookaside/efi-rpm-macros/efi-rpm-macros-3.tar.bz2/sha256/f002/efi-rpm-macros-3.tar.bz2",
+			Digest: attestation.DigestSet{"sha256": "f002", "sha512": "0fba"},
+		},
+	}, provenance.Materials)
+}
+
+func TestLookasideMaterialURI(t *testing.T) {
+	require.Equal(t, "lookaside/bash/bash-5.1.tar.gz/sha256/f002/bash-5.1.tar.gz", lookasideMaterialURI("", "bash", "bash-5.1.tar.gz", "f002"))
+	require.Equal(t, "https://mship.example.com/lookaside/bash/bash-5.1.tar.gz/sha256/f002/bash-5.1.tar.gz", lookasideMaterialURI("https://mship.example.com", "bash", "bash-5.1.tar.gz", "f002"))
+}
+
+func TestBuilderID(t *testing.T) {
+	require.Equal(t, defaultBuilderID, (&Worker{}).builderID())
+	require.Equal(t, "https://mship.example.com/worker_server", (&Worker{publicURI: "https://mship.example.com/"}).builderID())
+}
+
+func testAttestationSigner(t *testing.T) signing.Signer {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+	block, err := ssh.MarshalPrivateKey(priv, "")
+	require.Nil(t, err)
+
+	signer, err := signing.New(pem.EncodeToMemory(block), "", nil)
+	require.Nil(t, err)
+	return signer
+}
+
+func TestAttestEntry(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestActivityEnvironment()
+	storage := storage_memory.New(memfs.New())
+	signer := testAttestationSigner(t)
+	worker := &Worker{storage: storage, signer: signer}
+	env.RegisterActivity(worker)
+
+	entry, args, importRpmRes := testAttestationArgs()
+	_, err := env.ExecuteActivity(worker.AttestEntry, entry, args, importRpmRes)
+	require.Nil(t, err)
+
+	data, err := storage.Get("attestations/entries/123.intoto.json")
+	require.Nil(t, err)
+	var envelope attestation.Envelope
+	require.Nil(t, json.Unmarshal(data, &envelope))
+	require.Equal(t, attestation.PayloadType, envelope.PayloadType)
+	require.Len(t, envelope.Signatures, 1)
+	require.Equal(t, signer.Key().ID, envelope.Signatures[0].KeyID)
+
+	var statement map[string]any
+	require.Nil(t, json.Unmarshal(envelope.Payload, &statement))
+	require.Equal(t, attestation.PredicateTypeSLSAProvenance, statement["predicateType"])
+}
+
+func TestAttestEntry_NoSigner(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestActivityEnvironment()
+	storage := storage_memory.New(memfs.New())
+	worker := &Worker{storage: storage}
+	env.RegisterActivity(worker)
+
+	// Unsigned attestations aren't published
+	entry, args, importRpmRes := testAttestationArgs()
+	_, err := env.ExecuteActivity(worker.AttestEntry, entry, args, importRpmRes)
+	require.Nil(t, err)
+
+	ok, err := storage.Exists("attestations/entries/123.intoto.json")
+	require.Nil(t, err)
+	require.False(t, ok)
+}
+
+func TestAttestEntry_NotArchived(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestActivityEnvironment()
+	worker := &Worker{storage: storage_memory.New(memfs.New())}
+	env.RegisterActivity(worker)
+
+	entry, args, importRpmRes := testAttestationArgs()
+	entry.State = mothershippb.Entry_ON_HOLD
+	_, err := env.ExecuteActivity(worker.AttestEntry, entry, args, importRpmRes)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "entry is not archived")
+}
@@ -0,0 +1,170 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"database/sql"
+	"regexp"
+	"strconv"
+
+	"github.com/openela/mothership/base"
+	"github.com/openela/mothership/base/bugtracker"
+	gitlab_bugtracker "github.com/openela/mothership/base/bugtracker/gitlab"
+	jira_bugtracker "github.com/openela/mothership/base/bugtracker/jira"
+	mantis_bugtracker "github.com/openela/mothership/base/bugtracker/mantis"
+	mothership_db "github.com/openela/mothership/db"
+	mshipadminpb "github.com/openela/mothership/proto/admin/v1"
+	"github.com/pkg/errors"
+)
+
+var majorVersionRegex = regexp.MustCompile(`release (\d+)`)
+
+// majorVersion returns the major version of an OS release, or 0 if the
+// release has no version.
+func majorVersion(osRelease string) int32 {
+	match := majorVersionRegex.FindStringSubmatch(osRelease)
+	if match == nil {
+		return 0
+	}
+
+	version, err := strconv.ParseInt(match[1], 10, 32)
+	if err != nil {
+		return 0
+	}
+
+	return int32(version)
+}
+
+// NewBugtracker returns the bug tracker described by config.
+func NewBugtracker(config *mshipadminpb.BugTrackerConfig) (bugtracker.Bugtracker, error) {
+	switch config.Type {
+	case mshipadminpb.BugTrackerConfig_MANTIS:
+		mantis := config.GetMantis()
+		if mantis == nil {
+			return nil, errors.New("mantis config is required for mantis bug tracker")
+		}
+
+		return mantis_bugtracker.New(config.Uri, mantis.ApiKey, mantis.ProjectIds)
+	case mshipadminpb.BugTrackerConfig_GITLAB:
+		gitlab := config.GetGitlab()
+		if gitlab == nil {
+			return nil, errors.New("gitlab config is required for gitlab bug tracker")
+		}
+
+		return gitlab_bugtracker.New(config.Uri, gitlab.Project, gitlab.Token)
+	case mshipadminpb.BugTrackerConfig_JIRA:
+		jira := config.GetJira()
+		if jira == nil {
+			return nil, errors.New("jira config is required for jira bug tracker")
+		}
+
+		return jira_bugtracker.New(
+			config.Uri,
+			jira.Email,
+			jira.ApiToken,
+			jira.ProjectKey,
+			jira.IssueType,
+			jira.CloseTransition,
+			jira.Components,
+		)
+	default:
+		return nil, errors.Errorf("unsupported bug tracker type %s", config.Type)
+	}
+}
+
+// bugtrackerFromDB returns the bug tracker of a bug tracker config.
+func bugtrackerFromDB(config *mothership_db.BugTrackerConfig) (bugtracker.Bugtracker, error) {
+	configPb, err := config.Proto()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal bug tracker config")
+	}
+
+	tracker, err := NewBugtracker(configPb)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid bug tracker config %s", config.Name)
+	}
+
+	return tracker, nil
+}
+
+// activeBugtracker returns the bug tracker new tickets are created in, and
+// the name of its config.
+// The active bug tracker config takes precedence over the bug tracker of the
+// worker flags, which has no config name. The tracker is nil if neither
+// exists.
+// Configs are read on every call, so trackers can be switched and keys
+// rotated without restarting the worker.
+func (w *Worker) activeBugtracker() (bugtracker.Bugtracker, sql.NullString, error) {
+	config, err := base.Q[mothership_db.BugTrackerConfig](w.db).F("active", true).GetOrNil()
+	if err != nil {
+		return nil, sql.NullString{}, errors.Wrap(err, "failed to get active bug tracker config")
+	}
+	if config == nil {
+		return w.bugtracker, sql.NullString{}, nil
+	}
+
+	tracker, err := bugtrackerFromDB(config)
+	if err != nil {
+		return nil, sql.NullString{}, err
+	}
+
+	return tracker, sql.NullString{Valid: true, String: config.Name}, nil
+}
+
+// bugtrackerConfig returns the bug tracker config named configName.
+// The config is nil if configName is null, i.e. the bug tracker of the worker
+// flags is used, or if the config was deleted.
+func (w *Worker) bugtrackerConfig(configName sql.NullString) (*mshipadminpb.BugTrackerConfig, error) {
+	if !configName.Valid {
+		return nil, nil
+	}
+
+	config, err := base.Q[mothership_db.BugTrackerConfig](w.db).F("name", configName.String).GetOrNil()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get bug tracker config")
+	}
+	if config == nil {
+		return nil, nil
+	}
+
+	configPb, err := config.Proto()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal bug tracker config")
+	}
+
+	return configPb, nil
+}
+
+// entryTicketsEnabled returns true if tickets are created for failed
+// entries, in the bug tracker of configName.
+func (w *Worker) entryTicketsEnabled(configName sql.NullString) (bool, error) {
+	config, err := w.bugtrackerConfig(configName)
+	if err != nil {
+		return false, err
+	}
+	if config != nil {
+		return config.EntryTickets, nil
+	}
+
+	return w.entryTickets, nil
+}
+
+// ticketBugtracker returns the bug tracker a ticket was created in, from
+// the name of its config.
+// The tracker is nil if the config was deleted.
+func (w *Worker) ticketBugtracker(configName sql.NullString) (bugtracker.Bugtracker, error) {
+	if !configName.Valid {
+		return w.bugtracker, nil
+	}
+
+	config, err := base.Q[mothership_db.BugTrackerConfig](w.db).F("name", configName.String).GetOrNil()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get bug tracker config")
+	}
+	if config == nil {
+		return nil, nil
+	}
+
+	return bugtrackerFromDB(config)
+}
@@ -0,0 +1,115 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"testing"
+
+	mothership_db "github.com/openela/mothership/db"
+	mshipadminpb "github.com/openela/mothership/proto/admin/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMajorVersion(t *testing.T) {
+	require.Equal(t, int32(8), majorVersion("Rocky Linux release 8.8 (Green Obsidian)"))
+	require.Equal(t, int32(9), majorVersion("Red Hat Enterprise Linux release 9.2 (Plow)"))
+	require.Equal(t, int32(0), majorVersion("Fedora"))
+}
+
+func TestNewBugtracker(t *testing.T) {
+	_, err := NewBugtracker(&mshipadminpb.BugTrackerConfig{
+		Type: mshipadminpb.BugTrackerConfig_MANTIS,
+		Uri:  "https://mantis.example.com",
+		Config: &mshipadminpb.BugTrackerConfig_Mantis{
+			Mantis: &mshipadminpb.BugTrackerConfig_MantisConfig{
+				ApiKey:     "test_token",
+				ProjectIds: map[int32]int64{8: 1},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	// The Mantis config is required
+	_, err = NewBugtracker(&mshipadminpb.BugTrackerConfig{
+		Type: mshipadminpb.BugTrackerConfig_MANTIS,
+		Uri:  "https://mantis.example.com",
+	})
+	require.NotNil(t, err)
+
+	tracker, err := NewBugtracker(&mshipadminpb.BugTrackerConfig{
+		Type: mshipadminpb.BugTrackerConfig_GITLAB,
+		Uri:  "https://gitlab.example.com",
+		Config: &mshipadminpb.BugTrackerConfig_Gitlab{
+			Gitlab: &mshipadminpb.BugTrackerConfig_GitlabConfig{
+				Token:   "test_token",
+				Project: "openela/bugs",
+			},
+		},
+	})
+	require.Nil(t, err)
+	uri, err := tracker.TicketURI("42")
+	require.Nil(t, err)
+	require.Equal(t, "https://gitlab.example.com/openela/bugs/-/issues/42", uri)
+
+	tracker, err = NewBugtracker(&mshipadminpb.BugTrackerConfig{
+		Type: mshipadminpb.BugTrackerConfig_JIRA,
+		Uri:  "https://jira.example.com",
+		Config: &mshipadminpb.BugTrackerConfig_Jira{
+			Jira: &mshipadminpb.BugTrackerConfig_JiraConfig{
+				Email:      "mship@example.com",
+				ApiToken:   "test_token",
+				ProjectKey: "REL",
+			},
+		},
+	})
+	require.Nil(t, err)
+	uri, err = tracker.TicketURI("REL-42")
+	require.Nil(t, err)
+	require.Equal(t, "https://jira.example.com/browse/REL-42", uri)
+
+	// The config has to match the type
+	_, err = NewBugtracker(&mshipadminpb.BugTrackerConfig{
+		Type: mshipadminpb.BugTrackerConfig_JIRA,
+		Uri:  "https://gitlab.example.com",
+		Config: &mshipadminpb.BugTrackerConfig_Gitlab{
+			Gitlab: &mshipadminpb.BugTrackerConfig_GitlabConfig{
+				Token:   "test_token",
+				Project: "openela/bugs",
+			},
+		},
+	})
+	require.NotNil(t, err)
+
+	_, err = NewBugtracker(&mshipadminpb.BugTrackerConfig{})
+	require.NotNil(t, err)
+}
+
+func TestBugtrackerFromDB(t *testing.T) {
+	config := &mothership_db.BugTrackerConfig{Name: "bugTrackerConfigs/1"}
+	require.Nil(t, config.SetConfig(&mshipadminpb.BugTrackerConfig{
+		Name: "ignored",
+		Type: mshipadminpb.BugTrackerConfig_MANTIS,
+		Uri:  "https://mantis.example.com",
+		Config: &mshipadminpb.BugTrackerConfig_Mantis{
+			Mantis: &mshipadminpb.BugTrackerConfig_MantisConfig{
+				ApiKey:     "test_token",
+				ProjectIds: map[int32]int64{8: 1},
+			},
+		},
+	}))
+
+	tracker, err := bugtrackerFromDB(config)
+	require.Nil(t, err)
+	uri, err := tracker.TicketURI("42")
+	require.Nil(t, err)
+	require.Equal(t, "https://mantis.example.com/view.php?id=42", uri)
+
+	// Secrets are only masked through the API
+	require.Equal(t, mothership_db.MaskedSecret, config.ToPB().GetMantis().ApiKey)
+	require.Equal(t, "bugTrackerConfigs/1", config.ToPB().Name)
+
+	config.Config = "{}"
+	_, err = bugtrackerFromDB(config)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,433 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"text/template"
+	"time"
+
+	"github.com/openela/mothership/base"
+	"github.com/openela/mothership/base/bugtracker"
+	mothership_db "github.com/openela/mothership/db"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/pkg/errors"
+	"github.com/sassoftware/go-rpmutils"
+	"go.temporal.io/sdk/temporal"
+)
+
+// conflictHeaders are the string headers compared between conflicting SRPMs.
+var conflictHeaders = []struct {
+	tag  int
+	name string
+}{
+	{rpmutils.SUMMARY, "Summary"},
+	{rpmutils.LICENSE, "License"},
+	{rpmutils.URL, "URL"},
+	{rpmutils.VENDOR, "Vendor"},
+	{rpmutils.PACKAGER, "Packager"},
+	{rpmutils.BUILDHOST, "BuildHost"},
+}
+
+const conflictTicketBody = `[{{.Entry.EntryID}}]({{.PublicURI}}/{{.Entry.Name}}) was submitted for {{.Entry.OSRelease}} with checksum {{.Entry.Sha256Sum}}, but [{{.ConflictingEntry.EntryID}}]({{.PublicURI}}/{{.ConflictingEntry.Name}}) has checksum {{.ConflictingEntry.Sha256Sum}}.
+
+{{if .HeaderDiffs}}| Header | {{.Entry.Name}} | {{.ConflictingEntry.Name}} |
+| --- | --- | --- |
+{{range .HeaderDiffs}}| {{.Header}} | {{.Value}} | {{.ConflictingValue}} |
+{{end}}{{else}}The headers of both SRPMs are the same.
+{{end}}
+An admin must resolve the conflict by choosing one of the entries.
+`
+
+type conflictTicketData struct {
+	Entry            *mothership_db.Entry
+	ConflictingEntry *mothership_db.Entry
+	HeaderDiffs      []*mothershippb.HeaderDiff
+	PublicURI        string
+}
+
+// isConflictingState returns true if an entry in state conflicts with new
+// entries of the same entry ID and OS release, but a different checksum.
+func isConflictingState(state mothershippb.Entry_State) bool {
+	switch state {
+	case mothershippb.Entry_ARCHIVING,
+		mothershippb.Entry_ARCHIVED,
+		mothershippb.Entry_ON_HOLD,
+		mothershippb.Entry_AWAITING_QUORUM:
+		return true
+	default:
+		return false
+	}
+}
+
+// rpmHeaderValues returns the values of the compared headers of an RPM.
+// Files are compared by digest, as "File <name>".
+func rpmHeaderValues(rpm *rpmutils.Rpm) (map[string]string, error) {
+	values := map[string]string{}
+	for _, h := range conflictHeaders {
+		if !rpm.Header.HasTag(h.tag) {
+			continue
+		}
+		value, err := rpm.Header.GetString(h.tag)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get %s header", h.name)
+		}
+		values[h.name] = value
+	}
+
+	if rpm.Header.HasTag(rpmutils.BUILDTIME) {
+		buildTime, err := rpm.Header.GetInt(rpmutils.BUILDTIME)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get BuildTime header")
+		}
+		values["BuildTime"] = time.Unix(int64(buildTime), 0).UTC().Format(time.RFC3339)
+	}
+
+	files, err := rpm.Header.GetFiles()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get files")
+	}
+	for _, file := range files {
+		values["File "+file.Name()] = file.Digest()
+	}
+
+	return values, nil
+}
+
+// diffHeaderValues returns the headers with different values, sorted by
+// header. Headers missing in one of the RPMs have an empty value.
+func diffHeaderValues(values map[string]string, conflictingValues map[string]string) []*mothershippb.HeaderDiff {
+	headers := map[string]bool{}
+	for header := range values {
+		headers[header] = true
+	}
+	for header := range conflictingValues {
+		headers[header] = true
+	}
+
+	var diffs []*mothershippb.HeaderDiff
+	for header := range headers {
+		if values[header] == conflictingValues[header] {
+			continue
+		}
+		diffs = append(diffs, &mothershippb.HeaderDiff{
+			Header:           header,
+			Value:            values[header],
+			ConflictingValue: conflictingValues[header],
+		})
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Header < diffs[j].Header
+	})
+
+	return diffs
+}
+
+// readRPM reads the headers of the RPM stored in object.
+func (w *Worker) readRPM(object string) (*rpmutils.Rpm, error) {
+	r, _, err := w.storage.Open(object)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open resource")
+	}
+	defer r.Close()
+
+	rpm, err := rpmutils.ReadRpm(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read RPM headers")
+	}
+
+	return rpm, nil
+}
+
+// lockEntryID serializes finding conflicts of, and updating, the entries of
+// an entry ID and OS release across all workers, so that two entries can't
+// miss their conflict with each other.
+// The lock is held until the returned function is called.
+func (w *Worker) lockEntryID(entryID string, osRelease string) (func(), error) {
+	tx, err := w.db.DB().Beginx()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin transaction")
+	}
+
+	_, err = tx.Exec("SELECT pg_advisory_xact_lock(hashtextextended($1, 0))", entryID+"\n"+osRelease)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, errors.Wrap(err, "failed to lock entry ID")
+	}
+
+	return func() {
+		_ = tx.Rollback()
+	}, nil
+}
+
+// findConflictingEntry returns the entry that ent conflicts with, or nil.
+// The entry ID of ent must be locked with lockEntryID.
+func (w *Worker) findConflictingEntry(ent *mothership_db.Entry) (*mothership_db.Entry, error) {
+	entries, err := base.Q[mothership_db.Entry](w.db).F(
+		"entry_id", ent.EntryID,
+		"os_release", ent.OSRelease,
+	).All()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get entries")
+	}
+
+	for _, other := range entries {
+		if other.Name == ent.Name || other.Sha256Sum == ent.Sha256Sum || !isConflictingState(other.State) {
+			continue
+		}
+		return other, nil
+	}
+
+	return nil, nil
+}
+
+// recordConflict records the conflict of ent with other, and the differences
+// between their headers.
+// The SRPM of other is stored under its checksum.
+func (w *Worker) recordConflict(ent *mothership_db.Entry, rpm *rpmutils.Rpm, other *mothership_db.Entry) error {
+	name := mothership_db.EntryConflictName(ent.Name)
+	existing, err := base.Q[mothership_db.EntryConflict](w.db).F("name", name).GetOrNil()
+	if err != nil {
+		return errors.Wrap(err, "failed to get entry conflict")
+	}
+	if existing != nil {
+		return nil
+	}
+
+	otherRpm, err := w.readRPM(other.Sha256Sum)
+	if err != nil {
+		return err
+	}
+	values, err := rpmHeaderValues(rpm)
+	if err != nil {
+		return err
+	}
+	otherValues, err := rpmHeaderValues(otherRpm)
+	if err != nil {
+		return err
+	}
+
+	headerDiffs, err := json.Marshal(diffHeaderValues(values, otherValues))
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal header diffs")
+	}
+
+	err = base.Q[mothership_db.EntryConflict](w.db).Create(&mothership_db.EntryConflict{
+		Name:                 name,
+		EntryName:            ent.Name,
+		ConflictingEntryName: other.Name,
+		HeaderDiffs:          string(headerDiffs),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create entry conflict")
+	}
+
+	return nil
+}
+
+// getEntryConflict returns the conflict of an entry, and both entries.
+func (w *Worker) getEntryConflict(entry string) (*mothership_db.EntryConflict, *mothership_db.Entry, *mothership_db.Entry, error) {
+	conflict, err := base.Q[mothership_db.EntryConflict](w.db).F("name", mothership_db.EntryConflictName(entry)).GetOrNil()
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to get entry conflict")
+	}
+	if conflict == nil {
+		return nil, nil, nil, temporal.NewNonRetryableApplicationError(
+			"entry conflict does not exist",
+			"entryConflictDoesNotExist",
+			errors.New("entry conflict does not exist"),
+		)
+	}
+
+	ent, err := base.Q[mothership_db.Entry](w.db).F("name", conflict.EntryName).Get()
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to get entry")
+	}
+	other, err := base.Q[mothership_db.Entry](w.db).F("name", conflict.ConflictingEntryName).Get()
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to get conflicting entry")
+	}
+
+	return conflict, ent, other, nil
+}
+
+// CreateConflictTicket reports the conflict of an entry in the bugtracker.
+// This is a Temporal activity.
+func (w *Worker) CreateConflictTicket(entry string) error {
+	tracker, configName, err := w.activeBugtracker()
+	if err != nil {
+		return err
+	}
+	if tracker == nil {
+		return nil
+	}
+
+	conflict, ent, other, err := w.getEntryConflict(entry)
+	if err != nil {
+		return err
+	}
+	if conflict.BugtrackerURI.Valid {
+		return nil
+	}
+
+	bodyTemplate, err := template.New("conflictTicketBody").Parse(conflictTicketBody)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse template")
+	}
+
+	var buf bytes.Buffer
+	err = bodyTemplate.Execute(&buf, conflictTicketData{
+		Entry:            ent,
+		ConflictingEntry: other,
+		HeaderDiffs:      conflict.ToPB().HeaderDiffs,
+		PublicURI:        w.publicURI,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to execute template")
+	}
+
+	auth, err := tracker.GetAuthenticator()
+	if err != nil {
+		return errors.Wrap(err, "failed to get authenticator")
+	}
+
+	title := fmt.Sprintf("conflict: %s", ent.EntryID)
+	ticket, err := tracker.CreateTicket(auth, title, buf.String(), bugtracker.Options{
+		Labels:       []string{"conflict"},
+		MajorVersion: majorVersion(ent.OSRelease),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create ticket")
+	}
+
+	ticketURI, err := tracker.TicketURI(ticket)
+	if err != nil {
+		return errors.Wrap(err, "failed to get ticket URI")
+	}
+
+	conflict.BugtrackerURI = sql.NullString{
+		Valid:  true,
+		String: ticketURI,
+	}
+	conflict.BugtrackerConfigName = configName
+	err = base.Q[mothership_db.EntryConflict](w.db).U(conflict)
+	if err != nil {
+		return errors.Wrap(err, "failed to update entry conflict")
+	}
+
+	return nil
+}
+
+// GetConflictingEntry returns the entry that an entry conflicts with.
+// This is a Temporal activity.
+func (w *Worker) GetConflictingEntry(entry string) (*mothershippb.Entry, error) {
+	_, _, other, err := w.getEntryConflict(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return other.ToPB(), nil
+}
+
+// SetEntryConflictResolution resolves the conflict of an entry by choosing
+// either the entry or the entry it conflicts with.
+// The entry is archived if chosen, or cancelled otherwise. The entry can only
+// be chosen once no other entry conflicts with it, the entry it conflicted
+// with has to be retracted first.
+// This is a Temporal activity.
+func (w *Worker) SetEntryConflictResolution(entry string, chosen string) (*mothershippb.Entry, error) {
+	conflict, ent, _, err := w.getEntryConflict(entry)
+	if err != nil {
+		return nil, err
+	}
+	if chosen != conflict.EntryName && chosen != conflict.ConflictingEntryName {
+		return nil, temporal.NewNonRetryableApplicationError(
+			"chosen entry is not in conflict",
+			"chosenEntryNotInConflict",
+			errors.New("chosen entry is not in conflict"),
+		)
+	}
+
+	unlock, err := w.lockEntryID(ent.EntryID, ent.OSRelease)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if chosen == ent.Name {
+		conflicting, err := w.findConflictingEntry(ent)
+		if err != nil {
+			return nil, err
+		}
+		if conflicting != nil {
+			return nil, temporal.NewNonRetryableApplicationError(
+				"conflicting entry must be retracted first",
+				"conflictingEntryNotRetracted",
+				errors.Errorf("entry %s still conflicts", conflicting.Name),
+			)
+		}
+	}
+
+	if !conflict.ResolveTime.Valid {
+		conflict.ResolveTime = sql.NullTime{
+			Valid: true,
+			Time:  time.Now(),
+		}
+		conflict.ChosenEntryName = sql.NullString{
+			Valid:  true,
+			String: chosen,
+		}
+		err = base.Q[mothership_db.EntryConflict](w.db).U(conflict)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to update entry conflict")
+		}
+	}
+
+	state := mothershippb.Entry_CANCELLED
+	if conflict.ChosenEntryName.String == ent.Name {
+		state = mothershippb.Entry_ARCHIVING
+	}
+	res, err := w.SetEntryState(ent.Name, state, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Closing the ticket is best effort, it can be closed manually.
+	if conflict.BugtrackerURI.Valid {
+		err = w.closeTicket(conflict.BugtrackerConfigName, conflict.BugtrackerURI.String)
+		if err != nil {
+			slog.Info("failed to close conflict ticket", "err", err)
+		}
+	}
+
+	return res, nil
+}
+
+// closeTicket closes the ticket at uri, in the bug tracker of configName.
+func (w *Worker) closeTicket(configName sql.NullString, uri string) error {
+	tracker, err := w.ticketBugtracker(configName)
+	if err != nil {
+		return err
+	}
+	if tracker == nil {
+		return errors.New("bug tracker config of ticket was deleted")
+	}
+
+	auth, err := tracker.GetAuthenticator()
+	if err != nil {
+		return errors.Wrap(err, "failed to get authenticator")
+	}
+
+	ticket, err := tracker.URIToTicket(uri)
+	if err != nil {
+		return errors.Wrap(err, "failed to get ticket ID")
+	}
+
+	return tracker.CloseTicket(auth, ticket)
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"os"
+	"testing"
+
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/sassoftware/go-rpmutils"
+	"github.com/stretchr/testify/require"
+)
+
+func testRPMHeaderValues(t *testing.T, path string) map[string]string {
+	f, err := os.Open(path)
+	require.Nil(t, err)
+	defer f.Close()
+
+	rpm, err := rpmutils.ReadRpm(f)
+	require.Nil(t, err)
+
+	values, err := rpmHeaderValues(rpm)
+	require.Nil(t, err)
+
+	return values
+}
+
+func TestRPMHeaderValues(t *testing.T) {
+	values := testRPMHeaderValues(t, "testdata/efi-rpm-macros-3-3.el8.src.rpm")
+	require.Equal(t, "GPLv3+", values["License"])
+	require.Equal(t, "Rocky", values["Vendor"])
+	require.Equal(t, "2021-05-19T02:30:23Z", values["BuildTime"])
+	require.Equal(t, "f002f60baed7a47ca3e98b8dd7ece2f7352dac9ffab7ae3557eb56b481ce2f86", values["File efi-rpm-macros-3.tar.bz2"])
+}
+
+func TestDiffHeaderValues(t *testing.T) {
+	values := testRPMHeaderValues(t, "testdata/efi-rpm-macros-3-3.el8.src.rpm")
+	require.Empty(t, diffHeaderValues(values, values))
+
+	conflictingValues := testRPMHeaderValues(t, "testdata/basesystem-11-5.el8.src.rpm")
+	diffs := diffHeaderValues(values, conflictingValues)
+
+	headers := make([]string, 0, len(diffs))
+	for _, diff := range diffs {
+		headers = append(headers, diff.Header)
+	}
+	require.Equal(t, []string{
+		"BuildHost",
+		"BuildTime",
+		"File 0001-macros.efi-srpm-make-all-of-our-macros-always-expand.patch",
+		"File basesystem.spec",
+		"File efi-rpm-macros-3.tar.bz2",
+		"File efi-rpm-macros.spec",
+		"License",
+		"Summary",
+		"URL",
+	}, headers)
+
+	// Headers missing in one of the RPMs are empty
+	require.Equal(t, &mothershippb.HeaderDiff{
+		Header:           "File basesystem.spec",
+		Value:            "",
+		ConflictingValue: "85cbf7956f46320e679f3d934b988a751bee6941fee930399d46b51492828ecb",
+	}, diffs[3])
+}
+
+func TestIsConflictingState(t *testing.T) {
+	require.True(t, isConflictingState(mothershippb.Entry_ARCHIVED))
+	require.True(t, isConflictingState(mothershippb.Entry_ON_HOLD))
+	require.False(t, isConflictingState(mothershippb.Entry_RETRACTED))
+	require.False(t, isConflictingState(mothershippb.Entry_CONFLICT))
+}
@@ -4,19 +4,15 @@
 package mothership_worker_server
 
 import (
-	"crypto/sha256"
 	"database/sql"
-	"encoding/hex"
 	"fmt"
 	"github.com/openela/mothership/base"
+	"github.com/openela/mothership/base/storage"
 	mothership_db "github.com/openela/mothership/db"
 	mothershippb "github.com/openela/mothership/proto/v1"
 	"github.com/pkg/errors"
 	"github.com/sassoftware/go-rpmutils"
 	"go.temporal.io/sdk/temporal"
-	"io"
-	"os"
-	"path/filepath"
 	"time"
 )
 
@@ -49,56 +45,57 @@ func (w *Worker) CreateEntry(args *mothershippb.ProcessRPMArgs) (*mothershippb.E
 	return entry.ToPB(), nil
 }
 
-// SetEntryIDFromRPM sets the entry ID from the RPM.
-// This is a Temporal activity.
-func (w *Worker) SetEntryIDFromRPM(entry string, uri string, checksumSha256 string) (*mothershippb.Entry, error) {
+// formatNEVRA returns the name-epoch:version-release.src NEVRA of an SRPM.
+// The arch header of an SRPM is the arch it was built on, so it's not used.
+func formatNEVRA(nevra *rpmutils.NEVRA) string {
+	return fmt.Sprintf("%s-%s:%s-%s.src", nevra.Name, nevra.Epoch, nevra.Version, nevra.Release)
+}
+
+// setEntryErrorMessage records the error of a failed import of an entry.
+func (w *Worker) setEntryErrorMessage(entry string, importErr error) error {
 	ent, err := base.Q[mothership_db.Entry](w.db).F("name", entry).GetOrNil()
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get entry")
+		return errors.Wrap(err, "failed to get entry")
 	}
 	if ent == nil {
-		return nil, errors.New("entry does not exist")
+		return errors.New("entry does not exist")
 	}
 
-	tempDir, err := os.MkdirTemp("", "mothership-worker-server-import-rpm-*")
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to create temporary directory")
+	ent.ErrorMessage = importErr.Error()
+	if err := base.Q[mothership_db.Entry](w.db).U(ent); err != nil {
+		return errors.Wrap(err, "failed to update entry")
 	}
-	defer os.RemoveAll(tempDir)
 
-	object, err := getObjectPath(uri)
-	if err != nil {
-		return nil, err
-	}
+	return nil
+}
 
-	err = w.storage.Download(object, filepath.Join(tempDir, "resource.rpm"))
+// SetEntryIDFromRPM sets the entry ID from the RPM.
+// This is a Temporal activity.
+func (w *Worker) SetEntryIDFromRPM(entry string, uri string, checksumSha256 string) (*mothershippb.Entry, error) {
+	ent, err := base.Q[mothership_db.Entry](w.db).F("name", entry).GetOrNil()
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to download resource")
+		return nil, errors.Wrap(err, "failed to get entry")
+	}
+	if ent == nil {
+		return nil, errors.New("entry does not exist")
 	}
 
-	// Verify checksum
-	hash := sha256.New()
-	f, err := os.Open(filepath.Join(tempDir, "resource.rpm"))
+	object, err := storage.ObjectFromURI(w.storage, uri)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to open resource")
-	}
-	defer f.Close()
-	if _, err := io.Copy(hash, f); err != nil {
-		return nil, errors.Wrap(err, "failed to hash resource")
-	}
-	if hex.EncodeToString(hash.Sum(nil)) != checksumSha256 {
 		return nil, temporal.NewNonRetryableApplicationError(
-			"checksum does not match",
-			"checksumDoesNotMatch",
-			errors.New("client submitted a checksum that does not match the resource"),
+			"could not parse resource URI",
+			"couldNotParseResourceURI",
+			errors.Wrap(err, "failed to parse resource URI"),
 		)
 	}
 
-	// Read the RPM headers
-	_, err = f.Seek(0, io.SeekStart)
+	// Verify checksum before the headers are parsed
+	f, err := w.openVerified(object, checksumSha256)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to seek resource")
+		return nil, err
 	}
+	defer f.Close()
+
 	rpm, err := rpmutils.ReadRpm(f)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to read RPM headers")
@@ -111,8 +108,31 @@ func (w *Worker) SetEntryIDFromRPM(entry string, uri string, checksumSha256 stri
 
 	// Set entry ID
 	ent.EntryID = fmt.Sprintf("%s-%s-%s.src", nevra.Name, nevra.Version, nevra.Release)
+	ent.NEVRA = formatNEVRA(nevra)
 	ent.Sha256Sum = checksumSha256
 
+	// Entries with the same entry ID and OS release must have the same
+	// checksum, otherwise an admin has to choose one of them.
+	// Other workers could set the same entry ID concurrently, so the entry
+	// ID is locked until the entry is updated.
+	unlock, err := w.lockEntryID(ent.EntryID, ent.OSRelease)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	other, err := w.findConflictingEntry(ent)
+	if err != nil {
+		return nil, err
+	}
+	if other != nil {
+		err = w.recordConflict(ent, rpm, other)
+		if err != nil {
+			return nil, err
+		}
+		ent.State = mothershippb.Entry_CONFLICT
+	}
+
 	// Update entry
 	if err := base.Q[mothership_db.Entry](w.db).U(ent); err != nil {
 		return nil, errors.Wrap(err, "failed to update entry")
@@ -134,7 +154,13 @@ func (w *Worker) SetEntryState(entry string, state mothershippb.Entry_State, imp
 		)
 	}
 
+	if ent.State != state {
+		ent.StateChangeTime = time.Now()
+	}
 	ent.State = state
+	if state == mothershippb.Entry_ARCHIVED {
+		ent.ErrorMessage = ""
+	}
 	if importRpmRes != nil {
 		ent.CommitURI = importRpmRes.CommitUri
 		ent.CommitHash = importRpmRes.CommitHash
@@ -147,9 +173,76 @@ func (w *Worker) SetEntryState(entry string, state mothershippb.Entry_State, imp
 		return nil, errors.Wrap(err, "failed to update entry")
 	}
 
+	if importRpmRes != nil {
+		if err := w.setEntryMirrors(ent.Name, importRpmRes.Mirrors); err != nil {
+			return nil, err
+		}
+		if err := w.setEntryLookasideBlobs(ent.Name, importRpmRes.LookasideClassifications); err != nil {
+			return nil, err
+		}
+	}
+
+	if isLoggedState(state) {
+		if err := w.appendLog(ent); err != nil {
+			return nil, err
+		}
+	}
+
 	return ent.ToPB(), nil
 }
 
+// setEntryLookasideBlobs records the lookaside blobs the import of an entry
+// committed metadata for, replacing those of an earlier import.
+// Only recorded blobs are served from the lookaside endpoint.
+func (w *Worker) setEntryLookasideBlobs(entry string, classifications []*mothershippb.LookasideClassification) error {
+	existing, err := base.Q[mothership_db.EntryLookasideBlob](w.db).F("entry_name", entry).All()
+	if err != nil {
+		return errors.Wrap(err, "failed to get entry lookaside blobs")
+	}
+	stale := map[string]*mothership_db.EntryLookasideBlob{}
+	for _, blob := range existing {
+		stale[blob.Name] = blob
+	}
+
+	recorded := map[string]bool{}
+	for _, c := range classifications {
+		if !c.Lookaside {
+			continue
+		}
+
+		// Files with the same content share a blob
+		name := mothership_db.EntryLookasideBlobName(entry, c.Sha256)
+		if recorded[name] {
+			continue
+		}
+		recorded[name] = true
+		if _, ok := stale[name]; ok {
+			delete(stale, name)
+			continue
+		}
+
+		err = base.Q[mothership_db.EntryLookasideBlob](w.db).Create(&mothership_db.EntryLookasideBlob{
+			Name:      name,
+			EntryName: entry,
+			File:      c.File,
+			Sha256:    c.Sha256,
+			Sha512:    c.Sha512,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to save entry lookaside blob")
+		}
+	}
+
+	for _, blob := range stale {
+		err = base.Q[mothership_db.EntryLookasideBlob](w.db).D(blob)
+		if err != nil {
+			return errors.Wrap(err, "failed to delete entry lookaside blob")
+		}
+	}
+
+	return nil
+}
+
 func (w *Worker) SetWorkerLastCheckinTime(workerID string) error {
 	wrk, err := base.Q[mothership_db.Worker](w.db).F("worker_id", workerID).GetOrNil()
 	if err != nil {
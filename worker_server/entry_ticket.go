@@ -0,0 +1,218 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"text/template"
+
+	"github.com/openela/mothership/base"
+	"github.com/openela/mothership/base/bugtracker"
+	mothership_db "github.com/openela/mothership/db"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/openela/mothership/worker_server/srpm_import"
+	"github.com/pkg/errors"
+	"go.temporal.io/sdk/temporal"
+)
+
+const entryTicketBody = `[{{.Entry.EntryID}}]({{.PublicURI}}/{{.Entry.Name}}) failed to import for {{.Entry.OSRelease}}.
+
+- NEVRA: {{.Entry.NEVRA}}
+- State: {{.Entry.State}}
+{{if .PatchesURI}}- PATCHES: {{.PatchesURI}}
+{{end}}{{if .Entry.BatchName.Valid}}- Batch: {{.Entry.BatchName.String}}
+{{end}}
+{{if .Entry.ErrorMessage}}` + "```" + `
+{{.Entry.ErrorMessage}}
+` + "```" + `
+{{else}}No error was recorded for the import.
+{{end}}
+{{if .OnHold}}An admin can rescue the entry once PATCHES are fixed, this ticket is closed once the import succeeds.{{else}}The entry can't be rescued, the SRPM must be submitted again.{{end}}
+`
+
+type entryTicketData struct {
+	Entry      *mothership_db.Entry
+	OnHold     bool
+	PatchesURI string
+	PublicURI  string
+}
+
+func renderEntryTicketBody(data *entryTicketData) (string, error) {
+	bodyTemplate, err := template.New("entryTicketBody").Parse(entryTicketBody)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse template")
+	}
+
+	var buf bytes.Buffer
+	err = bodyTemplate.Execute(&buf, data)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to execute template")
+	}
+
+	return buf.String(), nil
+}
+
+// entryPackage returns the package name of an entry, and the web URL of the
+// PATCHES directory it is imported with.
+// Both are read from the SRPM, the URL is empty if the branch can't be
+// determined.
+func (w *Worker) entryPackage(ent *mothership_db.Entry) (string, string, error) {
+	rpm, err := w.readRPM(ent.Sha256Sum)
+	if err != nil {
+		return "", "", err
+	}
+	nevra, err := rpm.Header.GetNEVRA()
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to get RPM NEVRA")
+	}
+
+	branch, err := srpm_import.Branch(rpm, ent.OSRelease, w.rolling)
+	if err != nil {
+		slog.Info("failed to determine branch of entry", "entry", ent.Name, "err", err)
+		return nevra.Name, "", nil
+	}
+
+	return nevra.Name, w.forge.GetTreeViewerURL(nevra.Name, branch, "PATCHES"), nil
+}
+
+// CreateEntryTicket reports an entry that failed to import in the
+// bugtracker, if tickets are created for failed entries.
+// The ticket is labeled with the package name, so maintainers can follow
+// their packages. If the entry already has a ticket, e.g. because the import
+// failed again after a rescue, the ticket is updated instead.
+// This is a Temporal activity.
+func (w *Worker) CreateEntryTicket(entry string) error {
+	ent, err := base.Q[mothership_db.Entry](w.db).F("name", entry).GetOrNil()
+	if err != nil {
+		return errors.Wrap(err, "failed to get entry")
+	}
+	if ent == nil {
+		return temporal.NewNonRetryableApplicationError(
+			"entry does not exist",
+			"entryDoesNotExist",
+			errors.New("entry does not exist"),
+		)
+	}
+
+	var tracker bugtracker.Bugtracker
+	var configName sql.NullString
+	if ent.BugtrackerURI.Valid {
+		configName = ent.BugtrackerConfigName
+		tracker, err = w.ticketBugtracker(configName)
+		if err != nil {
+			return err
+		}
+	} else {
+		tracker, configName, err = w.activeBugtracker()
+		if err != nil {
+			return err
+		}
+		if tracker != nil {
+			enabled, err := w.entryTicketsEnabled(configName)
+			if err != nil {
+				return err
+			}
+			if !enabled {
+				return nil
+			}
+		}
+	}
+	if tracker == nil {
+		return nil
+	}
+
+	pkg, patchesURI, err := w.entryPackage(ent)
+	if err != nil {
+		return err
+	}
+
+	body, err := renderEntryTicketBody(&entryTicketData{
+		Entry:      ent,
+		OnHold:     ent.State == mothershippb.Entry_ON_HOLD,
+		PatchesURI: patchesURI,
+		PublicURI:  w.publicURI,
+	})
+	if err != nil {
+		return err
+	}
+
+	auth, err := tracker.GetAuthenticator()
+	if err != nil {
+		return errors.Wrap(err, "failed to get authenticator")
+	}
+
+	title := fmt.Sprintf("failed: %s", ent.EntryID)
+	opts := bugtracker.Options{
+		Labels:       []string{"failed-entry", "import-entry", pkg},
+		MajorVersion: majorVersion(ent.OSRelease),
+	}
+
+	if ent.BugtrackerURI.Valid {
+		ticket, err := tracker.URIToTicket(ent.BugtrackerURI.String)
+		if err != nil {
+			return errors.Wrap(err, "failed to get ticket ID")
+		}
+
+		err = tracker.EditTicket(auth, ticket, title, body, opts)
+		if err != nil {
+			return errors.Wrap(err, "failed to edit ticket")
+		}
+
+		return nil
+	}
+
+	ticket, err := tracker.CreateTicket(auth, title, body, opts)
+	if err != nil {
+		return errors.Wrap(err, "failed to create ticket")
+	}
+
+	ticketURI, err := tracker.TicketURI(ticket)
+	if err != nil {
+		return errors.Wrap(err, "failed to get ticket URI")
+	}
+
+	ent.BugtrackerURI = sql.NullString{
+		Valid:  true,
+		String: ticketURI,
+	}
+	ent.BugtrackerConfigName = configName
+	err = base.Q[mothership_db.Entry](w.db).U(ent)
+	if err != nil {
+		return errors.Wrap(err, "failed to update entry")
+	}
+
+	// Link the ticket from the batch ticket, if the batch already has one.
+	// This is best effort, the batch ticket links it on its next update.
+	if ent.BatchName.Valid {
+		batch, err := base.Q[mothership_db.Batch](w.db).F("name", ent.BatchName.String).GetOrNil()
+		if err != nil {
+			slog.Info("failed to get batch", "err", err)
+		} else if batch != nil && batch.BugtrackerURI.Valid {
+			err = w.updateBatchTicket(batch)
+			if err != nil {
+				slog.Info("failed to update batch ticket", "err", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// CloseEntryTicket closes the ticket of an entry that was imported after a
+// rescue.
+// This is a Temporal activity.
+func (w *Worker) CloseEntryTicket(entry string) error {
+	ent, err := base.Q[mothership_db.Entry](w.db).F("name", entry).GetOrNil()
+	if err != nil {
+		return errors.Wrap(err, "failed to get entry")
+	}
+	if ent == nil || !ent.BugtrackerURI.Valid {
+		return nil
+	}
+
+	return w.closeTicket(ent.BugtrackerConfigName, ent.BugtrackerURI.String)
+}
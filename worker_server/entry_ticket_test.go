@@ -0,0 +1,62 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"database/sql"
+	"testing"
+
+	mothership_db "github.com/openela/mothership/db"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderEntryTicketBody_OnHold(t *testing.T) {
+	body, err := renderEntryTicketBody(&entryTicketData{
+		Entry: &mothership_db.Entry{
+			Name:         "entries/123",
+			EntryID:      "efi-rpm-macros-3-3.el8.src",
+			NEVRA:        "efi-rpm-macros-0:3-3.el8.src",
+			OSRelease:    "Rocky Linux release 8.8 (Green Obsidian)",
+			BatchName:    sql.NullString{Valid: true, String: "batches/456"},
+			State:        mothershippb.Entry_ON_HOLD,
+			ErrorMessage: "failed to import SRPM: patch failed",
+		},
+		OnHold:     true,
+		PatchesURI: "https://git.example.com/efi-rpm-macros/tree/el-8.8/PATCHES",
+		PublicURI:  "https://mship.example.com",
+	})
+	require.Nil(t, err)
+	require.Equal(t, "[efi-rpm-macros-3-3.el8.src](https://mship.example.com/entries/123) failed to import for Rocky Linux release 8.8 (Green Obsidian).\n"+
+		"\n"+
+		"- NEVRA: efi-rpm-macros-0:3-3.el8.src\n"+
+		"- State: ON_HOLD\n"+
+		"- PATCHES: https://git.example.com/efi-rpm-macros/tree/el-8.8/PATCHES\n"+
+		"- Batch: batches/456\n"+
+		"\n"+
+		"```\n"+
+		"failed to import SRPM: patch failed\n"+
+		"```\n"+
+		"\n"+
+		"An admin can rescue the entry once PATCHES are fixed, this ticket is closed once the import succeeds.\n", body)
+}
+
+func TestRenderEntryTicketBody_Failed(t *testing.T) {
+	body, err := renderEntryTicketBody(&entryTicketData{
+		Entry: &mothership_db.Entry{
+			Name:      "entries/123",
+			EntryID:   "efi-rpm-macros-3-3.el8.src",
+			NEVRA:     "efi-rpm-macros-0:3-3.el8.src",
+			OSRelease: "Rocky Linux release 8.8 (Green Obsidian)",
+			State:     mothershippb.Entry_FAILED,
+		},
+		PublicURI: "https://mship.example.com",
+	})
+	require.Nil(t, err)
+	require.Contains(t, body, "- State: FAILED\n")
+	require.NotContains(t, body, "PATCHES:")
+	require.NotContains(t, body, "Batch:")
+	require.Contains(t, body, "No error was recorded for the import.\n")
+	require.Contains(t, body, "The entry can't be rescued, the SRPM must be submitted again.\n")
+}
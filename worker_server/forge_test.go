@@ -56,6 +56,10 @@ func (f *inMemoryForge) GetCommitViewerURL(repo string, commit string) string {
 	return f.remoteBaseURL + "/" + f.namespace + repo + "/commit/" + commit
 }
 
+func (f *inMemoryForge) GetTreeViewerURL(repo string, ref string, path string) string {
+	return f.remoteBaseURL + "/" + f.namespace + repo + "/tree/" + ref + "/" + path
+}
+
 func (f *inMemoryForge) EnsureRepositoryExists(auth *forge.Authenticator, repo string) error {
 	// Try casting auth.AuthMethod to *transport_http.BasicAuth
 	// If it fails, return an error
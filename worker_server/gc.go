@@ -0,0 +1,391 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/openela/mothership/base"
+	"github.com/openela/mothership/base/storage"
+	mothership_db "github.com/openela/mothership/db"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/pkg/errors"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+)
+
+// garbageCollectScheduleID is the ID of the Temporal schedule that starts
+// GarbageCollectWorkflow.
+const garbageCollectScheduleID = "garbage-collect"
+
+// trashPrefix is where unreachable objects are moved to before deletion.
+const trashPrefix = "trash/"
+
+// metadataFileRegex matches the lookaside metadata files of a package,
+// either the srpmproc metadata file or the dist-git sources file.
+var metadataFileRegex = regexp.MustCompile(`^(\..+\.metadata|sources)$`)
+
+// isObjectHash returns true if the object name is a hash.
+// Both SRPMs and lookaside blobs are named by their hex encoded SHA-256 or
+// SHA-512 hash, so only names of exactly 64 or 128 hex characters are
+// considered by the garbage collector. Anything else in the storage backend,
+// for example objects in a subdirectory or with an extension, is never
+// trashed or deleted, even if nothing references it.
+func isObjectHash(name string) bool {
+	if len(name) != 64 && len(name) != 128 {
+		return false
+	}
+	_, err := hex.DecodeString(name)
+	return err == nil
+}
+
+// isEntryStateGarbage returns true if the SRPM of an entry in the given state
+// is no longer needed.
+func isEntryStateGarbage(state mothershippb.Entry_State) bool {
+	switch state {
+	case mothershippb.Entry_CANCELLED,
+		mothershippb.Entry_FAILED,
+		mothershippb.Entry_RETRACTED:
+		return true
+	default:
+		return false
+	}
+}
+
+// metadataBlob is a lookaside blob referenced by a metadata file.
+type metadataBlob struct {
+	// File is the name of the file, without the SOURCES directory
+	File string
+	// Hash is the hash the metadata file refers to the blob by, SHA-256 in
+	// the srpmproc metadata file and SHA-512 in the dist-git sources file
+	Hash string
+}
+
+// parseMetadataBlobs returns the blobs in a metadata file.
+// Lines are of the format "<hash> <path>", or "<HASHTYPE> (<file>) = <hash>"
+// in a dist-git sources file.
+func parseMetadataBlobs(r io.Reader) ([]metadataBlob, error) {
+	var blobs []metadataBlob
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		if len(fields) == 4 && fields[2] == "=" {
+			blobs = append(blobs, metadataBlob{
+				File: strings.TrimSuffix(strings.TrimPrefix(fields[1], "("), ")"),
+				Hash: fields[3],
+			})
+			continue
+		}
+		blobs = append(blobs, metadataBlob{
+			File: path.Base(fields[1]),
+			Hash: fields[0],
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return blobs, nil
+}
+
+// metadataBlobsForCommit returns the blobs in all metadata files in the root
+// of the commit tree.
+func metadataBlobsForCommit(commit *object.Commit) ([]metadataBlob, error) {
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get tree")
+	}
+
+	var blobs []metadataBlob
+	for _, entry := range tree.Entries {
+		if !entry.Mode.IsFile() || !metadataFileRegex.MatchString(entry.Name) {
+			continue
+		}
+
+		f, err := tree.TreeEntryFile(&entry)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get metadata file")
+		}
+		r, err := f.Reader()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read metadata file")
+		}
+		fileBlobs, err := parseMetadataBlobs(r)
+		_ = r.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse metadata file")
+		}
+
+		blobs = append(blobs, fileBlobs...)
+	}
+
+	return blobs, nil
+}
+
+// metadataHashesForCommit returns the blob hashes in all metadata files
+// in the root of the commit tree.
+func metadataHashesForCommit(commit *object.Commit) ([]string, error) {
+	blobs, err := metadataBlobsForCommit(commit)
+	if err != nil {
+		return nil, err
+	}
+
+	var hashes []string
+	for _, blob := range blobs {
+		hashes = append(hashes, blob.Hash)
+	}
+
+	return hashes, nil
+}
+
+// metadataHashesForRepo returns the blob hashes referenced by every branch
+// and tag in the repository. Every import is tagged, so this covers all
+// imports that haven't been retracted.
+func metadataHashesForRepo(repo *git.Repository) ([]string, error) {
+	refs, err := repo.References()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get references")
+	}
+
+	var hashes []string
+	seen := map[plumbing.Hash]bool{}
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() != plumbing.HashReference {
+			return nil
+		}
+		if !ref.Name().IsBranch() && !ref.Name().IsTag() {
+			return nil
+		}
+
+		commitHash := ref.Hash()
+		if ref.Name().IsTag() {
+			// Annotated tags point to a tag object
+			tag, err := repo.TagObject(commitHash)
+			if err == nil {
+				commitHash = tag.Target
+			}
+		}
+		if seen[commitHash] {
+			return nil
+		}
+		seen[commitHash] = true
+
+		commit, err := repo.CommitObject(commitHash)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get commit for %s", ref.Name())
+		}
+
+		commitHashes, err := metadataHashesForCommit(commit)
+		if err != nil {
+			return err
+		}
+		hashes = append(hashes, commitHashes...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hashes, nil
+}
+
+// addBlobAliases marks both names of a lookaside blob as reachable if
+// either of them is.
+// Blobs are stored under their SHA-256 and SHA-512 hash, but metadata files
+// only refer to one of them, depending on the format.
+func addBlobAliases(reachable map[string]bool, blobs []*mothership_db.EntryLookasideBlob) {
+	for _, blob := range blobs {
+		if reachable[blob.Sha256] || reachable[blob.Sha512] {
+			reachable[blob.Sha256] = true
+			reachable[blob.Sha512] = true
+		}
+	}
+}
+
+// reachableObjects returns the set of objects that are still referenced,
+// either as the SRPM of an entry or as a lookaside blob in a metadata file.
+func (w *Worker) reachableObjects(ctx context.Context) (map[string]bool, error) {
+	entries, err := base.Q[mothership_db.Entry](w.db).All()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get entries")
+	}
+
+	reachable := map[string]bool{}
+	packages := map[string]bool{}
+	for _, entry := range entries {
+		if !isEntryStateGarbage(entry.State) {
+			reachable[entry.Sha256Sum] = true
+		}
+		if entry.PackageName != "" {
+			packages[entry.PackageName] = true
+		}
+	}
+
+	auth, err := w.forge.GetAuthenticator()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get forge authenticator")
+	}
+
+	for pkg := range packages {
+		activity.RecordHeartbeat(ctx, pkg)
+
+		repo, err := getRepo(w.forge.GetRemote(pkg), auth.AuthMethod)
+		if err != nil {
+			// The entry may have failed before the repository was created
+			if errors.Is(err, transport.ErrRepositoryNotFound) || errors.Is(err, transport.ErrEmptyRemoteRepository) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to get repo %s", pkg)
+		}
+
+		hashes, err := metadataHashesForRepo(repo)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get metadata for %s", pkg)
+		}
+		for _, hash := range hashes {
+			reachable[hash] = true
+		}
+	}
+
+	blobs, err := base.Q[mothership_db.EntryLookasideBlob](w.db).All()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get entry lookaside blobs")
+	}
+	addBlobAliases(reachable, blobs)
+
+	return reachable, nil
+}
+
+// moveObject moves an object within the storage backend.
+func moveObject(s storage.Storage, from string, to string) error {
+	r, _, err := s.Open(from)
+	if err != nil {
+		return errors.Wrap(err, "failed to open object")
+	}
+	defer r.Close()
+
+	wr, err := s.Create(to)
+	if err != nil {
+		return errors.Wrap(err, "failed to create object")
+	}
+
+	_, err = io.Copy(wr, r)
+	if err != nil {
+		_ = wr.Abort()
+		return errors.Wrap(err, "failed to copy object")
+	}
+
+	err = wr.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to close object")
+	}
+
+	return s.Delete(from)
+}
+
+// GarbageCollect moves unreachable objects to the trash, deletes trashed
+// objects once the grace period has passed and restores trashed objects
+// that are reachable again.
+// Objects younger than the grace period are left alone, so uploads that
+// haven't been submitted yet and in-flight imports are safe.
+// This is a Temporal activity.
+func (w *Worker) GarbageCollect(ctx context.Context, args *mothershippb.GarbageCollectArgs) (*mothershippb.GarbageCollectResponse, error) {
+	gracePeriod := args.GracePeriod.AsDuration()
+	cutoff := time.Now().Add(-gracePeriod)
+
+	reachable, err := w.reachableObjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := w.storage.List("")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list objects")
+	}
+
+	res := &mothershippb.GarbageCollectResponse{
+		DryRun:           args.DryRun,
+		ReachableObjects: int64(len(reachable)),
+	}
+	for _, obj := range objects {
+		activity.RecordHeartbeat(ctx, obj.Name)
+
+		trashed := strings.HasPrefix(obj.Name, trashPrefix)
+		name := strings.TrimPrefix(obj.Name, trashPrefix)
+		if !isObjectHash(name) {
+			continue
+		}
+
+		switch {
+		case trashed && reachable[name]:
+			res.RestoredObjects++
+			if !args.DryRun {
+				err = moveObject(w.storage, obj.Name, name)
+			}
+		case trashed && obj.ModTime.Before(cutoff):
+			res.DeletedObjects++
+			res.DeletedBytes += obj.Size
+			res.ReclaimableBytes += obj.Size
+			if !args.DryRun {
+				err = w.storage.Delete(obj.Name)
+			}
+		case trashed:
+			res.ReclaimableBytes += obj.Size
+		case !reachable[name] && obj.ModTime.Before(cutoff):
+			res.TrashedObjects++
+			res.ReclaimableBytes += obj.Size
+			if !args.DryRun {
+				err = moveObject(w.storage, obj.Name, trashPrefix+name)
+			}
+		}
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return nil, errors.Wrapf(err, "failed to collect %s", obj.Name)
+		}
+		err = nil
+	}
+
+	slog.Info(
+		"garbage collection finished",
+		"dryRun", res.DryRun,
+		"trashed", res.TrashedObjects,
+		"deleted", res.DeletedObjects,
+		"restored", res.RestoredObjects,
+		"reclaimableBytes", res.ReclaimableBytes,
+	)
+
+	return res, nil
+}
+
+// EnsureGarbageCollectSchedule creates or updates the Temporal schedule that
+// periodically starts GarbageCollectWorkflow.
+// An empty cron expression removes the schedule.
+func EnsureGarbageCollectSchedule(ctx context.Context, c client.Client, taskQueue string, cron string, args *mothershippb.GarbageCollectArgs) error {
+	err := ensureSchedule(ctx, c, garbageCollectScheduleID, cron, &client.ScheduleWorkflowAction{
+		ID:        "operations/garbage-collect",
+		Workflow:  GarbageCollectWorkflow,
+		Args:      []any{args},
+		TaskQueue: taskQueue,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to ensure garbage collection schedule")
+	}
+
+	return nil
+}
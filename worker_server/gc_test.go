@@ -0,0 +1,135 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/openela/mothership/base/storage"
+	storage_memory "github.com/openela/mothership/base/storage/memory"
+	mothership_db "github.com/openela/mothership/db"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testHashA = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	testHashB = "486ea46224d1bb4fb680f34f7c9ad96a8f24ec88be73ea8e5a6c65260e9cb8a7"
+	testHashC = "9b71d224bd62f3785d96d46ad3ea3d73319bfbc2890caadae2dff72519673ca72323c3d99ba5c11d7c7acc6e14b8c5da0c4663475c2e5c3adef46f73bcdec043"
+)
+
+func TestIsObjectHash(t *testing.T) {
+	require.True(t, isObjectHash(testHashA))
+	require.True(t, isObjectHash(testHashC))
+	require.False(t, isObjectHash("foo"))
+	require.False(t, isObjectHash(strings.Repeat("z", 64)))
+}
+
+func TestIsEntryStateGarbage(t *testing.T) {
+	require.True(t, isEntryStateGarbage(mothershippb.Entry_RETRACTED))
+	require.True(t, isEntryStateGarbage(mothershippb.Entry_FAILED))
+	require.True(t, isEntryStateGarbage(mothershippb.Entry_CANCELLED))
+	require.False(t, isEntryStateGarbage(mothershippb.Entry_ARCHIVED))
+	require.False(t, isEntryStateGarbage(mothershippb.Entry_ON_HOLD))
+}
+
+func TestParseMetadataBlobs(t *testing.T) {
+	blobs, err := parseMetadataBlobs(strings.NewReader(testHashA + " SOURCES/foo.tar.gz\n\n" + testHashB + " SOURCES/bar.tar.gz\n"))
+	require.Nil(t, err)
+	require.Equal(t, []metadataBlob{
+		{File: "foo.tar.gz", Hash: testHashA},
+		{File: "bar.tar.gz", Hash: testHashB},
+	}, blobs)
+}
+
+func TestParseMetadataBlobs_Sources(t *testing.T) {
+	blobs, err := parseMetadataBlobs(strings.NewReader("SHA512 (foo.tar.gz) = " + testHashC + "\n"))
+	require.Nil(t, err)
+	require.Equal(t, []metadataBlob{{File: "foo.tar.gz", Hash: testHashC}}, blobs)
+}
+
+func TestAddBlobAliases(t *testing.T) {
+	blobs := []*mothership_db.EntryLookasideBlob{
+		{Sha256: testHashA, Sha512: testHashC},
+		{Sha256: testHashB, Sha512: strings.Repeat("0", 128)},
+	}
+
+	// The sources file only refers to the SHA-512 name
+	reachable := map[string]bool{testHashC: true}
+	addBlobAliases(reachable, blobs)
+	require.Equal(t, map[string]bool{testHashA: true, testHashC: true}, reachable)
+
+	// The srpmproc metadata file only refers to the SHA-256 name
+	reachable = map[string]bool{testHashA: true}
+	addBlobAliases(reachable, blobs)
+	require.Equal(t, map[string]bool{testHashA: true, testHashC: true}, reachable)
+}
+
+func TestMetadataHashesForRepo(t *testing.T) {
+	repo, err := git.Init(memory.NewStorage(), memfs.New())
+	require.Nil(t, err)
+	wt, err := repo.Worktree()
+	require.Nil(t, err)
+
+	commit := func(name string, content string) {
+		f, err := wt.Filesystem.Create(name)
+		require.Nil(t, err)
+		_, err = f.Write([]byte(content))
+		require.Nil(t, err)
+		require.Nil(t, f.Close())
+
+		_, err = wt.Add(name)
+		require.Nil(t, err)
+		_, err = wt.Commit("import", &git.CommitOptions{
+			Author: &object.Signature{
+				Name:  "Mship Bot",
+				Email: "no-reply+mshipbot@openela.org",
+				When:  time.Now(),
+			},
+		})
+		require.Nil(t, err)
+	}
+
+	// The first import is only reachable from its tag
+	commit(".efi-rpm-macros.metadata", testHashA+" SOURCES/efi-rpm-macros-3.tar.bz2\n")
+	head, err := repo.Head()
+	require.Nil(t, err)
+	_, err = repo.CreateTag("imports/el-8.8/efi-rpm-macros-3-3.el8", head.Hash(), &git.CreateTagOptions{
+		Message: "import efi-rpm-macros-3-3.el8",
+		Tagger: &object.Signature{
+			Name:  "Mship Bot",
+			Email: "no-reply+mshipbot@openela.org",
+			When:  time.Now(),
+		},
+	})
+	require.Nil(t, err)
+	commit(".efi-rpm-macros.metadata", testHashB+" SOURCES/efi-rpm-macros-4.tar.bz2\n")
+	commit("sources", "SHA512 (efi-rpm-macros-4.tar.bz2) = "+testHashC+"\n")
+
+	hashes, err := metadataHashesForRepo(repo)
+	require.Nil(t, err)
+	sort.Strings(hashes)
+	require.Equal(t, []string{testHashA, testHashB, testHashC}, hashes)
+}
+
+func TestMoveObject(t *testing.T) {
+	s := storage_memory.New(memfs.New())
+	_, err := s.PutBytes(testHashA, []byte("hello"))
+	require.Nil(t, err)
+
+	require.Nil(t, moveObject(s, testHashA, trashPrefix+testHashA))
+
+	_, err = s.Get(testHashA)
+	require.Equal(t, storage.ErrNotFound, err)
+	data, err := s.Get(trashPrefix + testHashA)
+	require.Nil(t, err)
+	require.Equal(t, []byte("hello"), data)
+}
@@ -0,0 +1,173 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"io"
+	"log/slog"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/openela/mothership/base"
+	mothership_db "github.com/openela/mothership/db"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/pkg/errors"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+)
+
+// backfillLookasideBlobsWorkflowID is the workflow ID of
+// BackfillLookasideBlobsWorkflow, see EnsureLookasideBlobsBackfill.
+const backfillLookasideBlobsWorkflowID = "operations/backfill-lookaside-blobs"
+
+// lookasideClassifications returns the classification of the blobs in the
+// metadata files of commit, as the import that committed them would have.
+// Metadata files only refer to one hash of a blob, so the blob is read from
+// storage to get both.
+func (w *Worker) lookasideClassifications(commit *object.Commit) ([]*mothershippb.LookasideClassification, error) {
+	blobs, err := metadataBlobsForCommit(commit)
+	if err != nil {
+		return nil, err
+	}
+
+	var classifications []*mothershippb.LookasideClassification
+	for _, blob := range blobs {
+		rc, _, err := w.storage.Open(blob.Hash)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to open lookaside blob %s", blob.Hash)
+		}
+		sha256Hash := sha256.New()
+		sha512Hash := sha512.New()
+		_, err = io.Copy(io.MultiWriter(sha256Hash, sha512Hash), rc)
+		_ = rc.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read lookaside blob %s", blob.Hash)
+		}
+
+		c := &mothershippb.LookasideClassification{
+			File:      blob.File,
+			Lookaside: true,
+			Sha256:    hex.EncodeToString(sha256Hash.Sum(nil)),
+			Sha512:    hex.EncodeToString(sha512Hash.Sum(nil)),
+		}
+		if blob.Hash != c.Sha256 && blob.Hash != c.Sha512 {
+			return nil, errors.Errorf("lookaside blob %s does not match its hash", blob.Hash)
+		}
+		classifications = append(classifications, c)
+	}
+
+	return classifications, nil
+}
+
+// BackfillLookasideBlobs records the lookaside blobs of archived entries that
+// were imported before lookaside blobs were recorded.
+// The blobs are read from the metadata files the entry committed.
+// Entries that fail are logged and skipped, and fail the activity once all
+// other entries are recorded. Running it again retries them.
+// This is a Temporal activity.
+func (w *Worker) BackfillLookasideBlobs(ctx context.Context) (*mothershippb.BackfillLookasideBlobsResponse, error) {
+	res := &mothershippb.BackfillLookasideBlobsResponse{}
+
+	entries, err := base.Q[mothership_db.Entry](w.db).F("state", mothershippb.Entry_ARCHIVED).All()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get archived entries")
+	}
+
+	auth, err := w.forge.GetAuthenticator()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get forge authenticator")
+	}
+
+	repos := map[string]*git.Repository{}
+	for _, entry := range entries {
+		if entry.PackageName == "" || entry.CommitHash == "" {
+			continue
+		}
+
+		count, err := base.Q[mothership_db.EntryLookasideBlob](w.db).F("entry_name", entry.Name).Count()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get entry lookaside blobs")
+		}
+		if count > 0 {
+			continue
+		}
+
+		activity.RecordHeartbeat(ctx, entry.Name)
+		res.CheckedEntries++
+
+		repo := repos[entry.PackageName]
+		if repo == nil {
+			repo, err = getRepo(w.forge.GetRemote(entry.PackageName), auth.AuthMethod)
+			if err != nil {
+				slog.Error("failed to get repo", "package", entry.PackageName, "error", err)
+				res.FailedEntries++
+				continue
+			}
+			repos[entry.PackageName] = repo
+		}
+
+		commit, err := repo.CommitObject(plumbing.NewHash(entry.CommitHash))
+		if err != nil {
+			slog.Error("failed to get commit", "entry", entry.Name, "commit", entry.CommitHash, "error", err)
+			res.FailedEntries++
+			continue
+		}
+
+		classifications, err := w.lookasideClassifications(commit)
+		if err != nil {
+			slog.Error("failed to get lookaside blobs", "entry", entry.Name, "error", err)
+			res.FailedEntries++
+			continue
+		}
+		if len(classifications) == 0 {
+			continue
+		}
+
+		err = w.setEntryLookasideBlobs(entry.Name, classifications)
+		if err != nil {
+			return nil, err
+		}
+		res.BackfilledEntries++
+	}
+
+	slog.Info(
+		"lookaside blob backfill finished",
+		"checked", res.CheckedEntries,
+		"backfilled", res.BackfilledEntries,
+		"failed", res.FailedEntries,
+	)
+	if res.FailedEntries > 0 {
+		return nil, errors.Errorf("failed to backfill lookaside blobs of %d entries", res.FailedEntries)
+	}
+
+	return res, nil
+}
+
+// EnsureLookasideBlobsBackfill starts BackfillLookasideBlobsWorkflow, unless
+// it already completed.
+// A failed run is started again, so entries that failed are retried on the
+// next start of the worker server.
+func EnsureLookasideBlobsBackfill(ctx context.Context, c client.Client, taskQueue string) error {
+	_, err := c.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:                    backfillLookasideBlobsWorkflowID,
+		TaskQueue:             taskQueue,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY,
+	}, BackfillLookasideBlobsWorkflow)
+	if err != nil {
+		var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+		if errors.As(err, &alreadyStarted) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to start lookaside blob backfill")
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	storage_memory "github.com/openela/mothership/base/storage/memory"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/stretchr/testify/require"
+)
+
+// commitMetadata commits the given metadata files to a new repository.
+func commitMetadata(t *testing.T, files map[string]string) *object.Commit {
+	repo, err := git.Init(memory.NewStorage(), memfs.New())
+	require.Nil(t, err)
+	wt, err := repo.Worktree()
+	require.Nil(t, err)
+
+	for name, content := range files {
+		f, err := wt.Filesystem.Create(name)
+		require.Nil(t, err)
+		_, err = f.Write([]byte(content))
+		require.Nil(t, err)
+		require.Nil(t, f.Close())
+		_, err = wt.Add(name)
+		require.Nil(t, err)
+	}
+
+	hash, err := wt.Commit("import", &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  "Mship Bot",
+			Email: "no-reply+mshipbot@openela.org",
+			When:  time.Now(),
+		},
+	})
+	require.Nil(t, err)
+	commit, err := repo.CommitObject(hash)
+	require.Nil(t, err)
+
+	return commit
+}
+
+func TestLookasideClassifications(t *testing.T) {
+	st := storage_memory.New(memfs.New())
+	// Blobs are stored under both hashes
+	_, err := st.PutBytes(testHashA, []byte("hello"))
+	require.Nil(t, err)
+	_, err = st.PutBytes(testHashC, []byte("hello"))
+	require.Nil(t, err)
+	w := &Worker{storage: st}
+
+	expected := []*mothershippb.LookasideClassification{
+		{
+			File:      "hello.tar.gz",
+			Lookaside: true,
+			Sha256:    testHashA,
+			Sha512:    testHashC,
+		},
+	}
+
+	// The srpmproc metadata file refers to the SHA-256 hash
+	commit := commitMetadata(t, map[string]string{
+		".hello.metadata": testHashA + " SOURCES/hello.tar.gz\n",
+	})
+	classifications, err := w.lookasideClassifications(commit)
+	require.Nil(t, err)
+	require.Equal(t, expected, classifications)
+
+	// The dist-git sources file refers to the SHA-512 hash
+	commit = commitMetadata(t, map[string]string{
+		"sources": "SHA512 (hello.tar.gz) = " + testHashC + "\n",
+	})
+	classifications, err = w.lookasideClassifications(commit)
+	require.Nil(t, err)
+	require.Equal(t, expected, classifications)
+}
+
+func TestLookasideClassifications_Mismatch(t *testing.T) {
+	st := storage_memory.New(memfs.New())
+	_, err := st.PutBytes(testHashB, []byte("hello"))
+	require.Nil(t, err)
+	w := &Worker{storage: st}
+
+	commit := commitMetadata(t, map[string]string{
+		".hello.metadata": testHashB + " SOURCES/hello.tar.gz\n",
+	})
+	_, err = w.lookasideClassifications(commit)
+	require.ErrorContains(t, err, "does not match its hash")
+}
+
+func TestLookasideClassifications_Missing(t *testing.T) {
+	w := &Worker{storage: storage_memory.New(memfs.New())}
+
+	commit := commitMetadata(t, map[string]string{
+		".hello.metadata": testHashA + " SOURCES/hello.tar.gz\n",
+	})
+	_, err := w.lookasideClassifications(commit)
+	require.NotNil(t, err)
+}
@@ -134,8 +134,19 @@ func TestMain(m *testing.M) {
 		localTempDir:  tempDirForge,
 		repos:         map[string]bool{},
 	}
-	testW = New(db, inMemStorage, gpgKeys, inmf, false)
-	testWRolling = New(db, inMemStorage, gpgKeys, inmf, true)
+	testW = New(Options{
+		DB:      db,
+		Storage: inMemStorage,
+		GPGKeys: gpgKeys,
+		Forge:   inmf,
+	})
+	testWRolling = New(Options{
+		DB:      db,
+		Storage: inMemStorage,
+		GPGKeys: gpgKeys,
+		Forge:   inmf,
+		Rolling: true,
+	})
 
 	if err := q[mothership_db.Worker]().Create(&mothership_db.Worker{
 		Name:      base.NameGen("workers"),
@@ -0,0 +1,206 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/go-git/go-git/v5/config"
+	"github.com/openela/mothership/base"
+	"github.com/openela/mothership/base/forge"
+	mothership_db "github.com/openela/mothership/db"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/pkg/errors"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+)
+
+// repairMirrorsScheduleID is the ID of the Temporal schedule that starts
+// RepairMirrorsWorkflow.
+const repairMirrorsScheduleID = "repair-mirrors"
+
+// mirrorRepairRefSpecs are synced to a mirror that fell behind.
+// Everything is synced since the mirror may have missed any number of imports
+// and retractions, refs that no longer exist on the primary are deleted.
+var mirrorRepairRefSpecs = []config.RefSpec{
+	"refs/heads/*:refs/heads/*",
+	"refs/tags/*:refs/tags/*",
+	"refs/notes/*:refs/notes/*",
+}
+
+// mirrorStatuses converts the results of a push to the mirrors.
+func mirrorStatuses(results []*forge.MirrorResult) []*mothershippb.MirrorStatus {
+	var statuses []*mothershippb.MirrorStatus
+	for _, result := range results {
+		status := &mothershippb.MirrorStatus{
+			Mirror: result.Name,
+			Synced: result.Err == nil,
+		}
+		if result.Err != nil {
+			// Mirror failures don't fail the import, so this is the only
+			// place they surface
+			slog.Error("failed to push to mirror", "mirror", result.Name, "error", result.Err)
+			status.Error = result.Err.Error()
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+// setEntryMirrors records the status of an entry on the mirrors.
+func (w *Worker) setEntryMirrors(entry string, statuses []*mothershippb.MirrorStatus) error {
+	for _, status := range statuses {
+		name := mothership_db.EntryMirrorName(entry, status.Mirror)
+		em, err := base.Q[mothership_db.EntryMirror](w.db).F("name", name).GetOrNil()
+		if err != nil {
+			return errors.Wrap(err, "failed to get entry mirror")
+		}
+
+		create := em == nil
+		if create {
+			em = &mothership_db.EntryMirror{
+				Name:      name,
+				EntryName: entry,
+				Mirror:    status.Mirror,
+			}
+		}
+		em.UpdateTime = time.Now()
+		em.Synced = status.Synced
+		em.Error = status.Error
+
+		if create {
+			err = base.Q[mothership_db.EntryMirror](w.db).Create(em)
+		} else {
+			err = base.Q[mothership_db.EntryMirror](w.db).U(em)
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to save entry mirror")
+		}
+	}
+
+	return nil
+}
+
+// RepairMirrors pushes every repository that has an entry missing from a
+// mirror to that mirror.
+// Mirrors fall behind if they were unavailable during an import or
+// retraction.
+// Does nothing if the forge isn't mirrored.
+// This is a Temporal activity.
+func (w *Worker) RepairMirrors(ctx context.Context) (*mothershippb.RepairMirrorsResponse, error) {
+	res := &mothershippb.RepairMirrorsResponse{}
+
+	mirrors := map[string]*forge.Mirror{}
+	for _, mirror := range forge.MirrorsOf(w.forge) {
+		mirrors[mirror.Name] = mirror
+	}
+	if len(mirrors) == 0 {
+		slog.Info("forge is not mirrored, nothing to repair")
+		return res, nil
+	}
+
+	unsynced, err := base.Q[mothership_db.EntryMirror](w.db).F("synced", false).All()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get unsynced entry mirrors")
+	}
+
+	// Repositories are pushed as a whole, so group by package and mirror
+	lagging := map[string]map[string][]*mothership_db.EntryMirror{}
+	for _, em := range unsynced {
+		// The mirror was removed from the configuration
+		if mirrors[em.Mirror] == nil {
+			continue
+		}
+
+		entry, err := base.Q[mothership_db.Entry](w.db).F("name", em.EntryName).GetOrNil()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get entry")
+		}
+		if entry == nil || entry.PackageName == "" {
+			continue
+		}
+
+		if lagging[entry.PackageName] == nil {
+			lagging[entry.PackageName] = map[string][]*mothership_db.EntryMirror{}
+		}
+		lagging[entry.PackageName][em.Mirror] = append(lagging[entry.PackageName][em.Mirror], em)
+	}
+
+	pkgs := make([]string, 0, len(lagging))
+	for pkg := range lagging {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+
+	auth, err := w.forge.GetAuthenticator()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get forge authenticator")
+	}
+
+	res.CheckedPackages = int64(len(pkgs))
+	for _, pkg := range pkgs {
+		activity.RecordHeartbeat(ctx, pkg)
+
+		repo, err := getRepo(w.forge.GetRemote(pkg), auth.AuthMethod)
+		if err != nil {
+			// Keep going, the next run retries the package
+			slog.Error("failed to get repo", "package", pkg, "error", err)
+			res.FailedMirrors += int64(len(lagging[pkg]))
+			continue
+		}
+
+		var pkgMirrors []*forge.Mirror
+		for name := range lagging[pkg] {
+			pkgMirrors = append(pkgMirrors, mirrors[name])
+		}
+		sort.Slice(pkgMirrors, func(i, j int) bool {
+			return pkgMirrors[i].Name < pkgMirrors[j].Name
+		})
+
+		results := forge.SyncMirrors(repo, pkgMirrors, pkg, mirrorRepairRefSpecs)
+		for _, status := range mirrorStatuses(results) {
+			if status.Synced {
+				res.RepairedMirrors++
+			} else {
+				res.FailedMirrors++
+			}
+
+			for _, em := range lagging[pkg][status.Mirror] {
+				err = w.setEntryMirrors(em.EntryName, []*mothershippb.MirrorStatus{status})
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	slog.Info(
+		"mirror repair finished",
+		"checked", res.CheckedPackages,
+		"repaired", res.RepairedMirrors,
+		"failed", res.FailedMirrors,
+	)
+
+	return res, nil
+}
+
+// EnsureRepairMirrorsSchedule creates or updates the Temporal schedule that
+// periodically starts RepairMirrorsWorkflow.
+// An empty cron expression removes the schedule.
+func EnsureRepairMirrorsSchedule(ctx context.Context, c client.Client, taskQueue string, cron string) error {
+	err := ensureSchedule(ctx, c, repairMirrorsScheduleID, cron, &client.ScheduleWorkflowAction{
+		ID:        "operations/repair-mirrors",
+		Workflow:  RepairMirrorsWorkflow,
+		TaskQueue: taskQueue,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to ensure mirror repair schedule")
+	}
+
+	return nil
+}
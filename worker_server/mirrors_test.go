@@ -0,0 +1,44 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/openela/mothership/base/forge"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestMirrorStatuses(t *testing.T) {
+	statuses := mirrorStatuses([]*forge.MirrorResult{
+		{Name: "gitlab"},
+		{Name: "gitea", Err: errors.New("connection refused")},
+	})
+	require.Len(t, statuses, 2)
+	require.Equal(t, "gitlab", statuses[0].Mirror)
+	require.True(t, statuses[0].Synced)
+	require.Empty(t, statuses[0].Error)
+	require.Equal(t, "gitea", statuses[1].Mirror)
+	require.False(t, statuses[1].Synced)
+	require.Equal(t, "connection refused", statuses[1].Error)
+
+	require.Nil(t, mirrorStatuses(nil))
+}
+
+func TestRepairMirrors_NotMirrored(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestActivityEnvironment()
+	worker := &Worker{forge: forge.NewCacher(&inMemoryForge{})}
+	env.RegisterActivity(worker)
+
+	val, err := env.ExecuteActivity(worker.RepairMirrors)
+	require.Nil(t, err)
+
+	var res mothershippb.RepairMirrorsResponse
+	require.Nil(t, val.Get(&res))
+	require.Equal(t, int64(0), res.CheckedPackages)
+}
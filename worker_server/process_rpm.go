@@ -4,21 +4,20 @@
 package mothership_worker_server
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
-	"io"
-	"os"
-	"path/filepath"
+	"log/slog"
 	"strings"
 
 	"github.com/go-git/go-billy/v5/memfs"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/openela/mothership/base/forge"
+	"github.com/openela/mothership/base/storage"
 	mothershippb "github.com/openela/mothership/proto/v1"
 	"github.com/openela/mothership/worker_server/srpm_import"
 	"github.com/pkg/errors"
-	"github.com/sassoftware/go-rpmutils"
 	"go.temporal.io/sdk/temporal"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 // VerifyResourceExists verifies that the resource exists.
@@ -37,9 +36,13 @@ func (w *Worker) VerifyResourceExists(uri string) error {
 		)
 	}
 
-	object, err := getObjectPath(uri)
+	object, err := storage.ObjectFromURI(w.storage, uri)
 	if err != nil {
-		return err
+		return temporal.NewNonRetryableApplicationError(
+			"could not parse resource URI",
+			"couldNotParseResourceURI",
+			errors.Wrap(err, "failed to parse resource URI"),
+		)
 	}
 
 	exists, err := w.storage.Exists(object)
@@ -58,56 +61,75 @@ func (w *Worker) VerifyResourceExists(uri string) error {
 	return nil
 }
 
-// ImportRPM imports an RPM into the database.
-// This is a Temporal activity.
-func (w *Worker) ImportRPM(uri string, checksumSha256 string, osRelease string) (*mothershippb.ImportRPMResponse, error) {
-	tempDir, err := os.MkdirTemp("", checksumSha256+"-mothership-worker-server-import-rpm-*")
+// importProvenance returns the provenance recorded on the import commit of
+// entry. The note holds the entry as JSON, without the email of the user
+// since notes are public.
+func importProvenance(entry *mothershippb.Entry) (*srpm_import.Provenance, error) {
+	noteEntry := proto.Clone(entry).(*mothershippb.Entry)
+	noteEntry.UserEmail = nil
+	note, err := protojson.MarshalOptions{Multiline: true}.Marshal(noteEntry)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create temporary directory")
+		return nil, errors.Wrap(err, "failed to marshal entry")
 	}
-	defer os.RemoveAll(tempDir)
 
-	// Parse uri
-	object, err := getObjectPath(uri)
-	if err != nil {
-		return nil, err
-	}
+	return &srpm_import.Provenance{
+		Entry:        entry.Name,
+		SourceSHA256: entry.Sha256Sum,
+		WorkerID:     entry.WorkerId.GetValue(),
+		Batch:        entry.Batch.GetValue(),
+		OSRelease:    entry.OsRelease,
+		Note:         append(note, '\n'),
+	}, nil
+}
 
-	// Download the resource to the temporary directory
-	err = w.storage.Download(object, filepath.Join(tempDir, "resource.rpm"))
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to download resource")
+// ImportRPM imports an RPM into the database.
+// The provenance of the import is recorded on the import commit if entry is
+// set, and the error of a failed import on the entry.
+// This is a Temporal activity.
+func (w *Worker) ImportRPM(uri string, checksumSha256 string, osRelease string, entry *mothershippb.Entry) (*mothershippb.ImportRPMResponse, error) {
+	res, err := w.importRPM(uri, checksumSha256, osRelease, entry)
+	if err != nil && entry != nil {
+		// The error is only shown in tickets, so recording it is best effort
+		if recordErr := w.setEntryErrorMessage(entry.Name, err); recordErr != nil {
+			slog.Info("failed to record import error", "entry", entry.Name, "err", recordErr)
+		}
 	}
 
-	// Verify checksum
-	hash := sha256.New()
-	f, err := os.Open(filepath.Join(tempDir, "resource.rpm"))
+	return res, err
+}
+
+func (w *Worker) importRPM(uri string, checksumSha256 string, osRelease string, entry *mothershippb.Entry) (*mothershippb.ImportRPMResponse, error) {
+	// Parse uri
+	object, err := storage.ObjectFromURI(w.storage, uri)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to open resource")
-	}
-	defer f.Close()
-	if _, err := io.Copy(hash, f); err != nil {
-		return nil, errors.Wrap(err, "failed to hash resource")
-	}
-	if hex.EncodeToString(hash.Sum(nil)) != checksumSha256 {
 		return nil, temporal.NewNonRetryableApplicationError(
-			"checksum does not match",
-			"checksumDoesNotMatch",
-			errors.New("client submitted a checksum that does not match the resource"),
+			"could not parse resource URI",
+			"couldNotParseResourceURI",
+			errors.Wrap(err, "failed to parse resource URI"),
 		)
 	}
 
-	// Read the RPM headers
-	_, err = f.Seek(0, io.SeekStart)
+	// Verify checksum before anything parses the resource
+	f, err := w.openVerified(object, checksumSha256)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to seek resource")
+		return nil, err
 	}
-	rpm, err := rpmutils.ReadRpm(f)
+	defer f.Close()
+
+	srpmState, err := srpm_import.FromReader(f, w.rolling, w.gpgKeys...)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to read RPM headers")
+		if strings.Contains(err.Error(), "failed to verify RPM") {
+			return nil, temporal.NewNonRetryableApplicationError(
+				"failed to verify RPM",
+				"failedToVerifyRPM",
+				err,
+			)
+		}
+		return nil, errors.Wrap(err, "failed to import SRPM")
 	}
+	defer srpmState.Close()
 
-	nevra, err := rpm.Header.GetNEVRA()
+	nevra, err := srpmState.GetNEVRA()
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get RPM NEVRA")
 	}
@@ -127,19 +149,20 @@ func (w *Worker) ImportRPM(uri string, checksumSha256 string, osRelease string)
 	}
 
 	// Then do an import
-	srpmState, err := srpm_import.FromFile(filepath.Join(tempDir, "resource.rpm"), w.rolling, w.gpgKeys...)
-	if err != nil {
-		if strings.Contains(err.Error(), "failed to verify RPM") {
-			return nil, temporal.NewNonRetryableApplicationError(
-				"failed to verify RPM",
-				"failedToVerifyRPM",
-				err,
-			)
+	srpmState.SetAuthor(authenticator.AuthorName, authenticator.AuthorEmail)
+	if w.metadataFormat != 0 {
+		srpmState.SetMetadataFormat(w.metadataFormat)
+	}
+	srpmState.SetLookasideRules(w.lookasideRules)
+	srpmState.SetMirrors(repoName, forge.MirrorsOf(w.forge))
+	srpmState.SetSigner(w.signer)
+	if entry != nil {
+		provenance, err := importProvenance(entry)
+		if err != nil {
+			return nil, err
 		}
-		return nil, errors.Wrap(err, "failed to import SRPM")
+		srpmState.SetProvenance(provenance)
 	}
-	defer srpmState.Close()
-	srpmState.SetAuthor(authenticator.AuthorName, authenticator.AuthorEmail)
 
 	cloneOpts := &git.CloneOptions{
 		URL:  w.forge.GetRemote(repoName),
@@ -154,6 +177,17 @@ func (w *Worker) ImportRPM(uri string, checksumSha256 string, osRelease string)
 
 	commitURI := w.forge.GetCommitViewerURL(repoName, importOut.Commit.Hash.String())
 
+	var classifications []*mothershippb.LookasideClassification
+	for _, c := range importOut.Classifications {
+		classifications = append(classifications, &mothershippb.LookasideClassification{
+			File:      c.File,
+			Lookaside: c.Lookaside,
+			Rule:      c.Rule,
+			Sha256:    c.Sha256,
+			Sha512:    c.Sha512,
+		})
+	}
+
 	return &mothershippb.ImportRPMResponse{
 		CommitHash:   importOut.Commit.Hash.String(),
 		CommitUri:    commitURI,
@@ -161,5 +195,8 @@ func (w *Worker) ImportRPM(uri string, checksumSha256 string, osRelease string)
 		CommitTag:    importOut.Tag,
 		Nevra:        nevra.String(),
 		Pkg:          nevra.Name,
+
+		LookasideClassifications: classifications,
+		Mirrors:                  mirrorStatuses(importOut.Mirrors),
 	}, nil
 }
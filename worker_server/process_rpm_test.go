@@ -31,6 +31,7 @@ func TestWorker_ImportRPM(t *testing.T) {
 		"memory://efi-rpm-macros-3-3.el8.src.rpm",
 		"518a9418fec1deaeb4c636615d8d81fb60146883c431ea15ab1127893d075d28",
 		"Rocky Linux release 8.8 (Green Obsidian)",
+		nil,
 	)
 	require.Nil(t, err)
 	require.NotNil(t, res)
@@ -47,6 +48,7 @@ func TestWorker_ImportRPM_Existing(t *testing.T) {
 		"memory://basesystem-11-5.el8.src.rpm",
 		"6beff4cbfd5425e2c193312a9a184969a27d6bbd2d4cc29d7ce72dbe3d9f6416",
 		"Rocky Linux release 8.8 (Green Obsidian)",
+		nil,
 	)
 	require.Nil(t, err)
 	require.NotNil(t, res)
@@ -57,6 +59,7 @@ func TestWorker_ImportRPM_Existing(t *testing.T) {
 		"memory://basesystem-11-5.el8.src.rpm",
 		"6beff4cbfd5425e2c193312a9a184969a27d6bbd2d4cc29d7ce72dbe3d9f6416",
 		"Rocky Linux release 8.8 (Green Obsidian)",
+		nil,
 	)
 	require.Nil(t, err)
 	require.NotNil(t, res)
@@ -85,6 +88,7 @@ func TestWorker_ImportRPM_ChecksumDoesntMatch(t *testing.T) {
 		"memory://efi-rpm-macros-3-3.el8.src.rpm",
 		"518a9418fec1deaeb4c636615d8d81fb60146883c431ea15ab1127893d075d27",
 		"Rocky Linux release 8.8 (Green Obsidian)",
+		nil,
 	)
 	require.NotNil(t, err)
 	require.Nil(t, res)
@@ -98,6 +102,7 @@ func TestWorker_ImportRPM_AuthError(t *testing.T) {
 		"memory://efi-rpm-macros-3-3.el8.src.rpm",
 		"518a9418fec1deaeb4c636615d8d81fb60146883c431ea15ab1127893d075d28",
 		"Rocky Linux release 8.8 (Green Obsidian)",
+		nil,
 	)
 	require.NotNil(t, err)
 	require.Nil(t, res)
@@ -113,6 +118,7 @@ func TestWorker_ImportRPM_InvalidCredentials(t *testing.T) {
 		"memory://efi-rpm-macros-3-3.el8.src.rpm",
 		"518a9418fec1deaeb4c636615d8d81fb60146883c431ea15ab1127893d075d28",
 		"Rocky Linux release 8.8 (Green Obsidian)",
+		nil,
 	)
 	require.NotNil(t, err)
 	require.Nil(t, res)
@@ -0,0 +1,115 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+
+	storage_replicated "github.com/openela/mothership/base/storage/replicated"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/pkg/errors"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+)
+
+// repairReplicasScheduleID is the ID of the Temporal schedule that starts
+// RepairReplicasWorkflow.
+const repairReplicasScheduleID = "repair-replicas"
+
+// RepairReplicas copies objects to every replica that is missing them.
+// Replicas miss objects if they were unavailable during a write that still
+// reached quorum, or if they were added later.
+// Does nothing if the storage backend isn't replicated.
+// This is a Temporal activity.
+func (w *Worker) RepairReplicas(ctx context.Context) (*mothershippb.RepairReplicasResponse, error) {
+	res := &mothershippb.RepairReplicasResponse{}
+
+	replicated, ok := storage_replicated.Find(w.storage)
+	if !ok {
+		slog.Info("storage is not replicated, nothing to repair")
+		return res, nil
+	}
+
+	// Listing every replica once is much cheaper than checking every
+	// object in every replica
+	replicas := replicated.Replicas()
+	present := make([]map[string]bool, len(replicas))
+	all := map[string]bool{}
+	for i, replica := range replicas {
+		activity.RecordHeartbeat(ctx, i)
+
+		objects, err := replica.List("")
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list replica %d", i)
+		}
+
+		present[i] = map[string]bool{}
+		for _, obj := range objects {
+			present[i][obj.Name] = true
+			all[obj.Name] = true
+		}
+	}
+
+	names := make([]string, 0, len(all))
+	for name := range all {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	res.CheckedObjects = int64(len(names))
+	for _, name := range names {
+		missing := false
+		for i := range replicas {
+			if !present[i][name] {
+				missing = true
+				break
+			}
+		}
+		if !missing {
+			continue
+		}
+
+		activity.RecordHeartbeat(ctx, name)
+		res.MissingObjects++
+
+		info, err := replicated.Repair(name)
+		if err != nil {
+			// Keep going, the next run retries the object
+			slog.Error("failed to repair object", "object", name, "error", err)
+			res.FailedObjects++
+			continue
+		}
+		res.RepairedObjects++
+		res.RepairedBytes += info.Size * int64(len(info.Repaired))
+	}
+
+	slog.Info(
+		"replica repair finished",
+		"checked", res.CheckedObjects,
+		"missing", res.MissingObjects,
+		"repaired", res.RepairedObjects,
+		"failed", res.FailedObjects,
+		"repairedBytes", res.RepairedBytes,
+	)
+
+	return res, nil
+}
+
+// EnsureRepairReplicasSchedule creates or updates the Temporal schedule that
+// periodically starts RepairReplicasWorkflow.
+// An empty cron expression removes the schedule.
+func EnsureRepairReplicasSchedule(ctx context.Context, c client.Client, taskQueue string, cron string) error {
+	err := ensureSchedule(ctx, c, repairReplicasScheduleID, cron, &client.ScheduleWorkflowAction{
+		ID:        "operations/repair-replicas",
+		Workflow:  RepairReplicasWorkflow,
+		TaskQueue: taskQueue,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to ensure replica repair schedule")
+	}
+
+	return nil
+}
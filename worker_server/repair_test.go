@@ -0,0 +1,70 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"testing"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/openela/mothership/base/storage"
+	storage_memory "github.com/openela/mothership/base/storage/memory"
+	storage_replicated "github.com/openela/mothership/base/storage/replicated"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestRepairReplicas(t *testing.T) {
+	a := storage_memory.New(memfs.New())
+	b := storage_memory.New(memfs.New())
+	_, err := a.PutBytes(testHashA, []byte("hello"))
+	require.Nil(t, err)
+	_, err = b.PutBytes(testHashB, []byte("hi"))
+	require.Nil(t, err)
+	_, err = a.PutBytes("both", []byte("x"))
+	require.Nil(t, err)
+	_, err = b.PutBytes("both", []byte("x"))
+	require.Nil(t, err)
+
+	replicated, err := storage_replicated.New(0, a, b)
+	require.Nil(t, err)
+
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestActivityEnvironment()
+	worker := &Worker{storage: replicated}
+	env.RegisterActivity(worker)
+
+	val, err := env.ExecuteActivity(worker.RepairReplicas)
+	require.Nil(t, err)
+
+	var res mothershippb.RepairReplicasResponse
+	require.Nil(t, val.Get(&res))
+	require.Equal(t, int64(3), res.CheckedObjects)
+	require.Equal(t, int64(2), res.MissingObjects)
+	require.Equal(t, int64(2), res.RepairedObjects)
+	require.Equal(t, int64(0), res.FailedObjects)
+	require.Equal(t, int64(7), res.RepairedBytes)
+
+	for _, s := range []storage.Storage{a, b} {
+		for _, object := range []string{testHashA, testHashB, "both"} {
+			ok, err := s.Exists(object)
+			require.Nil(t, err)
+			require.True(t, ok)
+		}
+	}
+}
+
+func TestRepairReplicas_NotReplicated(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestActivityEnvironment()
+	worker := &Worker{storage: storage_memory.New(memfs.New())}
+	env.RegisterActivity(worker)
+
+	val, err := env.ExecuteActivity(worker.RepairReplicas)
+	require.Nil(t, err)
+
+	var res mothershippb.RepairReplicasResponse
+	require.Nil(t, val.Get(&res))
+	require.Equal(t, int64(0), res.CheckedObjects)
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/go-git/go-git/v5/storage/memory"
 	"github.com/openela/mothership/base"
 	"github.com/openela/mothership/base/forge"
+	"github.com/openela/mothership/base/signing"
 	mothership_db "github.com/openela/mothership/db"
 	mshipadminpb "github.com/openela/mothership/proto/admin/v1"
 	"github.com/pkg/errors"
@@ -142,7 +143,9 @@ func clonePatchesToTemporaryFS(currentFS billy.Filesystem) (billy.Filesystem, er
 	return fs, nil
 }
 
-func resetRepoToPoint(repo *git.Repository, authenticator *forge.Authenticator, commit string, branch string) error {
+// resetRepoToPoint resets branch to the commit before commit, replaying the
+// changes to PATCHES since. New commits are signed by signer, if set.
+func resetRepoToPoint(repo *git.Repository, authenticator *forge.Authenticator, signer signing.Signer, commit string, branch string) error {
 	wt, err := repo.Worktree()
 	if err != nil {
 		return errors.Wrap(err, "failed to get worktree")
@@ -277,13 +280,13 @@ func resetRepoToPoint(repo *git.Repository, authenticator *forge.Authenticator,
 		}
 
 		// Create the commit
-		_, err = wt.Commit(commitMsg, &git.CommitOptions{
+		_, err = signing.Commit(repo, commitMsg, &git.CommitOptions{
 			Author: &object.Signature{
 				Name:  authenticator.AuthorName,
 				Email: authenticator.AuthorEmail,
 				When:  time.Now(),
 			},
-		})
+		}, signer)
 		if err != nil {
 			return errors.Wrap(err, "failed to commit")
 		}
@@ -292,14 +295,14 @@ func resetRepoToPoint(repo *git.Repository, authenticator *forge.Authenticator,
 	// If resetToCommit is nil, that means there are no commits before the target commit
 	// On top if there are no commits to replay, we need to create an empty rollback commit
 	if resetToCommit == nil && len(commits) == 0 {
-		_, err = wt.Commit("Rollback to empty state", &git.CommitOptions{
+		_, err = signing.Commit(repo, "Rollback to empty state", &git.CommitOptions{
 			AllowEmptyCommits: true,
 			Author: &object.Signature{
 				Name:  authenticator.AuthorName,
 				Email: authenticator.AuthorEmail,
 				When:  time.Now(),
 			},
-		})
+		}, signer)
 		if err != nil {
 			return errors.Wrap(err, "failed to create rollback commit")
 		}
@@ -353,26 +356,36 @@ func (w *Worker) RetractEntry(name string) (*mshipadminpb.RetractEntryResponse,
 	}
 
 	// Reset the repo to the commit before the commit we want to revert
-	err = resetRepoToPoint(repo, auth, entry.CommitHash, entry.CommitBranch)
+	err = resetRepoToPoint(repo, auth, w.signer, entry.CommitHash, entry.CommitBranch)
 	if err != nil {
 		base.LogErrorf("failed to reset repo: %v", err)
 		return nil, status.Error(codes.Internal, "failed to reset repo")
 	}
 
 	// Push the changes
+	refSpecs := []config.RefSpec{
+		config.RefSpec("refs/heads/" + entry.CommitBranch + ":refs/heads/" + entry.CommitBranch),
+	}
 	err = repo.Push(&git.PushOptions{
 		RemoteName: "origin",
 		Force:      true,
 		Auth:       auth.AuthMethod,
-		RefSpecs: []config.RefSpec{
-			config.RefSpec("refs/heads/" + entry.CommitBranch + ":refs/heads/" + entry.CommitBranch),
-		},
+		RefSpecs:   refSpecs,
 	})
 	if err != nil {
 		base.LogErrorf("failed to push changes: %v", err)
 		return nil, status.Error(codes.Internal, "failed to push changes")
 	}
 
+	// Push the retraction to the mirrors, mirrors that fail are repaired
+	// later
+	results := forge.PushMirrors(repo, forge.MirrorsOf(w.forge), entry.PackageName, refSpecs)
+	err = w.setEntryMirrors(entry.Name, mirrorStatuses(results))
+	if err != nil {
+		base.LogErrorf("failed to set entry mirrors: %v", err)
+		return nil, status.Error(codes.Internal, "failed to set entry mirrors")
+	}
+
 	return &mshipadminpb.RetractEntryResponse{
 		Name: entry.Name,
 	}, nil
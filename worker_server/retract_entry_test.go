@@ -181,6 +181,7 @@ func TestResetRepoToPoint_OneCommit(t *testing.T) {
 	err = resetRepoToPoint(
 		repo,
 		&forge.Authenticator{AuthorName: "test", AuthorEmail: "test@rockylinux.org"},
+		nil,
 		firstImport.Commit.Hash.String(),
 		"el-8",
 	)
@@ -244,6 +245,7 @@ func TestResetRepoToPoint_TwoCommits(t *testing.T) {
 	err = resetRepoToPoint(
 		repo,
 		&forge.Authenticator{AuthorName: "test", AuthorEmail: "test@rockylinux.org"},
+		nil,
 		secondImport.Commit.Hash.String(),
 		"el-8",
 	)
@@ -328,6 +330,7 @@ func TestResetRepoToPoint_TwoCommits_CommitAfterRetractPoint(t *testing.T) {
 	err = resetRepoToPoint(
 		repo,
 		&forge.Authenticator{AuthorName: "test", AuthorEmail: "test@rockylinux.org"},
+		nil,
 		secondImport.Commit.Hash.String(),
 		"el-8",
 	)
@@ -0,0 +1,80 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"context"
+	"log/slog"
+
+	storage_encrypted "github.com/openela/mothership/base/storage/encrypted"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/pkg/errors"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+)
+
+// rewrapKeysScheduleID is the ID of the Temporal schedule that starts
+// RewrapKeysWorkflow.
+const rewrapKeysScheduleID = "rewrap-keys"
+
+// RewrapKeys re-wraps the data key of every object with the primary
+// storage encryption key, so older keys can be removed from the keyring
+// after a rotation. Only object metadata is rewritten.
+// Does nothing if the storage backend isn't encrypted.
+// This is a Temporal activity.
+func (w *Worker) RewrapKeys(ctx context.Context) (*mothershippb.RewrapKeysResponse, error) {
+	res := &mothershippb.RewrapKeysResponse{}
+
+	encrypted, ok := storage_encrypted.Find(w.storage)
+	if !ok {
+		slog.Info("storage is not encrypted, nothing to rewrap")
+		return res, nil
+	}
+
+	objects, err := encrypted.List("")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list objects")
+	}
+
+	for _, obj := range objects {
+		activity.RecordHeartbeat(ctx, obj.Name)
+		res.CheckedObjects++
+
+		rewrapped, err := encrypted.Rewrap(obj.Name)
+		if err != nil {
+			// Keep going, the next run retries the object
+			slog.Error("failed to rewrap object", "object", obj.Name, "error", err)
+			res.FailedObjects++
+			continue
+		}
+		if rewrapped {
+			res.RewrappedObjects++
+		}
+	}
+
+	slog.Info(
+		"key rewrap finished",
+		"checked", res.CheckedObjects,
+		"rewrapped", res.RewrappedObjects,
+		"failed", res.FailedObjects,
+	)
+
+	return res, nil
+}
+
+// EnsureRewrapKeysSchedule creates or updates the Temporal schedule that
+// periodically starts RewrapKeysWorkflow.
+// An empty cron expression removes the schedule.
+func EnsureRewrapKeysSchedule(ctx context.Context, c client.Client, taskQueue string, cron string) error {
+	err := ensureSchedule(ctx, c, rewrapKeysScheduleID, cron, &client.ScheduleWorkflowAction{
+		ID:        "operations/rewrap-keys",
+		Workflow:  RewrapKeysWorkflow,
+		TaskQueue: taskQueue,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to ensure key rewrap schedule")
+	}
+
+	return nil
+}
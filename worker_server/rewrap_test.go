@@ -0,0 +1,75 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	storage_encrypted "github.com/openela/mothership/base/storage/encrypted"
+	storage_memory "github.com/openela/mothership/base/storage/memory"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+// testEncryptionKeyring returns a keyring whose keys are derived from
+// their ID, so the same ID always has the same key.
+func testEncryptionKeyring(t *testing.T, primary string, ids ...string) *storage_encrypted.Keyring {
+	keys := map[string][]byte{}
+	for _, id := range ids {
+		keys[id] = bytes.Repeat([]byte(id), 32)[:32]
+	}
+
+	kr, err := storage_encrypted.NewKeyring(primary, keys)
+	require.Nil(t, err)
+	return kr
+}
+
+func TestRewrapKeys(t *testing.T) {
+	backend := storage_memory.New(memfs.New())
+
+	old := storage_encrypted.New(backend, testEncryptionKeyring(t, "k1", "k1"))
+	_, err := old.PutBytes(testHashA, []byte("hello"))
+	require.Nil(t, err)
+
+	rotated := storage_encrypted.New(backend, testEncryptionKeyring(t, "k2", "k1", "k2"))
+	_, err = rotated.PutBytes(testHashB, []byte("hi"))
+	require.Nil(t, err)
+
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestActivityEnvironment()
+	worker := &Worker{storage: rotated}
+	env.RegisterActivity(worker)
+
+	val, err := env.ExecuteActivity(worker.RewrapKeys)
+	require.Nil(t, err)
+
+	var res mothershippb.RewrapKeysResponse
+	require.Nil(t, val.Get(&res))
+	require.Equal(t, int64(2), res.CheckedObjects)
+	require.Equal(t, int64(1), res.RewrappedObjects)
+	require.Equal(t, int64(0), res.FailedObjects)
+
+	// k1 can be retired now
+	retired := storage_encrypted.New(backend, testEncryptionKeyring(t, "k2", "k2"))
+	data, err := retired.Get(testHashA)
+	require.Nil(t, err)
+	require.Equal(t, []byte("hello"), data)
+}
+
+func TestRewrapKeys_NotEncrypted(t *testing.T) {
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestActivityEnvironment()
+	worker := &Worker{storage: storage_memory.New(memfs.New())}
+	env.RegisterActivity(worker)
+
+	val, err := env.ExecuteActivity(worker.RewrapKeys)
+	require.Nil(t, err)
+
+	var res mothershippb.RewrapKeysResponse
+	require.Nil(t, val.Get(&res))
+	require.Equal(t, int64(0), res.CheckedObjects)
+}
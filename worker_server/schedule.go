@@ -0,0 +1,70 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+)
+
+// ensureSchedule creates or updates the Temporal schedule with the given ID,
+// so it matches the current flags.
+// An empty cron expression removes the schedule.
+// Runs never overlap, a run that is still going when the next one is due
+// skips the next one.
+func ensureSchedule(ctx context.Context, c client.Client, id string, cron string, action *client.ScheduleWorkflowAction) error {
+	scheduleClient := c.ScheduleClient()
+	handle := scheduleClient.GetHandle(ctx, id)
+
+	if cron == "" {
+		err := handle.Delete(ctx)
+		if err != nil {
+			var notFound *serviceerror.NotFound
+			if errors.As(err, &notFound) {
+				return nil
+			}
+			return errors.Wrap(err, "failed to delete schedule")
+		}
+		return nil
+	}
+
+	spec := client.ScheduleSpec{
+		CronExpressions: []string{cron},
+	}
+
+	_, err := scheduleClient.Create(ctx, client.ScheduleOptions{
+		ID:      id,
+		Spec:    spec,
+		Action:  action,
+		Overlap: enumspb.SCHEDULE_OVERLAP_POLICY_SKIP,
+	})
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, temporal.ErrScheduleAlreadyRunning) {
+		return errors.Wrap(err, "failed to create schedule")
+	}
+
+	// The schedule already exists, make sure it matches the current flags
+	err = handle.Update(ctx, client.ScheduleUpdateOptions{
+		DoUpdate: func(input client.ScheduleUpdateInput) (*client.ScheduleUpdate, error) {
+			schedule := input.Description.Schedule
+			schedule.Spec = &spec
+			schedule.Action = action
+			return &client.ScheduleUpdate{
+				Schedule: &schedule,
+			}, nil
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to update schedule")
+	}
+
+	return nil
+}
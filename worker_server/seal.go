@@ -4,16 +4,12 @@
 package mothership_worker_server
 
 import (
-	"bytes"
 	"context"
 	"database/sql"
-	"fmt"
 	"log/slog"
-	"text/template"
 	"time"
 
 	"github.com/openela/mothership/base"
-	"github.com/openela/mothership/base/bugtracker"
 	mothership_db "github.com/openela/mothership/db"
 	mothershippb "github.com/openela/mothership/proto/v1"
 	"github.com/pkg/errors"
@@ -21,12 +17,6 @@ import (
 	"go.temporal.io/sdk/temporal"
 )
 
-type issueBody struct {
-	Batch     *mothership_db.Batch
-	Entries   []*mothership_db.Entry
-	PublicURI string
-}
-
 func (w *Worker) isEntriesSettled(req *mothershippb.SealBatchRequest) error {
 	var entries []*mothership_db.Entry
 	var err error
@@ -48,7 +38,7 @@ func (w *Worker) isEntriesSettled(req *mothershippb.SealBatchRequest) error {
 
 	allEntriesSettled := true
 	for _, entry := range entries {
-		if entry.State != mothershippb.Entry_ARCHIVED && entry.State != mothershippb.Entry_ON_HOLD && entry.State != mothershippb.Entry_FAILED && entry.State != mothershippb.Entry_CANCELLED {
+		if entry.State != mothershippb.Entry_ARCHIVED && entry.State != mothershippb.Entry_ON_HOLD && entry.State != mothershippb.Entry_FAILED && entry.State != mothershippb.Entry_CANCELLED && entry.State != mothershippb.Entry_CONFLICT {
 			allEntriesSettled = false
 			break
 		}
@@ -61,55 +51,24 @@ func (w *Worker) isEntriesSettled(req *mothershippb.SealBatchRequest) error {
 	return nil
 }
 
-func (w *Worker) getTicketInfo(batch *mothership_db.Batch) (string, string, *bugtracker.Options, error) {
-	labels := []string{"all-successful"}
+// getTicketInfo renders the ticket of batch with tmpl.
+// nil is returned if the batch has no entries.
+func (w *Worker) getTicketInfo(batch *mothership_db.Batch, tmpl *TicketTemplate) (*ticketInfo, error) {
 	entries, err := base.Q[mothership_db.Entry](w.db).F("batch_name", batch.Name).All()
 	if err != nil {
-		return "", "", nil, errors.Wrap(err, "failed to get entries")
+		return nil, errors.Wrap(err, "failed to get entries")
 	}
 	if len(entries) == 0 {
-		return "", "", nil, nil
-	}
-
-	for _, entry := range entries {
-		entryPb := entry.ToPB()
-		if entryPb.State != mothershippb.Entry_ARCHIVED {
-			labels = []string{"failed-entry"}
-			break
-		}
-	}
-
-	labels = append(labels, "import-batch")
-
-	title := fmt.Sprintf("%s: %s", batch.WorkerID, batch.Name)
-	body := `Worker {{.Batch.WorkerID}} sealed {{.Batch.Name}}.
-
-{{if .Entries}}The following entries were in the batch:
-{{range .Entries}}- [{{if eq .State 2}}x{{else}} {{end}}] [{{.EntryID}}]({{$.PublicURI}}/{{.Name}})
-{{end}}{{else}}No entries were in batch. This is a test, please ignore.{{end}}
-`
-
-	bodyTemplate, err := template.New("issueBody").Parse(body)
-	if err != nil {
-		return "", "", nil, errors.Wrap(err, "failed to parse template")
+		return nil, nil
 	}
 
-	var buf bytes.Buffer
-	err = bodyTemplate.Execute(&buf, issueBody{
-		Batch:     batch,
-		Entries:   entries,
-		PublicURI: w.publicURI,
-	})
+	info, err := tmpl.render(newTicketData(batch, entries, w.publicURI, time.Now()))
 	if err != nil {
-		return "", "", nil, errors.Wrap(err, "failed to execute template")
-	}
-	formattedBody := buf.String()
-
-	opts := bugtracker.Options{
-		Labels: labels,
+		return nil, err
 	}
+	info.Options.MajorVersion = majorVersion(entries[0].OSRelease)
 
-	return title, formattedBody, &opts, nil
+	return info, nil
 }
 
 func (w *Worker) SealBatch(name string) (*mothershippb.Batch, error) {
@@ -151,7 +110,11 @@ func (w *Worker) WaitForEntriesToSettle(ctx context.Context, req *mothershippb.S
 }
 
 func (w *Worker) CreateTicket(ctx context.Context, batchName string) error {
-	if w.bugtracker == nil {
+	tracker, configName, err := w.activeBugtracker()
+	if err != nil {
+		return err
+	}
+	if tracker == nil {
 		return nil
 	}
 
@@ -167,27 +130,32 @@ func (w *Worker) CreateTicket(ctx context.Context, batchName string) error {
 		)
 	}
 
-	title, formattedBody, opts, err := w.getTicketInfo(batch)
+	tmpl, err := w.getTicketTemplate(configName)
+	if err != nil {
+		return errors.Wrap(err, "failed to get ticket template")
+	}
+
+	info, err := w.getTicketInfo(batch, tmpl)
 	if err != nil {
 		return errors.Wrap(err, "failed to get ticket title and body")
 	}
-	if title == "" {
+	if info == nil {
 		return nil
 	}
 
-	auth, err := w.bugtracker.GetAuthenticator()
+	auth, err := tracker.GetAuthenticator()
 	if err != nil {
 		return errors.Wrap(err, "failed to get authenticator")
 	}
 
-	ticket, err := w.bugtracker.CreateTicket(auth, title, formattedBody, *opts)
+	ticket, err := tracker.CreateTicket(auth, info.Title, info.Body, info.Options)
 	if err != nil {
 		return errors.Wrap(err, "failed to create ticket")
 	}
 
 	// Everything beyond this point is best effort.
 	// If we fail to update the batch with the ticket URI, it's not the end of the world.
-	ticketURI, err := w.bugtracker.TicketURI(ticket)
+	ticketURI, err := tracker.TicketURI(ticket)
 	if err != nil {
 		slog.Info("failed to get ticket URI", "err", err)
 	}
@@ -196,6 +164,7 @@ func (w *Worker) CreateTicket(ctx context.Context, batchName string) error {
 		Valid:  true,
 		String: ticketURI,
 	}
+	batch.BugtrackerConfigName = configName
 
 	err = base.Q[mothership_db.Batch](w.db).U(batch)
 	if err != nil {
@@ -203,8 +172,8 @@ func (w *Worker) CreateTicket(ctx context.Context, batchName string) error {
 	}
 
 	// Close ticket if everything went well, but again not the end of the world if we fail.
-	if opts.Labels[0] == "all-successful" {
-		err = w.bugtracker.CloseTicket(auth, ticket)
+	if info.Close {
+		err = tracker.CloseTicket(auth, ticket)
 		if err != nil {
 			slog.Info("failed to close ticket", "err", err)
 		}
@@ -214,7 +183,12 @@ func (w *Worker) CreateTicket(ctx context.Context, batchName string) error {
 }
 
 func (w *Worker) UpdateTicketStatus(ctx context.Context, entry *mothershippb.Entry) error {
-	if w.bugtracker == nil {
+	// No ticket is created without a bug tracker, so don't wait for one
+	tracker, _, err := w.activeBugtracker()
+	if err != nil {
+		return err
+	}
+	if tracker == nil {
 		return nil
 	}
 
@@ -234,32 +208,53 @@ func (w *Worker) UpdateTicketStatus(ctx context.Context, entry *mothershippb.Ent
 		time.Sleep(5 * time.Second)
 	}
 
-	auth, err := w.bugtracker.GetAuthenticator()
+	return w.updateBatchTicket(batch)
+}
+
+// updateBatchTicket renders the ticket of a batch again, and closes it if
+// the template says so.
+// The ticket is updated in the bug tracker that created it.
+func (w *Worker) updateBatchTicket(batch *mothership_db.Batch) error {
+	tracker, err := w.ticketBugtracker(batch.BugtrackerConfigName)
+	if err != nil {
+		return err
+	}
+	if tracker == nil {
+		slog.Info("bug tracker config of ticket was deleted", "batch", batch.Name)
+		return nil
+	}
+
+	auth, err := tracker.GetAuthenticator()
 	if err != nil {
 		return errors.Wrap(err, "failed to get authenticator")
 	}
 
-	title, formattedBody, opts, err := w.getTicketInfo(batch)
+	tmpl, err := w.getTicketTemplate(batch.BugtrackerConfigName)
+	if err != nil {
+		return errors.Wrap(err, "failed to get ticket template")
+	}
+
+	info, err := w.getTicketInfo(batch, tmpl)
 	if err != nil {
 		return errors.Wrap(err, "failed to get ticket title and body")
 	}
-	if title == "" {
+	if info == nil {
 		return nil
 	}
 
-	ticket, err := w.bugtracker.URIToTicket(batch.BugtrackerURI.String)
+	ticket, err := tracker.URIToTicket(batch.BugtrackerURI.String)
 	if err != nil {
 		return errors.Wrap(err, "failed to get ticket ID")
 	}
 
-	err = w.bugtracker.EditTicket(auth, ticket, title, formattedBody, *opts)
+	err = tracker.EditTicket(auth, ticket, info.Title, info.Body, info.Options)
 	if err != nil {
 		return errors.Wrap(err, "failed to edit ticket")
 	}
 
 	// Close ticket if everything went well, but again not the end of the world if we fail.
-	if opts.Labels[0] == "all-successful" {
-		err = w.bugtracker.CloseTicket(auth, ticket)
+	if info.Close {
+		err = tracker.CloseTicket(auth, ticket)
 		if err != nil {
 			slog.Info("failed to close ticket", "err", err)
 		}
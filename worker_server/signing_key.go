@@ -0,0 +1,39 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"github.com/openela/mothership/base"
+	"github.com/openela/mothership/base/signing"
+	mothership_db "github.com/openela/mothership/db"
+	"github.com/pkg/errors"
+)
+
+// EnsureSigningKey records the public key of signer, so the API server can
+// serve it to consumers verifying imports.
+// Keys are never removed, a rotated key stays listed.
+func EnsureSigningKey(db *base.DB, signer signing.Signer) error {
+	key := signer.Key()
+	name := "signingKeys/" + key.ID
+
+	existing, err := base.Q[mothership_db.SigningKey](db).F("name", name).GetOrNil()
+	if err != nil {
+		return errors.Wrap(err, "failed to get signing key")
+	}
+	if existing != nil {
+		return nil
+	}
+
+	err = base.Q[mothership_db.SigningKey](db).Create(&mothership_db.SigningKey{
+		Name:        name,
+		Format:      string(key.Format),
+		Fingerprint: key.Fingerprint,
+		PublicKey:   key.PublicKey,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create signing key")
+	}
+
+	return nil
+}
@@ -0,0 +1,228 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package srpm_import
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/pkg/errors"
+)
+
+// LookasideOverrideFile is the per-package override file in the PATCHES
+// directory. Every line is either "lookaside <glob>" or "git <glob>", the
+// first line matching a file decides where it is stored.
+// Empty lines and lines starting with # are ignored.
+const LookasideOverrideFile = "PATCHES/lookaside.rules"
+
+// sniffSize is how much of a file is read to determine whether it is binary.
+// This is the same amount git looks at.
+const sniffSize = 8000
+
+// LookasideRules decides which files of an SRPM are stored in the lookaside
+// cache instead of git.
+// Rules are evaluated in the following order, the first one that applies
+// wins:
+//  1. Per-package overrides from LookasideOverrideFile
+//  2. Exclude globs, the file is kept in git
+//  3. Include globs, the file is stored in the lookaside
+//  4. Files larger than SizeThreshold are stored in the lookaside
+//  5. If SniffBinary is set, binary files are stored in the lookaside
+//
+// Anything else is kept in git.
+type LookasideRules struct {
+	// SizeThreshold is the size in bytes above which files are stored in the
+	// lookaside. Zero disables the size rule.
+	SizeThreshold int64
+
+	// Include is a list of globs for files that are always stored in the
+	// lookaside.
+	Include []string
+
+	// Exclude is a list of globs for files that are always kept in git.
+	Exclude []string
+
+	// SniffBinary stores binary files in the lookaside, regardless of size.
+	SniffBinary bool
+}
+
+// DefaultLookasideRules returns the rules used if none are set.
+// Files larger than 5MB and tarballs are stored in the lookaside.
+func DefaultLookasideRules() *LookasideRules {
+	return &LookasideRules{
+		SizeThreshold: 5 * 1024 * 1024,
+		Include:       []string{"*.tar*"},
+	}
+}
+
+// Validate returns an error if any of the globs are malformed.
+func (r *LookasideRules) Validate() error {
+	for _, pattern := range append(append([]string{}, r.Include...), r.Exclude...) {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return errors.Wrapf(err, "invalid glob %s", pattern)
+		}
+	}
+
+	return nil
+}
+
+// LookasideClassification records where a file of an SRPM was stored and why.
+type LookasideClassification struct {
+	// File is the name of the file
+	File string
+
+	// Lookaside is true if the file was stored in the lookaside
+	Lookaside bool
+
+	// Rule is the rule that matched, e.g. "include *.tar*" or "size > 5242880"
+	Rule string
+
+	// Sha256 and Sha512 are the hex encoded hashes of the file, only set if
+	// the file was stored in the lookaside
+	Sha256 string
+	Sha512 string
+}
+
+// lookasideOverride is a single line of the override file.
+type lookasideOverride struct {
+	lookaside bool
+	pattern   string
+}
+
+// parseLookasideOverrides parses the override file.
+func parseLookasideOverrides(r io.Reader) ([]*lookasideOverride, error) {
+	var overrides []*lookasideOverride
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return nil, errors.Errorf("line %d: expected \"lookaside <glob>\" or \"git <glob>\"", line)
+		}
+		if _, err := filepath.Match(fields[1], ""); err != nil {
+			return nil, errors.Wrapf(err, "line %d: invalid glob %s", line, fields[1])
+		}
+
+		switch fields[0] {
+		case "lookaside":
+			overrides = append(overrides, &lookasideOverride{lookaside: true, pattern: fields[1]})
+		case "git":
+			overrides = append(overrides, &lookasideOverride{lookaside: false, pattern: fields[1]})
+		default:
+			return nil, errors.Errorf("line %d: unknown target %s", line, fields[0])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return overrides, nil
+}
+
+// readLookasideOverrides reads the override file from the target repository.
+// A missing override file is not an error.
+func readLookasideOverrides(targetFS billy.Filesystem) ([]*lookasideOverride, error) {
+	f, err := targetFS.Open(LookasideOverrideFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to open lookaside override file")
+	}
+	defer f.Close()
+
+	overrides, err := parseLookasideOverrides(f)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse lookaside override file")
+	}
+
+	return overrides, nil
+}
+
+// isBinary returns true if the file looks binary, using the same heuristic
+// as git: a NUL byte in the first few kilobytes.
+func isBinary(path string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to open file")
+	}
+	defer f.Close()
+
+	buf := make([]byte, sniffSize)
+	n, err := io.ReadFull(f, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return false, errors.Wrap(err, "failed to read file")
+	}
+
+	return bytes.IndexByte(buf[:n], 0) != -1, nil
+}
+
+// matchGlobs returns the first glob matching name.
+func matchGlobs(globs []string, name string) (string, bool) {
+	for _, glob := range globs {
+		if ok, _ := filepath.Match(glob, name); ok {
+			return glob, true
+		}
+	}
+
+	return "", false
+}
+
+// classify decides whether the file at path is stored in the lookaside.
+func (r *LookasideRules) classify(overrides []*lookasideOverride, path string, size int64) (*LookasideClassification, error) {
+	name := filepath.Base(path)
+	c := &LookasideClassification{File: name}
+
+	for _, override := range overrides {
+		if ok, _ := filepath.Match(override.pattern, name); ok {
+			c.Lookaside = override.lookaside
+			c.Rule = fmt.Sprintf("override %s", override.pattern)
+			return c, nil
+		}
+	}
+
+	if glob, ok := matchGlobs(r.Exclude, name); ok {
+		c.Rule = fmt.Sprintf("exclude %s", glob)
+		return c, nil
+	}
+
+	if glob, ok := matchGlobs(r.Include, name); ok {
+		c.Lookaside = true
+		c.Rule = fmt.Sprintf("include %s", glob)
+		return c, nil
+	}
+
+	if r.SizeThreshold > 0 && size > r.SizeThreshold {
+		c.Lookaside = true
+		c.Rule = fmt.Sprintf("size > %d", r.SizeThreshold)
+		return c, nil
+	}
+
+	if r.SniffBinary {
+		binary, err := isBinary(path)
+		if err != nil {
+			return nil, err
+		}
+		if binary {
+			c.Lookaside = true
+			c.Rule = "binary"
+			return c, nil
+		}
+	}
+
+	c.Rule = "default"
+	return c, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package srpm_import
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestFile(t *testing.T, name string, data []byte) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.Nil(t, os.WriteFile(path, data, 0644))
+	return path
+}
+
+func TestLookasideRules_Validate(t *testing.T) {
+	require.Nil(t, DefaultLookasideRules().Validate())
+	require.NotNil(t, (&LookasideRules{Include: []string{"[a-"}}).Validate())
+	require.NotNil(t, (&LookasideRules{Exclude: []string{"[a-"}}).Validate())
+}
+
+func TestLookasideRules_Classify(t *testing.T) {
+	rules := &LookasideRules{
+		SizeThreshold: 16,
+		Include:       []string{"*.tar*", "*.zip"},
+		Exclude:       []string{"small.tar.gz"},
+		SniffBinary:   true,
+	}
+
+	tests := []struct {
+		name      string
+		data      []byte
+		lookaside bool
+		rule      string
+	}{
+		{"bash-4.4.tar.gz", []byte("tar"), true, "include *.tar*"},
+		{"small.tar.gz", []byte("tar"), false, "exclude small.tar.gz"},
+		{"big.txt", []byte(strings.Repeat("a", 17)), true, "size > 16"},
+		{"logo.png", []byte("\x89PNG\x00"), true, "binary"},
+		{"fix.patch", []byte("--- a\n+++ b\n"), false, "default"},
+	}
+	for _, tt := range tests {
+		path := writeTestFile(t, tt.name, tt.data)
+		c, err := rules.classify(nil, path, int64(len(tt.data)))
+		require.Nil(t, err)
+		require.Equal(t, tt.name, c.File)
+		require.Equal(t, tt.lookaside, c.Lookaside, tt.name)
+		require.Equal(t, tt.rule, c.Rule, tt.name)
+	}
+}
+
+func TestLookasideRules_Classify_Override(t *testing.T) {
+	overrides, err := parseLookasideOverrides(strings.NewReader("# keep the small tarball in git\ngit small.tar.gz\n\nlookaside *.patch\n"))
+	require.Nil(t, err)
+	require.Len(t, overrides, 2)
+
+	rules := DefaultLookasideRules()
+
+	path := writeTestFile(t, "small.tar.gz", []byte("tar"))
+	c, err := rules.classify(overrides, path, 3)
+	require.Nil(t, err)
+	require.False(t, c.Lookaside)
+	require.Equal(t, "override small.tar.gz", c.Rule)
+
+	path = writeTestFile(t, "fix.patch", []byte("--- a\n"))
+	c, err = rules.classify(overrides, path, 6)
+	require.Nil(t, err)
+	require.True(t, c.Lookaside)
+	require.Equal(t, "override *.patch", c.Rule)
+}
+
+func TestParseLookasideOverrides_Invalid(t *testing.T) {
+	_, err := parseLookasideOverrides(strings.NewReader("lookaside\n"))
+	require.NotNil(t, err)
+
+	_, err = parseLookasideOverrides(strings.NewReader("elsewhere *.tar.gz\n"))
+	require.NotNil(t, err)
+
+	_, err = parseLookasideOverrides(strings.NewReader("git [a-\n"))
+	require.NotNil(t, err)
+}
+
+func TestReadLookasideOverrides(t *testing.T) {
+	fs := memfs.New()
+
+	overrides, err := readLookasideOverrides(fs)
+	require.Nil(t, err)
+	require.Nil(t, overrides)
+
+	f, err := fs.Create(LookasideOverrideFile)
+	require.Nil(t, err)
+	_, err = f.Write([]byte("git *.tar.bz2\n"))
+	require.Nil(t, err)
+	require.Nil(t, f.Close())
+
+	overrides, err = readLookasideOverrides(fs)
+	require.Nil(t, err)
+	require.Len(t, overrides, 1)
+	require.False(t, overrides[0].lookaside)
+	require.Equal(t, "*.tar.bz2", overrides[0].pattern)
+}
@@ -0,0 +1,149 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package srpm_import
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/pkg/errors"
+)
+
+// MetadataFormat is a set of lookaside metadata file formats.
+type MetadataFormat int
+
+const (
+	// MetadataFormatSrpmproc is the srpmproc style ".<name>.metadata" file.
+	// Lines are of the format:
+	//
+	//	<sha256> SOURCES/<file>
+	MetadataFormatSrpmproc MetadataFormat = 1 << iota
+
+	// MetadataFormatSources is the Fedora/CentOS dist-git "sources" file,
+	// as read by fedpkg and centpkg.
+	// Lines are of the format:
+	//
+	//	SHA512 (<file>) = <sha512>
+	//
+	// The repository keeps the srpmproc layout, with sources in SOURCES/ and
+	// the spec in SPECS/, so files are listed relative to SOURCES/. With the
+	// lookaside URL pointing at the gateway, sources are downloaded to where
+	// the spec expects them with:
+	//
+	//	centpkg sources --outdir SOURCES
+	//
+	// Without --outdir they're downloaded to the repository root, so they're
+	// ignored there too.
+	MetadataFormatSources
+
+	// MetadataFormatBoth writes both files.
+	MetadataFormatBoth = MetadataFormatSrpmproc | MetadataFormatSources
+)
+
+// sourcesFile is the name of the dist-git sources file.
+const sourcesFile = "sources"
+
+// ParseMetadataFormat parses a metadata format name.
+// Valid names are "srpmproc", "sources" and "both".
+func ParseMetadataFormat(name string) (MetadataFormat, error) {
+	switch name {
+	case "srpmproc":
+		return MetadataFormatSrpmproc, nil
+	case "sources":
+		return MetadataFormatSources, nil
+	case "both":
+		return MetadataFormatBoth, nil
+	default:
+		return 0, errors.Errorf("unknown metadata format %s", name)
+	}
+}
+
+// lookasideBlob holds the hashes of a lookaside blob.
+type lookasideBlob struct {
+	sha256 string
+	sha512 string
+}
+
+// objectNames returns the names a blob is stored under in the lookaside.
+// Blobs are stored under both their SHA-256 and SHA-512 hash, whatever the
+// metadata format, so the lookaside serves them by either hash type and
+// switching formats doesn't require uploading them again.
+// The garbage collector treats both names as reachable if either is.
+func (b *lookasideBlob) objectNames() []string {
+	return []string{b.sha256, b.sha512}
+}
+
+// hashFile returns the hashes of a file.
+func hashFile(name string, open func(string) (io.ReadCloser, error)) (*lookasideBlob, error) {
+	file, err := open(name)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open file")
+	}
+	defer file.Close()
+
+	sha256Hash := sha256.New()
+	sha512Hash := sha512.New()
+	_, err = io.Copy(io.MultiWriter(sha256Hash, sha512Hash), file)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to copy file")
+	}
+
+	return &lookasideBlob{
+		sha256: hex.EncodeToString(sha256Hash.Sum(nil)),
+		sha512: hex.EncodeToString(sha512Hash.Sum(nil)),
+	}, nil
+}
+
+// writeSrpmprocMetadata writes the .<name>.metadata file.
+func writeSrpmprocMetadata(targetFS billy.Filesystem, name string, paths []string, blobs map[string]*lookasideBlob) error {
+	metadataFile := fmt.Sprintf(".%s.metadata", name)
+
+	// Delete the file if it exists
+	_ = targetFS.Remove(metadataFile)
+
+	f, err := targetFS.Create(metadataFile)
+	if err != nil {
+		return errors.Wrap(err, "failed to open metadata file")
+	}
+	defer f.Close()
+
+	for _, path := range paths {
+		// RPM sources MUST be in SOURCES/ directory
+		_, err = f.Write([]byte(blobs[path].sha256 + " " + filepath.Join("SOURCES", path) + "\n"))
+		if err != nil {
+			return errors.Wrap(err, "failed to write line to metadata file")
+		}
+	}
+
+	return nil
+}
+
+// writeSourcesMetadata writes the dist-git sources file.
+// Files are listed by their full path relative to SOURCES/, like in the
+// srpmproc metadata file, so files with the same name in different
+// directories don't collide.
+func writeSourcesMetadata(targetFS billy.Filesystem, paths []string, blobs map[string]*lookasideBlob) error {
+	// Delete the file if it exists
+	_ = targetFS.Remove(sourcesFile)
+
+	f, err := targetFS.Create(sourcesFile)
+	if err != nil {
+		return errors.Wrap(err, "failed to open sources file")
+	}
+	defer f.Close()
+
+	for _, path := range paths {
+		_, err = f.Write([]byte(fmt.Sprintf("SHA512 (%s) = %s\n", path, blobs[path].sha512)))
+		if err != nil {
+			return errors.Wrap(err, "failed to write line to sources file")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package srpm_import
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/pkg/errors"
+)
+
+// NotesRef is the notes reference that holds the provenance notes of import
+// commits. The notes can be read with:
+//
+//	git fetch origin refs/notes/mship:refs/notes/mship
+//	git log --notes=mship
+const NotesRef = plumbing.ReferenceName("refs/notes/mship")
+
+// maxNotesPushAttempts is how often pushing NotesRef is attempted, if other
+// imports keep pushing notes at the same time.
+const maxNotesPushAttempts = 5
+
+// notesMessage is the message of the commits on NotesRef, the same message
+// git notes uses.
+const notesMessage = "Notes added by 'git notes add'\n"
+
+// Provenance describes where an import came from.
+// It is recorded as trailers of the import commit, and a note on the import
+// commit under NotesRef, so an import can be verified from git alone.
+type Provenance struct {
+	// Entry is the name of the entry that imported the SRPM
+	Entry string
+
+	// SourceSHA256 is the SHA256 checksum of the SRPM
+	SourceSHA256 string
+
+	// WorkerID is the ID of the worker that submitted the SRPM
+	WorkerID string
+
+	// Batch is the name of the batch the entry is part of, if any
+	Batch string
+
+	// OSRelease is the OS release the SRPM was submitted for
+	OSRelease string
+
+	// Note is stored as a note on the import commit, usually the entry as
+	// JSON. No note is added if empty.
+	Note []byte
+}
+
+// trailers returns the provenance trailers of the import commit.
+// keyID is the ID of the key that verified the SRPM, if any.
+// Empty values are left out.
+func (p *Provenance) trailers(keyID string) string {
+	var sb strings.Builder
+	for _, trailer := range [][2]string{
+		{"Mship-Entry", p.Entry},
+		{"Source-SHA256", p.SourceSHA256},
+		{"Worker-ID", p.WorkerID},
+		{"Batch", p.Batch},
+		{"OS-Release", p.OSRelease},
+		{"SRPM-Key-ID", keyID},
+	} {
+		if trailer[1] == "" {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("%s: %s\n", trailer[0], trailer[1]))
+	}
+
+	return sb.String()
+}
+
+// commitMessage returns the message of the import commit.
+// Provenance trailers are only added if provenance is set.
+func (s *State) commitMessage(subject string) string {
+	if s.provenance == nil {
+		return subject
+	}
+
+	return subject + "\n\n" + s.provenance.trailers(s.keyID)
+}
+
+// sortTreeEntries sorts entries in the order git expects, directories are
+// sorted as if their name ended with a slash.
+func sortTreeEntries(entries []object.TreeEntry) {
+	key := func(e object.TreeEntry) string {
+		if e.Mode == filemode.Dir {
+			return e.Name + "/"
+		}
+		return e.Name
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return key(entries[i]) < key(entries[j])
+	})
+}
+
+// addNote adds note to commit under NotesRef, replacing an existing note of
+// the commit.
+// Notes are stored without fan-out, which git reads as well.
+func addNote(repo *git.Repository, commit plumbing.Hash, note []byte, author object.Signature) error {
+	var parents []plumbing.Hash
+	var entries []object.TreeEntry
+
+	ref, err := repo.Reference(NotesRef, true)
+	if err != nil && !errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return errors.Wrap(err, "failed to get notes reference")
+	}
+	if ref != nil {
+		notesCommit, err := repo.CommitObject(ref.Hash())
+		if err != nil {
+			return errors.Wrap(err, "failed to get notes commit")
+		}
+		tree, err := notesCommit.Tree()
+		if err != nil {
+			return errors.Wrap(err, "failed to get notes tree")
+		}
+
+		parents = append(parents, notesCommit.Hash)
+		for _, entry := range tree.Entries {
+			if entry.Name != commit.String() {
+				entries = append(entries, entry)
+			}
+		}
+	}
+
+	blob := repo.Storer.NewEncodedObject()
+	blob.SetType(plumbing.BlobObject)
+	w, err := blob.Writer()
+	if err != nil {
+		return errors.Wrap(err, "failed to write note")
+	}
+	_, err = w.Write(note)
+	if err != nil {
+		return errors.Wrap(err, "failed to write note")
+	}
+	err = w.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to write note")
+	}
+	blobHash, err := repo.Storer.SetEncodedObject(blob)
+	if err != nil {
+		return errors.Wrap(err, "failed to store note")
+	}
+
+	entries = append(entries, object.TreeEntry{
+		Name: commit.String(),
+		Mode: filemode.Regular,
+		Hash: blobHash,
+	})
+	sortTreeEntries(entries)
+
+	tree := &object.Tree{Entries: entries}
+	treeObj := repo.Storer.NewEncodedObject()
+	err = tree.Encode(treeObj)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode notes tree")
+	}
+	treeHash, err := repo.Storer.SetEncodedObject(treeObj)
+	if err != nil {
+		return errors.Wrap(err, "failed to store notes tree")
+	}
+
+	notesCommit := &object.Commit{
+		Author:       author,
+		Committer:    author,
+		Message:      notesMessage,
+		TreeHash:     treeHash,
+		ParentHashes: parents,
+	}
+	commitObj := repo.Storer.NewEncodedObject()
+	err = notesCommit.Encode(commitObj)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode notes commit")
+	}
+	commitHash, err := repo.Storer.SetEncodedObject(commitObj)
+	if err != nil {
+		return errors.Wrap(err, "failed to store notes commit")
+	}
+
+	err = repo.Storer.SetReference(plumbing.NewHashReference(NotesRef, commitHash))
+	if err != nil {
+		return errors.Wrap(err, "failed to update notes reference")
+	}
+
+	return nil
+}
+
+// pushNotes pushes NotesRef to origin without force, since it is shared by
+// all branches of the repository. If another import pushed notes first, its
+// notes are fetched, note is added to commit again, and the push is retried.
+func pushNotes(repo *git.Repository, auth transport.AuthMethod, commit plumbing.Hash, note []byte, author object.Signature) error {
+	refSpec := config.RefSpec(fmt.Sprintf("%s:%[1]s", NotesRef))
+	for attempt := 1; ; attempt++ {
+		err := repo.Push(&git.PushOptions{
+			RemoteName: "origin",
+			Auth:       auth,
+			RefSpecs:   []config.RefSpec{refSpec},
+		})
+		if err == nil || errors.Is(err, git.NoErrAlreadyUpToDate) {
+			return nil
+		}
+		if attempt == maxNotesPushAttempts {
+			return errors.Wrap(err, "failed to push notes")
+		}
+
+		// The notes of origin replace the local ones, which only add note
+		err = repo.Fetch(&git.FetchOptions{
+			RemoteName: "origin",
+			Auth:       auth,
+			RefSpecs:   []config.RefSpec{config.RefSpec("+" + refSpec)},
+		})
+		if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+			return errors.Wrap(err, "failed to fetch notes")
+		}
+
+		err = addNote(repo, commit, note, author)
+		if err != nil {
+			return errors.Wrap(err, "failed to add provenance note")
+		}
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package srpm_import
+
+import (
+	"os"
+	"testing"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/osfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	storage_memory "github.com/openela/mothership/base/storage/memory"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/openpgp"
+)
+
+// readNote returns the note of commit under NotesRef.
+func readNote(t *testing.T, repo *git.Repository, commit plumbing.Hash) string {
+	ref, err := repo.Reference(NotesRef, false)
+	require.Nil(t, err)
+	notesCommit, err := repo.CommitObject(ref.Hash())
+	require.Nil(t, err)
+	tree, err := notesCommit.Tree()
+	require.Nil(t, err)
+	f, err := tree.File(commit.String())
+	require.Nil(t, err)
+	note, err := f.Contents()
+	require.Nil(t, err)
+
+	return note
+}
+
+func TestProvenance_Trailers(t *testing.T) {
+	p := &Provenance{
+		Entry:        "entries/1",
+		SourceSHA256: "518a9418fec1deaeb4c636615d8d81fb60146883c431ea15ab1127893d075d28",
+		WorkerID:     "worker-1",
+		OSRelease:    "Rocky Linux release 8.8 (Green Obsidian)",
+	}
+	require.Equal(t, `Mship-Entry: entries/1
+Source-SHA256: 518a9418fec1deaeb4c636615d8d81fb60146883c431ea15ab1127893d075d28
+Worker-ID: worker-1
+OS-Release: Rocky Linux release 8.8 (Green Obsidian)
+SRPM-Key-ID: 15af5dac6d745a60
+`, p.trailers("15af5dac6d745a60"))
+}
+
+func TestCommitMessage_NoProvenance(t *testing.T) {
+	s := &State{keyID: "15af5dac6d745a60"}
+	require.Equal(t, "import efi-rpm-macros-3-3.el8", s.commitMessage("import efi-rpm-macros-3-3.el8"))
+}
+
+func TestAddNote_Replace(t *testing.T) {
+	repo, err := git.Init(memory.NewStorage(), nil)
+	require.Nil(t, err)
+
+	author := object.Signature{Name: "Mship Bot", Email: "no-reply+mshipbot@openela.org"}
+	first := plumbing.NewHash("1111111111111111111111111111111111111111")
+	second := plumbing.NewHash("2222222222222222222222222222222222222222")
+	require.Nil(t, addNote(repo, second, []byte("second\n"), author))
+	require.Nil(t, addNote(repo, first, []byte("first\n"), author))
+	require.Nil(t, addNote(repo, second, []byte("second again\n"), author))
+
+	require.Equal(t, "first\n", readNote(t, repo, first))
+	require.Equal(t, "second again\n", readNote(t, repo, second))
+
+	// Every note is a commit on the notes ref
+	ref, err := repo.Reference(NotesRef, false)
+	require.Nil(t, err)
+	log, err := repo.Log(&git.LogOptions{From: ref.Hash()})
+	require.Nil(t, err)
+	count := 0
+	require.Nil(t, log.ForEach(func(*object.Commit) error {
+		count++
+		return nil
+	}))
+	require.Equal(t, 3, count)
+}
+
+func TestPushNotes_Concurrent(t *testing.T) {
+	tempDir := t.TempDir()
+	_, err := git.PlainInit(tempDir, true)
+	require.Nil(t, err)
+
+	author := object.Signature{Name: "Mship Bot", Email: "no-reply+mshipbot@openela.org"}
+	newRepo := func() *git.Repository {
+		repo, err := git.Init(memory.NewStorage(), nil)
+		require.Nil(t, err)
+		_, err = repo.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{tempDir}})
+		require.Nil(t, err)
+		return repo
+	}
+
+	// Both imports started before either pushed its note
+	el8 := plumbing.NewHash("1111111111111111111111111111111111111111")
+	el9 := plumbing.NewHash("2222222222222222222222222222222222222222")
+	repo8 := newRepo()
+	require.Nil(t, addNote(repo8, el8, []byte("el8\n"), author))
+	repo9 := newRepo()
+	require.Nil(t, addNote(repo9, el9, []byte("el9\n"), author))
+
+	require.Nil(t, pushNotes(repo8, nil, el8, []byte("el8\n"), author))
+	require.Nil(t, pushNotes(repo9, nil, el9, []byte("el9\n"), author))
+
+	// Neither note is lost
+	remote, err := git.PlainOpen(tempDir)
+	require.Nil(t, err)
+	require.Equal(t, "el8\n", readNote(t, remote, el8))
+	require.Equal(t, "el9\n", readNote(t, remote, el9))
+}
+
+func TestImport1_Provenance(t *testing.T) {
+	keyF, err := os.Open("testdata/RPM-GPG-KEY-Rocky-8")
+	require.Nil(t, err)
+	defer keyF.Close()
+	keys, err := openpgp.ReadArmoredKeyRing(keyF)
+	require.Nil(t, err)
+
+	tempDir := t.TempDir()
+	_, err = git.PlainInit(tempDir, true)
+	require.Nil(t, err)
+	lookaside := storage_memory.New(osfs.New("/"))
+
+	var commits []plumbing.Hash
+	for _, entry := range []string{"entries/1", "entries/2"} {
+		s, err := FromFile("testdata/efi-rpm-macros-3-3.el8.src.rpm", false, keys...)
+		require.Nil(t, err)
+		s.SetProvenance(&Provenance{
+			Entry:        entry,
+			SourceSHA256: "518a9418fec1deaeb4c636615d8d81fb60146883c431ea15ab1127893d075d28",
+			WorkerID:     "worker-1",
+			Batch:        "batches/1",
+			OSRelease:    "Rocky Linux release 8.8 (Green Obsidian)",
+			Note:         []byte(`{"name": "` + entry + `"}` + "\n"),
+		})
+
+		opts := &git.CloneOptions{
+			URL: tempDir,
+		}
+		out, err := s.Import(opts, memory.NewStorage(), memfs.New(), lookaside, "Rocky Linux release 8.8 (Green Obsidian)")
+		require.Nil(t, err)
+		require.Nil(t, s.Close())
+
+		require.Equal(t, `import efi-rpm-macros-3-3.el8
+
+Mship-Entry: `+entry+`
+Source-SHA256: 518a9418fec1deaeb4c636615d8d81fb60146883c431ea15ab1127893d075d28
+Worker-ID: worker-1
+Batch: batches/1
+OS-Release: Rocky Linux release 8.8 (Green Obsidian)
+SRPM-Key-ID: 15af5dac6d745a60
+`, out.Commit.Message)
+		commits = append(commits, out.Commit.Hash)
+	}
+
+	// The notes of both imports are pushed
+	repo, err := git.PlainOpen(tempDir)
+	require.Nil(t, err)
+	require.Equal(t, "{\"name\": \"entries/1\"}\n", readNote(t, repo, commits[0]))
+	require.Equal(t, "{\"name\": \"entries/2\"}\n", readNote(t, repo, commits[1]))
+
+	// The commits are reachable from the branch
+	ref, err := repo.Reference("refs/heads/el-8.8", false)
+	require.Nil(t, err)
+	require.Equal(t, commits[1], ref.Hash())
+	commit, err := repo.CommitObject(ref.Hash())
+	require.Nil(t, err)
+	require.Equal(t, []plumbing.Hash{commits[0]}, commit.ParentHashes)
+}
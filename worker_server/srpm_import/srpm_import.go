@@ -4,14 +4,13 @@
 package srpm_import
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -23,6 +22,8 @@ import (
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	storage2 "github.com/go-git/go-git/v5/storage"
+	"github.com/openela/mothership/base/forge"
+	"github.com/openela/mothership/base/signing"
 	"github.com/openela/mothership/base/storage"
 	"github.com/pkg/errors"
 	srpmprocpb "github.com/rocky-linux/srpmproc/pb"
@@ -52,8 +53,22 @@ type State struct {
 	// authorEmail is the email of the author of the commit.
 	authorEmail string
 
-	// lookasideBlobs is a map of blob names to their SHA256 hashes.
-	lookasideBlobs map[string]string
+	// lookasideBlobs is a map of blob names to their hashes.
+	lookasideBlobs map[string]*lookasideBlob
+
+	// metadataFormat determines which lookaside metadata files are written,
+	// and which hashes blobs are uploaded under.
+	metadataFormat MetadataFormat
+
+	// lookasideRules determines which files are stored in the lookaside.
+	lookasideRules *LookasideRules
+
+	// lookasideOverrides are the per-package overrides read from the
+	// target repository.
+	lookasideOverrides []*lookasideOverride
+
+	// classifications records where each file was stored and why.
+	classifications map[string]*LookasideClassification
 
 	// rolling determines how the branch is named.
 	// if true, the branch is named "elX" where X is the major release
@@ -62,6 +77,22 @@ type State struct {
 
 	// tag is the tag name
 	tag string
+
+	// mirrors are pushed the same refs as the target repository.
+	mirrors []*forge.Mirror
+
+	// mirrorRepo is the name of the repository on the mirrors.
+	mirrorRepo string
+
+	// signer signs the import commit and tag, if set.
+	signer signing.Signer
+
+	// keyID is the ID of the key that verified the SRPM, empty if the SRPM
+	// wasn't verified.
+	keyID string
+
+	// provenance is recorded on the import commit, if set.
+	provenance *Provenance
 }
 
 type ImportOutput struct {
@@ -73,6 +104,14 @@ type ImportOutput struct {
 
 	// Tag is the tag name
 	Tag string
+
+	// Classifications records where each file of the SRPM was stored,
+	// sorted by file name
+	Classifications []*LookasideClassification
+
+	// Mirrors is the result of the push to every mirror, in the order the
+	// mirrors were set
+	Mirrors []*forge.MirrorResult
 }
 
 // copyFromOS copies specified file from OS filesystem to target filesystem.
@@ -114,46 +153,110 @@ func FromFile(path string, rolling bool, keys ...*openpgp.Entity) (*State, error
 	}
 	defer f.Close()
 
-	// If keys is not empty, then verify the RPM signature.
-	if len(keys) > 0 {
-		_, _, err := rpmutils.Verify(f, keys)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to verify RPM")
-		}
+	return FromReader(f, rolling, keys...)
+}
 
-		// After verifying the RPM, seek back to the start of the file.
-		_, err = f.Seek(0, io.SeekStart)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to seek to start of file")
-		}
+// FromReader creates a new State from an SRPM stream.
+// The SRPM is verified (if keys are given) and extracted to a temporary
+// directory in a single pass, so the stream doesn't have to be seekable.
+// The stream is always read to the end, even if verification fails, so
+// callers can hash the stream while it is being read.
+func FromReader(r io.Reader, rolling bool, keys ...*openpgp.Entity) (*State, error) {
+	// Create a temporary directory.
+	tempDir, err := os.MkdirTemp("", "srpm_import-*")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create temporary directory")
 	}
 
-	rpm, err := rpmutils.ReadRpm(f)
+	rpm, keyID, err := readAndExpand(r, tempDir, keys)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to read RPM")
+		_ = os.RemoveAll(tempDir)
+		return nil, err
 	}
 
-	state := &State{
-		rpm:            rpm,
-		authorName:     "Mship Bot",
-		authorEmail:    "no-reply+mshipbot@openela.org",
-		lookasideBlobs: make(map[string]string),
-		rolling:        rolling,
-	}
+	return &State{
+		tempDir:         tempDir,
+		rpm:             rpm,
+		authorName:      "Mship Bot",
+		authorEmail:     "no-reply+mshipbot@openela.org",
+		lookasideBlobs:  make(map[string]*lookasideBlob),
+		metadataFormat:  MetadataFormatSrpmproc,
+		lookasideRules:  DefaultLookasideRules(),
+		classifications: make(map[string]*LookasideClassification),
+		rolling:         rolling,
+		keyID:           keyID,
+	}, nil
+}
 
-	// Create a temporary directory.
-	state.tempDir, err = os.MkdirTemp("", "srpm_import-*")
+// expandStream reads the RPM headers from r and extracts the payload to dir.
+// The rest of r is always drained.
+func expandStream(r io.Reader, dir string) (*rpmutils.Rpm, error) {
+	// Drain the stream whatever happens, the other side may still be writing.
+	defer io.Copy(io.Discard, r)
+
+	rpm, err := rpmutils.ReadRpm(r)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to create temporary directory")
+		return nil, errors.Wrap(err, "failed to read RPM")
 	}
 
 	// Extract the SRPM.
-	err = rpm.ExpandPayload(state.tempDir)
+	err = rpm.ExpandPayload(dir)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to extract SRPM")
 	}
 
-	return state, nil
+	return rpm, nil
+}
+
+// readAndExpand extracts the RPM in r to dir.
+// If keys is not empty, then the RPM signature is verified while the RPM
+// is being extracted, and the ID of the key that verified it is returned.
+func readAndExpand(r io.Reader, dir string, keys openpgp.EntityList) (*rpmutils.Rpm, string, error) {
+	if len(keys) == 0 {
+		rpm, err := expandStream(r, dir)
+		return rpm, "", err
+	}
+
+	// Verify consumes the whole stream, so tee it into the extractor.
+	pr, pw := io.Pipe()
+	type expandResult struct {
+		rpm *rpmutils.Rpm
+		err error
+	}
+	expandDone := make(chan expandResult, 1)
+	go func() {
+		rpm, err := expandStream(pr, dir)
+		expandDone <- expandResult{rpm, err}
+	}()
+
+	tee := io.TeeReader(r, pw)
+	_, sigs, verifyErr := rpmutils.Verify(tee, keys)
+	// Verify may stop early on failure, pass the rest of the stream through.
+	_, copyErr := io.Copy(io.Discard, tee)
+	if copyErr != nil {
+		_ = pw.CloseWithError(copyErr)
+	} else {
+		_ = pw.Close()
+	}
+
+	res := <-expandDone
+	if verifyErr != nil {
+		return nil, "", errors.Wrap(verifyErr, "failed to verify RPM")
+	}
+	if copyErr != nil {
+		return nil, "", errors.Wrap(copyErr, "failed to read RPM")
+	}
+	if res.err != nil {
+		return nil, "", res.err
+	}
+
+	// Verify fails unless every signature is made by one of the keys
+	var keyID string
+	if len(sigs) > 0 {
+		keyID = fmt.Sprintf("%016x", sigs[0].KeyId)
+	}
+
+	return res.rpm, keyID, nil
 }
 
 func (s *State) Close() error {
@@ -164,22 +267,63 @@ func (s *State) GetDir() string {
 	return s.tempDir
 }
 
+// GetNEVRA returns the NEVRA of the SRPM.
+func (s *State) GetNEVRA() (*rpmutils.NEVRA, error) {
+	return s.rpm.Header.GetNEVRA()
+}
+
 func (s *State) SetAuthor(name, email string) {
 	s.authorName = name
 	s.authorEmail = email
 }
 
+// SetMetadataFormat sets which lookaside metadata files are written.
+func (s *State) SetMetadataFormat(format MetadataFormat) {
+	s.metadataFormat = format
+}
+
+// SetLookasideRules sets which files are stored in the lookaside.
+// If rules is nil, the default rules are used.
+func (s *State) SetLookasideRules(rules *LookasideRules) {
+	if rules == nil {
+		rules = DefaultLookasideRules()
+	}
+	s.lookasideRules = rules
+}
+
+// SetSigner sets the signer of the import commit and tag.
+// Commits and tags are unsigned if signer is nil.
+func (s *State) SetSigner(signer signing.Signer) {
+	s.signer = signer
+}
+
+// SetProvenance sets the provenance recorded on the import commit.
+// Nothing is recorded if provenance is nil.
+func (s *State) SetProvenance(provenance *Provenance) {
+	s.provenance = provenance
+}
+
+// KeyID returns the ID of the key that verified the SRPM, or an empty string
+// if the SRPM wasn't verified.
+func (s *State) KeyID() string {
+	return s.keyID
+}
+
+// SetMirrors sets the mirrors every import is also pushed to, and the name
+// of the repository on them.
+func (s *State) SetMirrors(repoName string, mirrors []*forge.Mirror) {
+	s.mirrorRepo = repoName
+	s.mirrors = mirrors
+}
+
 // determineLookasideBlobs determines which blobs need to be uploaded to the
 // lookaside cache.
-// Currently, the rule is that if a file is larger than 5MB, and is binary,
-// then it should be uploaded to the lookaside cache.
-// If the file name contains ".tar", then it is assumed to be a tarball, and
-// is ALWAYS uploaded to the lookaside cache.
+// See LookasideRules for how files are classified. By default, files larger
+// than 5MB and tarballs are uploaded to the lookaside cache.
 func (s *State) determineLookasideBlobs() error {
 	// Read all files in tempDir, except for the SPEC file
-	// For each file, if it is larger than 5MB, and is binary, then add it to
+	// For each file, record the classification and add lookaside files to
 	// the lookasideBlobs map.
-	// If the file is not binary, then skip it.
 	ls, err := os.ReadDir(s.tempDir)
 	if err != nil {
 		return errors.Wrap(err, "failed to read directory")
@@ -191,33 +335,29 @@ func (s *State) determineLookasideBlobs() error {
 			continue
 		}
 
-		// If file is larger than 5MB, then add it to the lookasideBlobs map.
 		info, err := f.Info()
 		if err != nil {
 			return errors.Wrap(err, "failed to get file info")
 		}
 
-		if info.Size() > 5*1024*1024 || strings.Contains(f.Name(), ".tar") {
-			sum, err := func() (string, error) {
-				hash := sha256.New()
-				file, err := os.Open(filepath.Join(s.tempDir, f.Name()))
-				if err != nil {
-					return "", errors.Wrap(err, "failed to open file")
-				}
-				defer file.Close()
-
-				_, err = io.Copy(hash, file)
-				if err != nil {
-					return "", errors.Wrap(err, "failed to copy file")
-				}
+		path := filepath.Join(s.tempDir, f.Name())
+		classification, err := s.lookasideRules.classify(s.lookasideOverrides, path, info.Size())
+		if err != nil {
+			return errors.Wrapf(err, "failed to classify %s", f.Name())
+		}
+		s.classifications[f.Name()] = classification
 
-				return hex.EncodeToString(hash.Sum(nil)), nil
-			}()
+		if classification.Lookaside {
+			blob, err := hashFile(path, func(name string) (io.ReadCloser, error) {
+				return os.Open(name)
+			})
 			if err != nil {
 				return err
 			}
 
-			s.lookasideBlobs[f.Name()] = sum
+			s.lookasideBlobs[f.Name()] = blob
+			classification.Sha256 = blob.sha256
+			classification.Sha512 = blob.sha512
 		}
 	}
 
@@ -227,81 +367,90 @@ func (s *State) determineLookasideBlobs() error {
 // uploadLookasideBlobs uploads all blobs in the lookasideBlobs map to the
 // lookaside cache.
 func (s *State) uploadLookasideBlobs(lookaside storage.Storage) error {
-	// The object name is the SHA256 hash of the file.
-	for path, hash := range s.lookasideBlobs {
-		// First check if they exist, since it's a waste of time to upload
-		// something that already exists.
-		// They are uploaded by hash, so if the hash already exists, then the
-		// file already exists.
-		exists, err := lookaside.Exists(hash)
-		if err != nil {
-			return errors.Wrap(err, "failed to check if blob exists")
-		}
+	// The object name is the hash of the file.
+	// Blobs are uploaded under both hashes, see lookasideBlob.objectNames.
+	for path, blob := range s.lookasideBlobs {
+		for _, hash := range blob.objectNames() {
+			// First check if they exist, since it's a waste of time to upload
+			// something that already exists.
+			// They are uploaded by hash, so if the hash already exists, then the
+			// file already exists.
+			exists, err := lookaside.Exists(hash)
+			if err != nil {
+				return errors.Wrap(err, "failed to check if blob exists")
+			}
 
-		if exists {
-			continue
-		}
+			if exists {
+				continue
+			}
 
-		_, err = lookaside.Put(hash, filepath.Join(s.tempDir, path))
-		if err != nil {
-			return errors.Wrap(err, "failed to upload file")
+			_, err = lookaside.Put(hash, filepath.Join(s.tempDir, path))
+			if err != nil {
+				return errors.Wrap(err, "failed to upload file")
+			}
 		}
 	}
 
 	return nil
 }
 
-// writeMetadata file writes the metadata map file.
-// The metadata file contains lines of the format:
-//
-//	<path to download> <blob hash>
-//
-// For example:
-//
-//	1234567890abcdef SOURCES/bar
+// writeMetadataFile writes the lookaside metadata files of the configured
+// formats, see MetadataFormat.
+// Each file in the metadata is also added to the gitignore file.
 func (s *State) writeMetadataFile(targetFS billy.Filesystem) error {
-	// Open metadata file for writing.
 	name, err := s.rpm.Header.GetStrings(rpmutils.NAME)
 	if err != nil {
 		return errors.Wrap(err, "failed to get RPM name")
 	}
 
-	metadataFile := fmt.Sprintf(".%s.metadata", name[0])
-
-	// Delete the file if it exists
-	_ = targetFS.Remove(metadataFile)
+	paths := make([]string, 0, len(s.lookasideBlobs))
+	for path := range s.lookasideBlobs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
 
-	f, err := targetFS.Create(metadataFile)
-	if err != nil {
-		return errors.Wrap(err, "failed to open metadata file")
+	if s.metadataFormat&MetadataFormatSrpmproc != 0 {
+		err = writeSrpmprocMetadata(targetFS, name[0], paths, s.lookasideBlobs)
+		if err != nil {
+			return err
+		}
 	}
-	defer f.Close()
 
-	// Write each line to the metadata file.
-	for path, hash := range s.lookasideBlobs {
-		// RPM sources MUST be in SOURCES/ directory
-		_, err = f.Write([]byte(hash + " " + filepath.Join("SOURCES", path) + "\n"))
+	if s.metadataFormat&MetadataFormatSources != 0 {
+		err = writeSourcesMetadata(targetFS, paths, s.lookasideBlobs)
 		if err != nil {
-			return errors.Wrap(err, "failed to write line to metadata file")
+			return err
 		}
 	}
 
 	// Each file in metadata needs to be added to gitignore
 	// Overwrite the gitignore file
 	gitignoreFile := ".gitignore"
-	f, err = targetFS.OpenFile(gitignoreFile, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	f, err := targetFS.OpenFile(gitignoreFile, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
 	if err != nil {
 		return errors.Wrap(err, "failed to open gitignore file")
 	}
+	defer f.Close()
 
 	// Write each line to the gitignore file.
-	for path, _ := range s.lookasideBlobs {
+	for _, path := range paths {
 		_, err = f.Write([]byte(filepath.Join("SOURCES", path) + "\n"))
 		if err != nil {
 			return errors.Wrap(err, "failed to write line to gitignore file")
 		}
 	}
 
+	// rpkg based tools download to the repository root by default, see
+	// MetadataFormatSources
+	if s.metadataFormat&MetadataFormatSources != 0 {
+		for _, path := range paths {
+			_, err = f.Write([]byte("/" + path + "\n"))
+			if err != nil {
+				return errors.Wrap(err, "failed to write line to gitignore file")
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -351,8 +500,12 @@ func (s *State) expandLayout(targetFS billy.Filesystem) error {
 // the RPM is a module component. Label format is MODULE_NAME:STREAM:VERSION:CONTEXT.
 // This function returns an empty string if the RPM is not a module component.
 func (s *State) getStreamSuffix() (string, error) {
+	return streamSuffix(s.rpm)
+}
+
+func streamSuffix(rpm *rpmutils.Rpm) (string, error) {
 	// Check the modularity label
-	label, err := s.rpm.Header.GetString(5096)
+	label, err := rpm.Header.GetString(5096)
 	if err != nil {
 		// If it's not present at all, it will fail with "No such entry 5096"
 		return "", nil
@@ -373,26 +526,28 @@ func (s *State) getStreamSuffix() (string, error) {
 	return fmt.Sprintf("-stream-%s", parts[1]), nil
 }
 
-// getRepo returns the target repository for the SRPM.
-// This is where the payload is uploaded to.
-func (s *State) getRepo(opts *git.CloneOptions, storer storage2.Storer, targetFS billy.Filesystem, osRelease string) (*git.Repository, string, error) {
+// Branch returns the branch an SRPM is imported to.
+// The branch is determined from the OS release, or from the dist tag of the
+// SRPM if the OS release is empty. Module components get a "-stream-X"
+// suffix.
+func Branch(rpm *rpmutils.Rpm, osRelease string, rolling bool) (string, error) {
 	// Determine branch
 	// If the OS release is not specified, then we use the dist tag
 	var branch string
 	if osRelease == "" {
 		// Determine dist tag
-		nevra, err := s.rpm.Header.GetNEVRA()
+		nevra, err := rpm.Header.GetNEVRA()
 		if err != nil {
-			return nil, "", errors.Wrap(err, "failed to get NEVRA")
+			return "", errors.Wrap(err, "failed to get NEVRA")
 		}
 
 		// The dist tag will be used as the branch
 		dist := elDistRegex.FindString(nevra.Release)
 		if dist == "" {
-			return nil, "", errors.Wrap(err, "failed to determine dist tag")
+			return "", errors.New("failed to determine dist tag")
 		}
 
-		if s.rolling {
+		if rolling {
 			branch = dist
 		} else {
 			branch = "el-" + dist[2:]
@@ -400,14 +555,14 @@ func (s *State) getRepo(opts *git.CloneOptions, storer storage2.Storer, targetFS
 	} else {
 		// Determine branch from OS release
 		if !releaseRegex.MatchString(osRelease) {
-			return nil, "", fmt.Errorf("invalid OS release %s", osRelease)
+			return "", fmt.Errorf("invalid OS release %s", osRelease)
 		}
 		ver := releaseRegex.FindStringSubmatch(osRelease)[1]
 
-		if s.rolling {
+		if rolling {
 			dist := elDistRegex.FindString("el" + ver)
 			if dist == "" {
-				return nil, "", errors.New("failed to determine dist tag")
+				return "", errors.New("failed to determine dist tag")
 			}
 			branch = dist
 		} else {
@@ -416,11 +571,22 @@ func (s *State) getRepo(opts *git.CloneOptions, storer storage2.Storer, targetFS
 	}
 
 	// Check if module component
-	streamSuffix, err := s.getStreamSuffix()
+	suffix, err := streamSuffix(rpm)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get stream suffix")
+	}
+	branch += suffix
+
+	return branch, nil
+}
+
+// getRepo returns the target repository for the SRPM.
+// This is where the payload is uploaded to.
+func (s *State) getRepo(opts *git.CloneOptions, storer storage2.Storer, targetFS billy.Filesystem, osRelease string) (*git.Repository, string, error) {
+	branch, err := Branch(s.rpm, osRelease, s.rolling)
 	if err != nil {
-		return nil, "", errors.Wrap(err, "failed to get stream suffix")
+		return nil, "", err
 	}
-	branch += streamSuffix
 
 	// Set branch to dist tag
 	opts.ReferenceName = plumbing.NewBranchReferenceName(branch)
@@ -459,11 +625,13 @@ func (s *State) getRepo(opts *git.CloneOptions, storer storage2.Storer, targetFS
 	}
 
 	// Fetch the remote
+	// Notes are fetched as well, so new notes are added to the existing ones
 	err = repo.Fetch(&git.FetchOptions{
 		Auth:       opts.Auth,
 		RemoteName: "origin",
 		RefSpecs: []config.RefSpec{
 			"refs/heads/*:refs/heads/*",
+			"+refs/notes/*:refs/notes/*",
 		},
 	})
 	if err != nil && errors.Is(err, git.NoErrAlreadyUpToDate) {
@@ -602,6 +770,12 @@ func (s *State) populateTargetRepo(repo *git.Repository, targetFS billy.Filesyst
 		return errors.Wrap(err, "failed to clean target repo")
 	}
 
+	// Read the per-package overrides, PATCHES is kept by the clean.
+	s.lookasideOverrides, err = readLookasideOverrides(targetFS)
+	if err != nil {
+		return err
+	}
+
 	// Determine which blobs need to be uploaded to the lookaside cache.
 	err = s.determineLookasideBlobs()
 	if err != nil {
@@ -643,18 +817,27 @@ func (s *State) populateTargetRepo(repo *git.Repository, targetFS billy.Filesyst
 		return errors.Wrap(err, "failed to get NEVRA")
 	}
 	importStr := fmt.Sprintf("import %s-%s-%s", nevra.Name, nevra.Version, nevra.Release)
-	hash, err := wt.Commit(importStr, &git.CommitOptions{
-		Author: &object.Signature{
-			Name:  s.authorName,
-			Email: s.authorEmail,
-			When:  time.Now(),
-		},
+	author := object.Signature{
+		Name:  s.authorName,
+		Email: s.authorEmail,
+		When:  time.Now(),
+	}
+	hash, err := signing.Commit(repo, s.commitMessage(importStr), &git.CommitOptions{
+		Author:            &author,
 		AllowEmptyCommits: true,
-	})
+	}, s.signer)
 	if err != nil {
 		return errors.Wrap(err, "failed to commit changes")
 	}
 
+	// Attach the provenance note
+	if s.provenance != nil && len(s.provenance.Note) > 0 {
+		err = addNote(repo, hash, s.provenance.Note, author)
+		if err != nil {
+			return errors.Wrap(err, "failed to add provenance note")
+		}
+	}
+
 	// Create a tag
 	// The tag should follow the following format:
 	//   imports/<branch>/<nvra>
@@ -668,28 +851,28 @@ func (s *State) populateTargetRepo(repo *git.Repository, targetFS billy.Filesyst
 
 	s.tag = tag
 
-	_, err = repo.CreateTag(tag, hash, &git.CreateTagOptions{
+	_, err = signing.CreateTag(repo, tag, hash, &git.CreateTagOptions{
 		Tagger: &object.Signature{
 			Name:  s.authorName,
 			Email: s.authorEmail,
 			When:  time.Now(),
 		},
 		Message: tag,
-	})
+	}, s.signer)
 	if err != nil {
 		if errors.Is(err, git.ErrTagExists) {
 			err = repo.DeleteTag(tag)
 			if err != nil {
 				return errors.Wrap(err, "failed to delete tag")
 			}
-			_, err = repo.CreateTag(tag, hash, &git.CreateTagOptions{
+			_, err = signing.CreateTag(repo, tag, hash, &git.CreateTagOptions{
 				Tagger: &object.Signature{
 					Name:  s.authorName,
 					Email: s.authorEmail,
 					When:  time.Now(),
 				},
 				Message: tag,
-			})
+			}, s.signer)
 		}
 		if err != nil {
 			return errors.Wrap(err, "failed to create tag")
@@ -793,26 +976,21 @@ func (s *State) patchTargetRepo(repo *git.Repository, lookaside storage.Storage)
 	// Add sources to ignore to lookasideBlobs
 	for _, source := range md.SourcesToIgnore {
 		// Get the hash of the source
-		hash, err := func() (string, error) {
-			hash := sha256.New()
-			file, err := wt.Filesystem.Open(source.Name)
-			if err != nil {
-				return "", errors.Wrap(err, "failed to open file")
-			}
-			defer file.Close()
-
-			_, err = io.Copy(hash, file)
-			if err != nil {
-				return "", errors.Wrap(err, "failed to copy file")
-			}
-
-			return hex.EncodeToString(hash.Sum(nil)), nil
-		}()
+		blob, err := hashFile(source.Name, func(name string) (io.ReadCloser, error) {
+			return wt.Filesystem.Open(name)
+		})
 		if err != nil {
 			return err
 		}
 
-		s.lookasideBlobs[source.Name] = hash
+		s.lookasideBlobs[source.Name] = blob
+		s.classifications[source.Name] = &LookasideClassification{
+			File:      source.Name,
+			Lookaside: true,
+			Rule:      "directive",
+			Sha256:    blob.sha256,
+			Sha512:    blob.sha512,
+		}
 	}
 
 	// Re-write the metadata file
@@ -824,6 +1002,19 @@ func (s *State) patchTargetRepo(repo *git.Repository, lookaside storage.Storage)
 	return nil
 }
 
+// sortedClassifications returns the classifications sorted by file name.
+func (s *State) sortedClassifications() []*LookasideClassification {
+	classifications := make([]*LookasideClassification, 0, len(s.classifications))
+	for _, c := range s.classifications {
+		classifications = append(classifications, c)
+	}
+	sort.Slice(classifications, func(i, j int) bool {
+		return classifications[i].File < classifications[j].File
+	})
+
+	return classifications
+}
+
 // Import imports the SRPM into the target repository.
 func (s *State) Import(opts *git.CloneOptions, storer storage2.Storer, targetFS billy.Filesystem, lookaside storage.Storage, osRelease string) (*ImportOutput, error) {
 	// Get the target repository.
@@ -838,19 +1029,6 @@ func (s *State) Import(opts *git.CloneOptions, storer storage2.Storer, targetFS
 		return nil, errors.Wrap(err, "failed to populate target repo")
 	}
 
-	// Push the target repository.
-	err = s.pushTargetRepo(repo, &git.PushOptions{
-		Force: true,
-		Auth:  opts.Auth,
-		RefSpecs: []config.RefSpec{
-			config.RefSpec(fmt.Sprintf("refs/heads/%s:refs/heads/%[1]s", branch)),
-			config.RefSpec(fmt.Sprintf("refs/tags/imports/%s/*:refs/tags/imports/%[1]s/*", branch)),
-		},
-	})
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to push target repo")
-	}
-
 	// Get latest commit
 	head, err := repo.Head()
 	if err != nil {
@@ -863,9 +1041,40 @@ func (s *State) Import(opts *git.CloneOptions, storer storage2.Storer, targetFS
 		return nil, errors.Wrap(err, "failed to get commit object")
 	}
 
+	// Push the target repository.
+	refSpecs := []config.RefSpec{
+		config.RefSpec(fmt.Sprintf("refs/heads/%s:refs/heads/%[1]s", branch)),
+		config.RefSpec(fmt.Sprintf("refs/tags/imports/%s/*:refs/tags/imports/%[1]s/*", branch)),
+	}
+	err = s.pushTargetRepo(repo, &git.PushOptions{
+		Force:    true,
+		Auth:     opts.Auth,
+		RefSpecs: refSpecs,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to push target repo")
+	}
+
+	// The notes are shared with imports of other branches, so they are
+	// pushed on their own, without force.
+	if s.provenance != nil && len(s.provenance.Note) > 0 {
+		err = pushNotes(repo, opts.Auth, commit.Hash, s.provenance.Note, commit.Author)
+		if err != nil {
+			return nil, err
+		}
+		refSpecs = append(refSpecs, config.RefSpec(fmt.Sprintf("%s:%[1]s", NotesRef)))
+	}
+
+	// Then push the same refs to the mirrors.
+	// A failing mirror doesn't fail the import, the target repository is
+	// authoritative and mirrors that fell behind are repaired later.
+	mirrors := forge.PushMirrors(repo, s.mirrors, s.mirrorRepo, refSpecs)
+
 	return &ImportOutput{
-		Commit: commit,
-		Branch: branch,
-		Tag:    s.tag,
+		Commit:          commit,
+		Branch:          branch,
+		Tag:             s.tag,
+		Classifications: s.sortedClassifications(),
+		Mirrors:         mirrors,
 	}, nil
 }
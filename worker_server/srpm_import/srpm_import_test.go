@@ -4,10 +4,17 @@
 package srpm_import
 
 import (
+	"bufio"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/pem"
 	"io"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,9 +26,13 @@ import (
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/storage/filesystem"
 	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/openela/mothership/base/forge"
+	local_forge "github.com/openela/mothership/base/forge/local"
+	"github.com/openela/mothership/base/signing"
 	storage_memory "github.com/openela/mothership/base/storage/memory"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/ssh"
 )
 
 func TestFromFile(t *testing.T) {
@@ -41,6 +52,7 @@ func TestFromFile_SignatureOK(t *testing.T) {
 	s, err := FromFile("testdata/efi-rpm-macros-3-3.el8.src.rpm", false, testKey...)
 	require.Nil(t, err)
 	require.NotNil(t, s)
+	require.Equal(t, "15af5dac6d745a60", s.KeyID())
 	require.Nil(t, s.Close())
 }
 
@@ -57,6 +69,54 @@ func TestFromFile_SignatureFail(t *testing.T) {
 	require.Equal(t, "failed to verify RPM: keyid 15af5dac6d745a60 not found", err.Error())
 }
 
+func TestFromReader_SignatureOK_NotSeekable(t *testing.T) {
+	keyF, err := os.Open("testdata/RPM-GPG-KEY-Rocky-8")
+	require.Nil(t, err)
+
+	testKey, err := openpgp.ReadArmoredKeyRing(keyF)
+	require.Nil(t, err)
+
+	f, err := os.Open("testdata/efi-rpm-macros-3-3.el8.src.rpm")
+	require.Nil(t, err)
+	defer f.Close()
+
+	// Hash while streaming, the stream must be read to the end
+	hash := sha256.New()
+	s, err := FromReader(io.TeeReader(bufio.NewReader(f), hash), false, testKey...)
+	require.Nil(t, err)
+	require.NotNil(t, s)
+	require.Nil(t, s.Close())
+
+	expected, err := os.ReadFile("testdata/efi-rpm-macros-3-3.el8.src.rpm")
+	require.Nil(t, err)
+	expectedHash := sha256.Sum256(expected)
+	require.Equal(t, expectedHash[:], hash.Sum(nil))
+}
+
+func TestFromReader_SignatureFail_Drained(t *testing.T) {
+	keyF, err := os.Open("testdata/RPM-GPG-KEY-Rocky-9")
+	require.Nil(t, err)
+
+	testKey, err := openpgp.ReadArmoredKeyRing(keyF)
+	require.Nil(t, err)
+
+	f, err := os.Open("testdata/efi-rpm-macros-3-3.el8.src.rpm")
+	require.Nil(t, err)
+	defer f.Close()
+
+	s, err := FromReader(bufio.NewReader(f), false, testKey...)
+	require.NotNil(t, err)
+	require.Nil(t, s)
+	require.Equal(t, "failed to verify RPM: keyid 15af5dac6d745a60 not found", err.Error())
+
+	// Everything has been read
+	pos, err := f.Seek(0, io.SeekCurrent)
+	require.Nil(t, err)
+	info, err := f.Stat()
+	require.Nil(t, err)
+	require.Equal(t, info.Size(), pos)
+}
+
 func TestDetermineLookasideBlobs_Empty(t *testing.T) {
 	s, err := FromFile("testdata/basesystem-11-5.el8.src.rpm", false)
 	require.Nil(t, err)
@@ -79,6 +139,63 @@ func TestDetermineLookasideBlobs_NotEmpty_Tarball(t *testing.T) {
 	require.Equal(t, 1, len(s.lookasideBlobs))
 }
 
+func TestDetermineLookasideBlobs_Classifications(t *testing.T) {
+	s, err := FromFile("testdata/efi-rpm-macros-3-3.el8.src.rpm", false)
+	require.Nil(t, err)
+	require.NotNil(t, s)
+	defer func() {
+		require.Nil(t, s.Close())
+	}()
+	require.Nil(t, s.determineLookasideBlobs())
+	require.Equal(t, 1, len(s.lookasideBlobs))
+
+	classifications := s.sortedClassifications()
+	require.Equal(t, 2, len(classifications))
+	require.Equal(t, &LookasideClassification{
+		File:      "0001-macros.efi-srpm-make-all-of-our-macros-always-expand.patch",
+		Lookaside: false,
+		Rule:      "default",
+	}, classifications[0])
+	require.Equal(t, &LookasideClassification{
+		File:      "efi-rpm-macros-3.tar.bz2",
+		Lookaside: true,
+		Rule:      "include *.tar*",
+		Sha256:    "f002f60baed7a47ca3e98b8dd7ece2f7352dac9ffab7ae3557eb56b481ce2f86",
+		Sha512:    "0fba0b2e9d08f4da28eb3305f82a02e5d1787800c9e5dee8e78add3572935f80bf823318495763b126e8d79c927913ae4e9087533011032cd13175ed09955ac6",
+	}, classifications[1])
+}
+
+func TestDetermineLookasideBlobs_Rules(t *testing.T) {
+	s, err := FromFile("testdata/efi-rpm-macros-3-3.el8.src.rpm", false)
+	require.Nil(t, err)
+	require.NotNil(t, s)
+	defer func() {
+		require.Nil(t, s.Close())
+	}()
+	s.SetLookasideRules(&LookasideRules{
+		Exclude:     []string{"*.tar.bz2"},
+		SniffBinary: true,
+	})
+	require.Nil(t, s.determineLookasideBlobs())
+	require.Equal(t, 0, len(s.lookasideBlobs))
+	require.Equal(t, "exclude *.tar.bz2", s.classifications["efi-rpm-macros-3.tar.bz2"].Rule)
+}
+
+func TestDetermineLookasideBlobs_Override(t *testing.T) {
+	s, err := FromFile("testdata/efi-rpm-macros-3-3.el8.src.rpm", false)
+	require.Nil(t, err)
+	require.NotNil(t, s)
+	defer func() {
+		require.Nil(t, s.Close())
+	}()
+
+	s.lookasideOverrides, err = parseLookasideOverrides(strings.NewReader("git efi-rpm-macros-3.tar.bz2\n"))
+	require.Nil(t, err)
+	require.Nil(t, s.determineLookasideBlobs())
+	require.Equal(t, 0, len(s.lookasideBlobs))
+	require.Equal(t, "override efi-rpm-macros-3.tar.bz2", s.classifications["efi-rpm-macros-3.tar.bz2"].Rule)
+}
+
 func TestUploadLookaside_Empty(t *testing.T) {
 	s, err := FromFile("testdata/basesystem-11-5.el8.src.rpm", false)
 	require.Nil(t, err)
@@ -297,6 +414,153 @@ func TestWriteMetadataExpandLayout(t *testing.T) {
 	require.Equal(t, "f002f60baed7a47ca3e98b8dd7ece2f7352dac9ffab7ae3557eb56b481ce2f86 SOURCES/efi-rpm-macros-3.tar.bz2\n", string(buf))
 }
 
+func TestWriteMetadataFile_Sources(t *testing.T) {
+	s, err := FromFile("testdata/efi-rpm-macros-3-3.el8.src.rpm", false)
+	require.Nil(t, err)
+	require.NotNil(t, s)
+	defer func() {
+		require.Nil(t, s.Close())
+	}()
+	s.SetMetadataFormat(MetadataFormatSources)
+
+	fs := memfs.New()
+	require.Nil(t, s.determineLookasideBlobs())
+	require.Nil(t, s.writeMetadataFile(fs))
+
+	fi, err := fs.ReadDir(".")
+	require.Nil(t, err)
+	require.Equal(t, 2, len(fi))
+	require.Equal(t, ".gitignore", fi[0].Name())
+	require.Equal(t, "sources", fi[1].Name())
+
+	f, err := fs.Open("sources")
+	require.Nil(t, err)
+
+	buf, err := io.ReadAll(f)
+	require.Nil(t, err)
+
+	require.Equal(t, "SHA512 (efi-rpm-macros-3.tar.bz2) = 0fba0b2e9d08f4da28eb3305f82a02e5d1787800c9e5dee8e78add3572935f80bf823318495763b126e8d79c927913ae4e9087533011032cd13175ed09955ac6\n", string(buf))
+}
+
+// rpkgSourcesLine is the line format rpkg, which centpkg and fedpkg are
+// based on, reads sources files with.
+var rpkgSourcesLine = regexp.MustCompile(`^(?P<hashtype>[^ ]+?) \((?P<file>[^ )]+?)\) = (?P<hash>[^ ]+?)$`)
+
+func TestWriteMetadataFile_SourcesCentpkg(t *testing.T) {
+	s, err := FromFile("testdata/efi-rpm-macros-3-3.el8.src.rpm", false)
+	require.Nil(t, err)
+	require.NotNil(t, s)
+	defer func() {
+		require.Nil(t, s.Close())
+	}()
+	s.SetMetadataFormat(MetadataFormatSources)
+
+	fs := memfs.New()
+	require.Nil(t, s.determineLookasideBlobs())
+	require.Nil(t, s.writeMetadataFile(fs))
+
+	f, err := fs.Open("sources")
+	require.Nil(t, err)
+	buf, err := io.ReadAll(f)
+	require.Nil(t, err)
+
+	// centpkg sources --outdir SOURCES downloads each file to where the
+	// spec expects it
+	lines := strings.Split(strings.TrimSuffix(string(buf), "\n"), "\n")
+	require.Equal(t, 1, len(lines))
+	match := rpkgSourcesLine.FindStringSubmatch(lines[0])
+	require.NotNil(t, match)
+	require.Equal(t, "SHA512", match[1])
+	file := match[2]
+	require.Contains(t, s.lookasideBlobs, file)
+	require.Equal(t, s.lookasideBlobs[file].sha512, match[3])
+
+	// The file is ignored wherever it's downloaded to
+	f, err = fs.Open(".gitignore")
+	require.Nil(t, err)
+	buf, err = io.ReadAll(f)
+	require.Nil(t, err)
+	require.Equal(t, filepath.Join("SOURCES", file)+"\n/"+file+"\n", string(buf))
+}
+
+func TestWriteMetadataFile_Both(t *testing.T) {
+	s, err := FromFile("testdata/efi-rpm-macros-3-3.el8.src.rpm", false)
+	require.Nil(t, err)
+	require.NotNil(t, s)
+	defer func() {
+		require.Nil(t, s.Close())
+	}()
+	s.SetMetadataFormat(MetadataFormatBoth)
+
+	fs := memfs.New()
+	require.Nil(t, s.determineLookasideBlobs())
+	require.Nil(t, s.writeMetadataFile(fs))
+
+	fi, err := fs.ReadDir(".")
+	require.Nil(t, err)
+	require.Equal(t, 3, len(fi))
+	require.Equal(t, ".efi-rpm-macros.metadata", fi[0].Name())
+	require.Equal(t, ".gitignore", fi[1].Name())
+	require.Equal(t, "sources", fi[2].Name())
+}
+
+func TestUploadLookaside_Sources(t *testing.T) {
+	s, err := FromFile("testdata/efi-rpm-macros-3-3.el8.src.rpm", false)
+	require.Nil(t, err)
+	require.NotNil(t, s)
+	defer func() {
+		require.Nil(t, s.Close())
+	}()
+	s.SetMetadataFormat(MetadataFormatSources)
+	require.Nil(t, s.determineLookasideBlobs())
+
+	fs := osfs.New("/")
+	lookaside := storage_memory.New(fs, t.TempDir())
+	require.Nil(t, s.uploadLookasideBlobs(lookaside))
+
+	ok, err := lookaside.Exists("0fba0b2e9d08f4da28eb3305f82a02e5d1787800c9e5dee8e78add3572935f80bf823318495763b126e8d79c927913ae4e9087533011032cd13175ed09955ac6")
+	require.Nil(t, err)
+	require.True(t, ok)
+
+	// The SHA-256 name is uploaded too, even if no metadata refers to it
+	ok, err = lookaside.Exists("f002f60baed7a47ca3e98b8dd7ece2f7352dac9ffab7ae3557eb56b481ce2f86")
+	require.Nil(t, err)
+	require.True(t, ok)
+}
+
+func TestWriteSourcesMetadata_RelativePath(t *testing.T) {
+	fs := memfs.New()
+	blobs := map[string]*lookasideBlob{
+		"a/data.tar.gz": {sha256: "a256", sha512: "a512"},
+		"b/data.tar.gz": {sha256: "b256", sha512: "b512"},
+	}
+	require.Nil(t, writeSourcesMetadata(fs, []string{"a/data.tar.gz", "b/data.tar.gz"}, blobs))
+
+	f, err := fs.Open("sources")
+	require.Nil(t, err)
+	buf, err := io.ReadAll(f)
+	require.Nil(t, err)
+
+	require.Equal(t, "SHA512 (a/data.tar.gz) = a512\nSHA512 (b/data.tar.gz) = b512\n", string(buf))
+}
+
+func TestParseMetadataFormat(t *testing.T) {
+	format, err := ParseMetadataFormat("srpmproc")
+	require.Nil(t, err)
+	require.Equal(t, MetadataFormatSrpmproc, format)
+
+	format, err = ParseMetadataFormat("sources")
+	require.Nil(t, err)
+	require.Equal(t, MetadataFormatSources, format)
+
+	format, err = ParseMetadataFormat("both")
+	require.Nil(t, err)
+	require.Equal(t, MetadataFormatBoth, format)
+
+	_, err = ParseMetadataFormat("lookaside")
+	require.NotNil(t, err)
+}
+
 func TestGetStreamSuffix(t *testing.T) {
 	s, err := FromFile("testdata/nginx-1.14.1-9.module+el8.4.0+542+81547229.src.rpm", false)
 	require.Nil(t, err)
@@ -315,6 +579,41 @@ func TestGetStreamSuffix_NotModuleComponent(t *testing.T) {
 	require.Equal(t, "", suffix)
 }
 
+func TestBranch(t *testing.T) {
+	s, err := FromFile("testdata/efi-rpm-macros-3-3.el8.src.rpm", false)
+	require.Nil(t, err)
+	defer func() {
+		require.Nil(t, s.Close())
+	}()
+
+	branch, err := Branch(s.rpm, "", false)
+	require.Nil(t, err)
+	require.Equal(t, "el-8", branch)
+
+	branch, err = Branch(s.rpm, "Rocky Linux release 8.8 (Green Obsidian)", false)
+	require.Nil(t, err)
+	require.Equal(t, "el-8.8", branch)
+
+	branch, err = Branch(s.rpm, "Rocky Linux release 8.8 (Green Obsidian)", true)
+	require.Nil(t, err)
+	require.Equal(t, "el8", branch)
+
+	_, err = Branch(s.rpm, "X invalid 1.1", false)
+	require.NotNil(t, err)
+}
+
+func TestBranch_ModuleComponent(t *testing.T) {
+	s, err := FromFile("testdata/nginx-1.14.1-9.module+el8.4.0+542+81547229.src.rpm", false)
+	require.Nil(t, err)
+	defer func() {
+		require.Nil(t, s.Close())
+	}()
+
+	branch, err := Branch(s.rpm, "Rocky Linux release 8.4 (Green Obsidian)", false)
+	require.Nil(t, err)
+	require.Equal(t, "el-8.4-stream-1.14", branch)
+}
+
 func TestGetRepo_New(t *testing.T) {
 	s, err := FromFile("testdata/efi-rpm-macros-3-3.el8.src.rpm", false)
 	require.Nil(t, err)
@@ -1262,6 +1561,93 @@ func TestImport1_New_Rolling(t *testing.T) {
 	require.True(t, ok)
 }
 
+func TestImport1_Mirrors(t *testing.T) {
+	s, err := FromFile("testdata/efi-rpm-macros-3-3.el8.src.rpm", false)
+	require.Nil(t, err)
+
+	tempDir := t.TempDir()
+	_, err = git.PlainInit(tempDir, true)
+	require.Nil(t, err)
+
+	upRoot := t.TempDir()
+	up, err := local_forge.New(upRoot, "Mship Bot", "mship@openela.org", "")
+	require.Nil(t, err)
+	// A file in place of the root makes every repository creation fail
+	downRoot := filepath.Join(t.TempDir(), "down")
+	require.Nil(t, os.WriteFile(downRoot, nil, 0644))
+	down, err := local_forge.New(downRoot, "Mship Bot", "mship@openela.org", "")
+	require.Nil(t, err)
+
+	s.SetMirrors("efi-rpm-macros", []*forge.Mirror{
+		{Name: "up", Forge: up},
+		{Name: "down", Forge: down},
+	})
+
+	opts := &git.CloneOptions{
+		URL: tempDir,
+	}
+	lookaside := storage_memory.New(osfs.New("/"))
+	out, err := s.Import(opts, memory.NewStorage(), memfs.New(), lookaside, "")
+	require.Nil(t, err)
+
+	// A failing mirror doesn't fail the import
+	require.Len(t, out.Mirrors, 2)
+	require.Equal(t, "up", out.Mirrors[0].Name)
+	require.Nil(t, out.Mirrors[0].Err)
+	require.Equal(t, "down", out.Mirrors[1].Name)
+	require.NotNil(t, out.Mirrors[1].Err)
+
+	// The mirror has the same branch and tag as the target repository
+	repo, err := git.PlainOpen(filepath.Join(upRoot, "efi-rpm-macros"))
+	require.Nil(t, err)
+	ref, err := repo.Reference("refs/heads/el-8", false)
+	require.Nil(t, err)
+	require.Equal(t, out.Commit.Hash, ref.Hash())
+	_, err = repo.Reference("refs/tags/imports/el-8/efi-rpm-macros-3-3.el8", false)
+	require.Nil(t, err)
+}
+
+func TestImport1_Signed(t *testing.T) {
+	s, err := FromFile("testdata/efi-rpm-macros-3-3.el8.src.rpm", false)
+	require.Nil(t, err)
+
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+	block, err := ssh.MarshalPrivateKey(priv, "")
+	require.Nil(t, err)
+	signer, err := signing.New(pem.EncodeToMemory(block), "", nil)
+	require.Nil(t, err)
+	s.SetSigner(signer)
+
+	tempDir := t.TempDir()
+	_, err = git.PlainInit(tempDir, true)
+	require.Nil(t, err)
+
+	opts := &git.CloneOptions{
+		URL: tempDir,
+	}
+	lookaside := storage_memory.New(osfs.New("/"))
+	out, err := s.Import(opts, memory.NewStorage(), memfs.New(), lookaside, "")
+	require.Nil(t, err)
+
+	// The pushed commit and tag are signed
+	repo, err := git.PlainOpen(tempDir)
+	require.Nil(t, err)
+	ref, err := repo.Reference("refs/heads/el-8", false)
+	require.Nil(t, err)
+	require.Equal(t, out.Commit.Hash, ref.Hash())
+	commit, err := repo.CommitObject(ref.Hash())
+	require.Nil(t, err)
+	require.True(t, strings.HasPrefix(commit.PGPSignature, "-----BEGIN SSH SIGNATURE-----"))
+
+	ref, err = repo.Reference("refs/tags/imports/el-8/efi-rpm-macros-3-3.el8", false)
+	require.Nil(t, err)
+	tag, err := repo.TagObject(ref.Hash())
+	require.Nil(t, err)
+	require.Equal(t, out.Commit.Hash, tag.Target)
+	require.True(t, strings.HasPrefix(tag.PGPSignature, "-----BEGIN SSH SIGNATURE-----"))
+}
+
 func TestImport2_New(t *testing.T) {
 	s, err := FromFile("testdata/bash-4.4.20-4.el8_6.src.rpm", false)
 	require.Nil(t, err)
@@ -0,0 +1,325 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"bytes"
+	"database/sql"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/openela/mothership/base/bugtracker"
+	mothership_db "github.com/openela/mothership/db"
+	mshipadminpb "github.com/openela/mothership/proto/admin/v1"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	defaultTicketTitle = `{{.Batch.WorkerID}}: {{.Batch.Name}}`
+	defaultTicketBody  = `Worker {{.Batch.WorkerID}} sealed {{.Batch.Name}}.
+
+{{if .Entries}}The following entries were in the batch:
+{{range .Entries}}- [{{if eq .State 2}}x{{else}} {{end}}] [{{.EntryID}}]({{$.PublicURI}}/{{.Name}}){{if .TicketURI}} ([ticket]({{.TicketURI}})){{end}}
+{{end}}{{else}}No entries were in batch. This is a test, please ignore.{{end}}
+`
+	defaultTicketCloseCondition = `{{.AllArchived}}`
+)
+
+var defaultTicketLabelRules = []*mshipadminpb.TicketTemplate_LabelRule{
+	{Label: "all-successful", Condition: `{{.AllArchived}}`},
+	{Label: "failed-entry", Condition: `{{not .AllArchived}}`},
+	{Label: "import-batch"},
+}
+
+var ticketTemplateFuncs = template.FuncMap{
+	"join": strings.Join,
+	// truncate shortens s to n bytes, useful for long error messages
+	"truncate": func(n int, s string) string {
+		if len(s) <= n {
+			return s
+		}
+		return s[:n] + "..."
+	},
+}
+
+// ticketEntry is an entry of a batch in ticket templates.
+// All fields of the entry are available, e.g. {{.EntryID}}, {{.NEVRA}},
+// {{.CommitURI}} and {{.ErrorMessage}}.
+type ticketEntry struct {
+	*mothership_db.Entry
+
+	// StateName is the name of the state of the entry, e.g. ARCHIVED
+	StateName string
+	// URI is the URI of the entry in the Mothership UI
+	URI string
+	// TicketURI is the URI of the ticket of the failed entry, empty if it
+	// has none
+	TicketURI string
+	// TimeInState is how long the entry has been in its state, rounded to the
+	// second. Templates print it like "1h2m3s".
+	TimeInState time.Duration
+}
+
+// ticketData is the data ticket templates are rendered with.
+type ticketData struct {
+	Batch     *mothership_db.Batch
+	Entries   []*ticketEntry
+	PublicURI string
+	// AllArchived is true if all entries are archived
+	AllArchived bool
+	// StateCounts is the number of entries per state name
+	StateCounts map[string]int
+}
+
+func newTicketData(batch *mothership_db.Batch, entries []*mothership_db.Entry, publicURI string, now time.Time) *ticketData {
+	data := &ticketData{
+		Batch:       batch,
+		PublicURI:   publicURI,
+		AllArchived: true,
+		StateCounts: map[string]int{},
+	}
+	for _, entry := range entries {
+		stateName := entry.State.String()
+		data.Entries = append(data.Entries, &ticketEntry{
+			Entry:       entry,
+			StateName:   stateName,
+			URI:         publicURI + "/" + entry.Name,
+			TicketURI:   entry.BugtrackerURI.String,
+			TimeInState: now.Sub(entry.StateChangeTime).Round(time.Second),
+		})
+		data.StateCounts[stateName]++
+		if entry.State != mothershippb.Entry_ARCHIVED {
+			data.AllArchived = false
+		}
+	}
+
+	return data
+}
+
+// sampleTicketData returns data that exercises ticket templates, to validate
+// them before they are used.
+func sampleTicketData() *ticketData {
+	now := time.Now()
+	batch := &mothership_db.Batch{
+		Name:       "batches/123",
+		BatchID:    sql.NullString{Valid: true, String: "batch-id"},
+		WorkerID:   "test-worker",
+		CreateTime: now,
+		UpdateTime: now,
+		SealTime:   sql.NullTime{Valid: true, Time: now},
+	}
+	entries := []*mothership_db.Entry{
+		{
+			Name:            "entries/1",
+			EntryID:         "bash-5.1.8-6.el9.src",
+			NEVRA:           "bash-0:5.1.8-6.el9.src",
+			OSRelease:       "Rocky Linux release 9.2 (Blue Onyx)",
+			BatchName:       sql.NullString{Valid: true, String: batch.Name},
+			CommitURI:       "https://github.com/openela-main/bash/commit/123",
+			State:           mothershippb.Entry_ARCHIVED,
+			PackageName:     "bash",
+			StateChangeTime: now.Add(-time.Hour),
+		},
+		{
+			Name:            "entries/2",
+			EntryID:         "zsh-5.8-9.el9.src",
+			NEVRA:           "zsh-0:5.8-9.el9.src",
+			OSRelease:       "Rocky Linux release 9.2 (Blue Onyx)",
+			BatchName:       sql.NullString{Valid: true, String: batch.Name},
+			State:           mothershippb.Entry_ON_HOLD,
+			ErrorMessage:    "failed to import SRPM: patch failed",
+			StateChangeTime: now.Add(-time.Minute),
+		},
+	}
+
+	return newTicketData(batch, entries, "https://mship.example.com", now)
+}
+
+type ticketLabelRule struct {
+	label     string
+	condition *template.Template
+}
+
+// TicketTemplate is a parsed template of the tickets of import batches.
+type TicketTemplate struct {
+	title          *template.Template
+	body           *template.Template
+	labelRules     []ticketLabelRule
+	closeCondition *template.Template
+}
+
+// ticketInfo is a rendered ticket.
+type ticketInfo struct {
+	Title   string
+	Body    string
+	Options bugtracker.Options
+	// Close is true if the ticket should be closed
+	Close bool
+}
+
+func parseTicketTemplate(name string, text string, defaultText string) (*template.Template, error) {
+	if text == "" {
+		text = defaultText
+	}
+
+	t, err := template.New(name).Funcs(ticketTemplateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s template", name)
+	}
+
+	return t, nil
+}
+
+// NewTicketTemplate parses a ticket template, and validates it by rendering
+// it with sample data.
+// Empty fields use the default template, a nil template is the default
+// template.
+func NewTicketTemplate(pb *mshipadminpb.TicketTemplate) (*TicketTemplate, error) {
+	if pb == nil {
+		pb = &mshipadminpb.TicketTemplate{}
+	}
+
+	var t TicketTemplate
+	var err error
+	t.title, err = parseTicketTemplate("title", pb.Title, defaultTicketTitle)
+	if err != nil {
+		return nil, err
+	}
+	t.body, err = parseTicketTemplate("body", pb.Body, defaultTicketBody)
+	if err != nil {
+		return nil, err
+	}
+	t.closeCondition, err = parseTicketTemplate("close_condition", pb.CloseCondition, defaultTicketCloseCondition)
+	if err != nil {
+		return nil, err
+	}
+
+	labelRules := pb.LabelRules
+	if len(labelRules) == 0 {
+		labelRules = defaultTicketLabelRules
+	}
+	for _, rule := range labelRules {
+		if rule.Label == "" {
+			return nil, errors.New("label rules must have a label")
+		}
+
+		condition, err := parseTicketTemplate("label "+rule.Label, rule.Condition, "true")
+		if err != nil {
+			return nil, err
+		}
+		t.labelRules = append(t.labelRules, ticketLabelRule{
+			label:     rule.Label,
+			condition: condition,
+		})
+	}
+
+	// Most template errors only surface when rendering
+	_, err = t.render(sampleTicketData())
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid ticket template")
+	}
+
+	return &t, nil
+}
+
+// LoadTicketTemplate reads a ticket template from a JSON file, in the JSON
+// format of the TicketTemplate message.
+func LoadTicketTemplate(path string) (*TicketTemplate, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read ticket template")
+	}
+
+	var pb mshipadminpb.TicketTemplate
+	err = protojson.Unmarshal(content, &pb)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse ticket template")
+	}
+
+	return NewTicketTemplate(&pb)
+}
+
+func executeTicketTemplate(t *template.Template, data *ticketData) (string, error) {
+	var buf bytes.Buffer
+	err := t.Execute(&buf, data)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to execute %s template", t.Name())
+	}
+
+	return buf.String(), nil
+}
+
+func executeTicketCondition(t *template.Template, data *ticketData) (bool, error) {
+	value, err := executeTicketTemplate(t, data)
+	if err != nil {
+		return false, err
+	}
+
+	condition, err := strconv.ParseBool(strings.TrimSpace(value))
+	if err != nil {
+		return false, errors.Errorf("%s template must render true or false, got %q", t.Name(), value)
+	}
+
+	return condition, nil
+}
+
+// render renders the ticket of data.
+func (t *TicketTemplate) render(data *ticketData) (*ticketInfo, error) {
+	title, err := executeTicketTemplate(t.title, data)
+	if err != nil {
+		return nil, err
+	}
+	body, err := executeTicketTemplate(t.body, data)
+	if err != nil {
+		return nil, err
+	}
+	closeTicket, err := executeTicketCondition(t.closeCondition, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var labels []string
+	for _, rule := range t.labelRules {
+		ok, err := executeTicketCondition(rule.condition, data)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			labels = append(labels, rule.label)
+		}
+	}
+
+	return &ticketInfo{
+		Title: strings.TrimSpace(title),
+		Body:  body,
+		Options: bugtracker.Options{
+			Labels: labels,
+		},
+		Close: closeTicket,
+	}, nil
+}
+
+// getTicketTemplate returns the ticket template of the bug tracker config
+// named configName. Without config or without template in the config, the
+// template of the worker flags is used, or the default template.
+func (w *Worker) getTicketTemplate(configName sql.NullString) (*TicketTemplate, error) {
+	config, err := w.bugtrackerConfig(configName)
+	if err != nil {
+		return nil, err
+	}
+	if config.GetTicketTemplate() != nil {
+		return NewTicketTemplate(config.TicketTemplate)
+	}
+
+	if w.ticketTemplate != nil {
+		return w.ticketTemplate, nil
+	}
+
+	return NewTicketTemplate(nil)
+}
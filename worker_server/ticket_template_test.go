@@ -0,0 +1,149 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	mothership_db "github.com/openela/mothership/db"
+	mshipadminpb "github.com/openela/mothership/proto/admin/v1"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func testTicketData(states ...mothershippb.Entry_State) *ticketData {
+	now := time.Now()
+	batch := &mothership_db.Batch{
+		Name:     "batches/123",
+		WorkerID: "test-worker",
+	}
+	var entries []*mothership_db.Entry
+	for _, state := range states {
+		entries = append(entries, &mothership_db.Entry{
+			Name:            "entries/" + state.String(),
+			EntryID:         "basesystem-11-13.el9.src",
+			NEVRA:           "basesystem-0:11-13.el9.src",
+			State:           state,
+			ErrorMessage:    "failed to import",
+			StateChangeTime: now.Add(-90 * time.Minute),
+		})
+	}
+
+	return newTicketData(batch, entries, "https://mship.example.com", now)
+}
+
+func TestTicketTemplate_Default(t *testing.T) {
+	tmpl, err := NewTicketTemplate(nil)
+	require.Nil(t, err)
+
+	info, err := tmpl.render(testTicketData(mothershippb.Entry_ARCHIVED))
+	require.Nil(t, err)
+	require.Equal(t, "test-worker: batches/123", info.Title)
+	require.Equal(t, `Worker test-worker sealed batches/123.
+
+The following entries were in the batch:
+- [x] [basesystem-11-13.el9.src](https://mship.example.com/entries/ARCHIVED)
+
+`, info.Body)
+	require.Equal(t, []string{"all-successful", "import-batch"}, info.Options.Labels)
+	require.True(t, info.Close)
+
+	info, err = tmpl.render(testTicketData(mothershippb.Entry_ARCHIVED, mothershippb.Entry_ON_HOLD))
+	require.Nil(t, err)
+	require.Contains(t, info.Body, "- [ ] [basesystem-11-13.el9.src](https://mship.example.com/entries/ON_HOLD)")
+	require.Equal(t, []string{"failed-entry", "import-batch"}, info.Options.Labels)
+	require.False(t, info.Close)
+
+	// Tickets of failed entries are linked
+	data := testTicketData(mothershippb.Entry_FAILED)
+	data.Entries[0].TicketURI = "https://bugs.example.com/view.php?id=42"
+	info, err = tmpl.render(data)
+	require.Nil(t, err)
+	require.Contains(t, info.Body, "- [ ] [basesystem-11-13.el9.src](https://mship.example.com/entries/FAILED) ([ticket](https://bugs.example.com/view.php?id=42))")
+}
+
+func TestTicketTemplate_Custom(t *testing.T) {
+	tmpl, err := NewTicketTemplate(&mshipadminpb.TicketTemplate{
+		Title: `[{{.Batch.WorkerID}}] {{len .Entries}} packages`,
+		Body:  `{{range .Entries}}{{.NEVRA}} {{.StateName}} {{.TimeInState}}{{if .ErrorMessage}}: {{truncate 6 .ErrorMessage}}{{end}}{{"\n"}}{{end}}`,
+		LabelRules: []*mshipadminpb.TicketTemplate_LabelRule{
+			{Label: "import"},
+			{Label: "needs-attention", Condition: `{{gt (index .StateCounts "ON_HOLD") 0}}`},
+		},
+		CloseCondition: `{{eq (index .StateCounts "ON_HOLD") 0}}`,
+	})
+	require.Nil(t, err)
+
+	info, err := tmpl.render(testTicketData(mothershippb.Entry_ON_HOLD, mothershippb.Entry_FAILED))
+	require.Nil(t, err)
+	require.Equal(t, "[test-worker] 2 packages", info.Title)
+	require.Equal(t, "basesystem-0:11-13.el9.src ON_HOLD 1h30m0s: failed...\nbasesystem-0:11-13.el9.src FAILED 1h30m0s: failed...\n", info.Body)
+	require.Equal(t, []string{"import", "needs-attention"}, info.Options.Labels)
+	require.False(t, info.Close)
+
+	// Only the title is customized, the rest uses the default
+	tmpl, err = NewTicketTemplate(&mshipadminpb.TicketTemplate{
+		Title: `{{.Batch.Name}}`,
+	})
+	require.Nil(t, err)
+
+	info, err = tmpl.render(testTicketData(mothershippb.Entry_FAILED))
+	require.Nil(t, err)
+	require.Equal(t, "batches/123", info.Title)
+	require.Contains(t, info.Body, "Worker test-worker sealed batches/123.")
+	require.Equal(t, []string{"failed-entry", "import-batch"}, info.Options.Labels)
+	require.False(t, info.Close)
+}
+
+func TestTicketTemplate_Invalid(t *testing.T) {
+	// Syntax error
+	_, err := NewTicketTemplate(&mshipadminpb.TicketTemplate{
+		Title: `{{.Batch.Name`,
+	})
+	require.NotNil(t, err)
+
+	// Unknown field
+	_, err = NewTicketTemplate(&mshipadminpb.TicketTemplate{
+		Body: `{{.Batch.Owner}}`,
+	})
+	require.NotNil(t, err)
+
+	// Conditions must be booleans
+	_, err = NewTicketTemplate(&mshipadminpb.TicketTemplate{
+		CloseCondition: `{{len .Entries}}`,
+	})
+	require.NotNil(t, err)
+
+	// Label rules must have a label
+	_, err = NewTicketTemplate(&mshipadminpb.TicketTemplate{
+		LabelRules: []*mshipadminpb.TicketTemplate_LabelRule{
+			{Condition: `true`},
+		},
+	})
+	require.NotNil(t, err)
+}
+
+func TestLoadTicketTemplate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "template.json")
+	require.Nil(t, os.WriteFile(path, []byte(`{
+  "title": "{{.Batch.Name}}",
+  "labelRules": [{"label": "import"}],
+  "closeCondition": "false"
+}`), 0644))
+
+	tmpl, err := LoadTicketTemplate(path)
+	require.Nil(t, err)
+
+	info, err := tmpl.render(testTicketData(mothershippb.Entry_ARCHIVED))
+	require.Nil(t, err)
+	require.Equal(t, "batches/123", info.Title)
+	require.Equal(t, []string{"import"}, info.Options.Labels)
+	require.False(t, info.Close)
+
+	_, err = LoadTicketTemplate(filepath.Join(t.TempDir(), "missing.json"))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,213 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/openela/mothership/base"
+	"github.com/openela/mothership/base/tlog"
+	mothership_db "github.com/openela/mothership/db"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/pkg/errors"
+)
+
+// defaultLogOrigin identifies the transparency log if the worker server has
+// no public URI.
+const defaultLogOrigin = "mothership"
+
+// logLeafData is the data of a transparency log leaf.
+type logLeafData struct {
+	Entry        string    `json:"entry"`
+	EntryID      string    `json:"entryId"`
+	State        string    `json:"state"`
+	Sha256Sum    string    `json:"sha256Sum"`
+	Repository   string    `json:"repository"`
+	Pkg          string    `json:"pkg"`
+	CommitHash   string    `json:"commitHash"`
+	CommitBranch string    `json:"commitBranch"`
+	CommitTag    string    `json:"commitTag"`
+	Time         time.Time `json:"time"`
+}
+
+// isLoggedState returns true if transitions to state are logged.
+func isLoggedState(state mothershippb.Entry_State) bool {
+	return state == mothershippb.Entry_ARCHIVED || state == mothershippb.Entry_RETRACTED
+}
+
+// logOrigin returns the origin line of checkpoints, which identifies the log.
+func (w *Worker) logOrigin() string {
+	u, err := url.Parse(w.publicURI)
+	if err != nil || u.Host == "" {
+		return defaultLogOrigin
+	}
+
+	return u.Host + strings.TrimSuffix(u.Path, "/")
+}
+
+// latestLogLeaf returns the leaf with the highest index of the entries
+// filtered with keyval, or nil.
+func (w *Worker) latestLogLeaf(keyval ...any) (*mothership_db.LogLeaf, error) {
+	leaves, err := base.Q[mothership_db.LogLeaf](w.db).F(keyval...).OrderBy("-leaf_index").Limit(1).All()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get log leaf")
+	}
+	if len(leaves) == 0 {
+		return nil, nil
+	}
+
+	return leaves[0], nil
+}
+
+// appendLog appends the state of an entry to the transparency log and
+// creates the checkpoint of the new tree.
+// Nothing is appended if the latest leaf of the entry already has the state,
+// since SetEntryState is retried, and a failed retraction reverts the entry
+// to ARCHIVED.
+// A concurrent append fails on the unique leaf index, the activity is then
+// retried against the new tree.
+// Checkpoints must be signed, so nothing is logged if the worker server has
+// no signing key.
+func (w *Worker) appendLog(ent *mothership_db.Entry) error {
+	if w.signer == nil {
+		slog.Info("no signing key, not logging entry", "entry", ent.Name)
+		return nil
+	}
+
+	entryLeaf, err := w.latestLogLeaf("entry_name", ent.Name)
+	if err != nil {
+		return err
+	}
+	lastLeaf, err := w.latestLogLeaf()
+	if err != nil {
+		return err
+	}
+	size := int64(0)
+	if lastLeaf != nil {
+		size = lastLeaf.LeafIndex + 1
+	}
+
+	if entryLeaf == nil || entryLeaf.State != ent.State {
+		data, err := json.Marshal(&logLeafData{
+			Entry:        ent.Name,
+			EntryID:      ent.EntryID,
+			State:        ent.State.String(),
+			Sha256Sum:    ent.Sha256Sum,
+			Repository:   ent.RepositoryName,
+			Pkg:          ent.PackageName,
+			CommitHash:   ent.CommitHash,
+			CommitBranch: ent.CommitBranch,
+			CommitTag:    ent.CommitTag,
+			Time:         time.Now().UTC(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal log leaf")
+		}
+
+		lastLeaf = &mothership_db.LogLeaf{
+			Name:      mothership_db.LogLeafName(size),
+			LeafIndex: size,
+			EntryName: ent.Name,
+			State:     ent.State,
+			Data:      string(data),
+			LeafHash:  hex.EncodeToString(tlog.LeafHash(data)),
+		}
+		err = base.Q[mothership_db.LogLeaf](w.db).Create(lastLeaf)
+		if err != nil {
+			return errors.Wrap(err, "failed to append log leaf")
+		}
+		size++
+	}
+
+	if lastLeaf != nil {
+		if err := w.ensureLogNodes(lastLeaf); err != nil {
+			return err
+		}
+	}
+
+	return w.ensureLogCheckpoint(size)
+}
+
+// ensureLogNodes creates the nodes that leaf completes, if they don't exist
+// yet. They are missing if the append of the leaf was interrupted.
+func (w *Worker) ensureLogNodes(leaf *mothership_db.LogLeaf) error {
+	leafHash, err := hex.DecodeString(leaf.LeafHash)
+	if err != nil {
+		return errors.Wrapf(err, "invalid hash of leaf %d", leaf.LeafIndex)
+	}
+
+	ids, hashes, err := tlog.AppendNodes(&mothership_db.LogHashReader{DB: w.db}, leaf.LeafIndex, leafHash)
+	if err != nil {
+		return errors.Wrap(err, "failed to compute log nodes")
+	}
+
+	for i, id := range ids {
+		name := mothership_db.LogNodeName(id.Level, id.Index)
+		existing, err := base.Q[mothership_db.LogNode](w.db).F("name", name).GetOrNil()
+		if err != nil {
+			return errors.Wrap(err, "failed to get log node")
+		}
+		if existing != nil {
+			continue
+		}
+
+		err = base.Q[mothership_db.LogNode](w.db).Create(&mothership_db.LogNode{
+			Name:      name,
+			Level:     id.Level,
+			NodeIndex: id.Index,
+			Hash:      hex.EncodeToString(hashes[i]),
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create log node")
+		}
+	}
+
+	return nil
+}
+
+// ensureLogCheckpoint creates the signed checkpoint of the tree of size, if
+// it doesn't exist yet.
+func (w *Worker) ensureLogCheckpoint(size int64) error {
+	if w.signer == nil {
+		return errors.New("log checkpoints can't be created without a signing key")
+	}
+
+	name := mothership_db.LogCheckpointName(size)
+	existing, err := base.Q[mothership_db.LogCheckpoint](w.db).F("name", name).GetOrNil()
+	if err != nil {
+		return errors.Wrap(err, "failed to get log checkpoint")
+	}
+	if existing != nil {
+		return nil
+	}
+
+	root, err := tlog.TreeHash(&mothership_db.LogHashReader{DB: w.db}, size)
+	if err != nil {
+		return errors.Wrap(err, "failed to compute log root hash")
+	}
+
+	checkpoint := &mothership_db.LogCheckpoint{
+		Name:       name,
+		TreeSize:   size,
+		RootHash:   hex.EncodeToString(root),
+		Checkpoint: tlog.Checkpoint(w.logOrigin(), size, root),
+		KeyID:      w.signer.Key().ID,
+	}
+	checkpoint.Signature, err = w.signer.Sign(strings.NewReader(checkpoint.Checkpoint))
+	if err != nil {
+		return errors.Wrap(err, "failed to sign log checkpoint")
+	}
+
+	err = base.Q[mothership_db.LogCheckpoint](w.db).Create(checkpoint)
+	if err != nil {
+		return errors.Wrap(err, "failed to create log checkpoint")
+	}
+
+	return nil
+}
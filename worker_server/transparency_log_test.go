@@ -0,0 +1,32 @@
+// Copyright 2024 The Mothership Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package mothership_worker_server
+
+import (
+	"testing"
+
+	mothership_db "github.com/openela/mothership/db"
+	mothershippb "github.com/openela/mothership/proto/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogOrigin(t *testing.T) {
+	require.Equal(t, defaultLogOrigin, (&Worker{}).logOrigin())
+	require.Equal(t, "mship.example.com", (&Worker{publicURI: "https://mship.example.com/"}).logOrigin())
+	require.Equal(t, "example.com/mship", (&Worker{publicURI: "https://example.com/mship/"}).logOrigin())
+}
+
+func TestIsLoggedState(t *testing.T) {
+	require.True(t, isLoggedState(mothershippb.Entry_ARCHIVED))
+	require.True(t, isLoggedState(mothershippb.Entry_RETRACTED))
+	require.False(t, isLoggedState(mothershippb.Entry_ON_HOLD))
+	require.False(t, isLoggedState(mothershippb.Entry_FAILED))
+}
+
+func TestAppendLog_NoSigner(t *testing.T) {
+	// Nothing is logged, checkpoints are never unsigned
+	w := &Worker{}
+	require.Nil(t, w.appendLog(&mothership_db.Entry{Name: "entries/1", State: mothershippb.Entry_ARCHIVED}))
+	require.Error(t, w.ensureLogCheckpoint(1))
+}
@@ -4,32 +4,102 @@
 package mothership_worker_server
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"github.com/pkg/errors"
 	"go.temporal.io/sdk/temporal"
-	"net/url"
-	"strings"
+	"hash"
+	"io"
+	"os"
 )
 
-func getObjectPath(uri string) (string, error) {
-	// Get object name from URI.
-	// Check if object exists.
-	// If not, return error.
-	parsed, err := url.Parse(uri)
+// checksumReader hashes a resource while it is being streamed.
+type checksumReader struct {
+	r    io.Reader
+	hash hash.Hash
+	n    int64
+	size int64
+}
+
+func newChecksumReader(r io.Reader, size int64) *checksumReader {
+	return &checksumReader{
+		r:    r,
+		hash: sha256.New(),
+		size: size,
+	}
+}
+
+func (c *checksumReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	_, _ = c.hash.Write(p[:n])
+	return n, err
+}
+
+// verify reads the rest of the resource and compares the checksum.
+// A short read is retryable, while a checksum mismatch is not.
+func (c *checksumReader) verify(checksumSha256 string) error {
+	_, err := io.Copy(io.Discard, c)
 	if err != nil {
-		return "", temporal.NewNonRetryableApplicationError(
-			"could not parse resource URI",
-			"couldNotParseResourceURI",
-			errors.Wrap(err, "failed to parse resource URI"),
+		return errors.Wrap(err, "failed to read resource")
+	}
+
+	if c.n != c.size {
+		return errors.Errorf("resource was truncated, read %d of %d bytes", c.n, c.size)
+	}
+
+	if hex.EncodeToString(c.hash.Sum(nil)) != checksumSha256 {
+		return temporal.NewNonRetryableApplicationError(
+			"checksum does not match",
+			"checksumDoesNotMatch",
+			errors.New("client submitted a checksum that does not match the resource"),
 		)
 	}
 
-	// S3 for example must include bucket, while memory:// does not.
-	// So memory://test.rpm would be parsed as host=test.rpm, path="".
-	// While s3://mship/test.rpm would be parsed as host=mship, path=test.rpm.
-	object := strings.TrimPrefix(parsed.Path, "/")
-	if object == "" {
-		object = parsed.Host
+	return nil
+}
+
+// verifiedFile is a temporary copy of a resource that matched its checksum.
+// The copy is removed when the file is closed.
+type verifiedFile struct {
+	*os.File
+}
+
+func (v *verifiedFile) Close() error {
+	err := v.File.Close()
+	_ = os.Remove(v.Name())
+	return err
+}
+
+// openVerified copies object to a temporary file while hashing it, and only
+// returns the copy if it matches the checksum.
+// Resources are parsed from the copy, so nothing from a resource the client
+// didn't submit is ever parsed or extracted.
+func (w *Worker) openVerified(object string, checksumSha256 string) (*verifiedFile, error) {
+	r, size, err := w.storage.Open(object)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open resource")
+	}
+	defer r.Close()
+
+	f, err := os.CreateTemp("", "mship-resource-*")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create temporary file")
+	}
+	vf := &verifiedFile{f}
+
+	cr := newChecksumReader(io.TeeReader(r, f), size)
+	err = cr.verify(checksumSha256)
+	if err != nil {
+		_ = vf.Close()
+		return nil, err
+	}
+
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		_ = vf.Close()
+		return nil, errors.Wrap(err, "failed to seek temporary file")
 	}
 
-	return object, nil
+	return vf, nil
 }
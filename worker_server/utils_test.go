@@ -4,23 +4,61 @@
 package mothership_worker_server
 
 import (
+	"github.com/go-git/go-billy/v5/memfs"
+	storage_memory "github.com/openela/mothership/base/storage/memory"
 	"github.com/stretchr/testify/require"
+	"io"
+	"os"
+	"strings"
 	"testing"
 )
 
-func TestGetObjectPath_Path_S3(t *testing.T) {
-	object, err := getObjectPath("s3://mship/test.rpm")
+func TestChecksumReader_Verify(t *testing.T) {
+	// sha256 of "hello"
+	cr := newChecksumReader(strings.NewReader("hello"), 5)
+	err := cr.verify("2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824")
 	require.Nil(t, err)
-	require.Equal(t, "test.rpm", object)
 }
 
-func TestGetObjectPath_Host_Memory(t *testing.T) {
-	object, err := getObjectPath("memory://test.rpm")
-	require.Nil(t, err)
-	require.Equal(t, "test.rpm", object)
+func TestChecksumReader_Verify_Mismatch(t *testing.T) {
+	cr := newChecksumReader(strings.NewReader("hello"), 5)
+	err := cr.verify("0000")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "checksum does not match")
 }
 
-func TestGetObjectPath_InvalidURI(t *testing.T) {
-	_, err := getObjectPath("test://test:test/")
+func TestChecksumReader_Verify_Truncated(t *testing.T) {
+	cr := newChecksumReader(strings.NewReader("hel"), 5)
+	err := cr.verify("2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824")
 	require.NotNil(t, err)
+	require.Equal(t, "resource was truncated, read 3 of 5 bytes", err.Error())
+}
+
+func TestOpenVerified(t *testing.T) {
+	st := storage_memory.New(memfs.New())
+	_, err := st.PutBytes("resource", []byte("hello"))
+	require.Nil(t, err)
+	w := &Worker{storage: st}
+
+	f, err := w.openVerified("resource", "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824")
+	require.Nil(t, err)
+	data, err := io.ReadAll(f)
+	require.Nil(t, err)
+	require.Equal(t, "hello", string(data))
+
+	// The copy is removed on close
+	require.Nil(t, f.Close())
+	_, err = os.Stat(f.Name())
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestOpenVerified_Mismatch(t *testing.T) {
+	st := storage_memory.New(memfs.New())
+	_, err := st.PutBytes("resource", []byte("hello"))
+	require.Nil(t, err)
+	w := &Worker{storage: st}
+
+	f, err := w.openVerified("resource", "0000")
+	require.Nil(t, f)
+	require.Contains(t, err.Error(), "checksum does not match")
 }
@@ -7,7 +7,9 @@ import (
 	"github.com/openela/mothership/base"
 	"github.com/openela/mothership/base/bugtracker"
 	"github.com/openela/mothership/base/forge"
+	"github.com/openela/mothership/base/signing"
 	"github.com/openela/mothership/base/storage"
+	"github.com/openela/mothership/worker_server/srpm_import"
 	"golang.org/x/crypto/openpgp"
 )
 
@@ -19,18 +21,65 @@ type Worker struct {
 	bugtracker bugtracker.Bugtracker
 	rolling    bool
 	publicURI  string
+	// metadataFormat is the lookaside metadata written to imported
+	// repositories, zero keeps the srpm_import default
+	metadataFormat srpm_import.MetadataFormat
+	// lookasideRules decides which files are stored in the lookaside,
+	// nil uses the srpm_import defaults
+	lookasideRules *srpm_import.LookasideRules
+	// signer signs import and retraction commits and tags, nil leaves
+	// them unsigned
+	signer signing.Signer
+	// ticketTemplate renders batch tickets when the bug tracker config has
+	// no template, nil uses the default template
+	ticketTemplate *TicketTemplate
+	// entryTickets creates a ticket for every failed entry in the bug
+	// tracker of the worker flags
+	entryTickets bool
+}
+
+// Options configures a Worker.
+// Only DB, Storage and Forge are required, the zero value of every other
+// field keeps its default.
+type Options struct {
+	DB         *base.DB
+	Storage    storage.Storage
+	GPGKeys    openpgp.EntityList
+	Forge      forge.Forge
+	Bugtracker bugtracker.Bugtracker
+	Rolling    bool
+	PublicURI  string
+	// MetadataFormat is the lookaside metadata written to imported
+	// repositories, zero keeps the srpm_import default
+	MetadataFormat srpm_import.MetadataFormat
+	// LookasideRules decides which files are stored in the lookaside,
+	// nil uses the srpm_import defaults
+	LookasideRules *srpm_import.LookasideRules
+	// Signer signs import and retraction commits and tags, nil leaves
+	// them unsigned
+	Signer signing.Signer
+	// TicketTemplate renders batch tickets when the bug tracker config has
+	// no template, nil uses the default template
+	TicketTemplate *TicketTemplate
+	// EntryTickets creates a ticket for every failed entry in the bug
+	// tracker of the worker flags
+	EntryTickets bool
 }
 
 // New creates a new Worker
-// todo(mustafa): This is really ugly, we should probably just use the struct above directly
-func New(db *base.DB, storage storage.Storage, gpgKeys openpgp.EntityList, forge forge.Forge, bugtracker bugtracker.Bugtracker, rolling bool, publicURI string) *Worker {
+func New(opts Options) *Worker {
 	return &Worker{
-		db:         db,
-		storage:    storage,
-		gpgKeys:    gpgKeys,
-		forge:      forge,
-		bugtracker: bugtracker,
-		rolling:    rolling,
-		publicURI:  publicURI,
+		db:             opts.DB,
+		storage:        opts.Storage,
+		gpgKeys:        opts.GPGKeys,
+		forge:          opts.Forge,
+		bugtracker:     opts.Bugtracker,
+		rolling:        opts.Rolling,
+		publicURI:      opts.PublicURI,
+		metadataFormat: opts.MetadataFormat,
+		lookasideRules: opts.LookasideRules,
+		signer:         opts.Signer,
+		ticketTemplate: opts.TicketTemplate,
+		entryTickets:   opts.EntryTickets,
 	}
 }
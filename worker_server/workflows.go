@@ -8,12 +8,206 @@ import (
 
 	mshipadminpb "github.com/openela/mothership/proto/admin/v1"
 	mothershippb "github.com/openela/mothership/proto/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 )
 
 var w Worker
 
+// ResolveConflictSignal is the signal a ProcessRPM workflow in conflict
+// receives with the name of the chosen entry.
+const ResolveConflictSignal = "resolveConflict"
+
+// awaitConflictResolution is a part of the ProcessRPM workflow.
+// An entry conflicts with an earlier entry of the same entry ID and OS
+// release, but a different checksum. The conflict is reported in the
+// bugtracker, and the import waits until an admin chooses one of the entries.
+// If the entry is chosen, the archived entry it conflicts with is retracted
+// first.
+func awaitConflictResolution(ctx workflow.Context, entry *mothershippb.Entry) error {
+	// Reporting the conflict is best effort, the entry is listed as
+	// in conflict either way.
+	ticketCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 40 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 2,
+		},
+	})
+	err := workflow.ExecuteActivity(ticketCtx, w.CreateConflictTicket, entry.Name).Get(ticketCtx, nil)
+	if err != nil {
+		workflow.GetLogger(ctx).Warn("Failed to report conflict", "error", err)
+	}
+
+	var chosen string
+	signalChan := workflow.GetSignalChannel(ctx, ResolveConflictSignal)
+	selector := workflow.NewSelector(ctx)
+	selector.AddReceive(ctx.Done(), func(c workflow.ReceiveChannel, more bool) {
+		err = ctx.Err()
+	})
+	selector.AddReceive(signalChan, func(c workflow.ReceiveChannel, more bool) {
+		c.Receive(ctx, &chosen)
+		err = nil
+	})
+	selector.Select(ctx)
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 25 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 0,
+		},
+	})
+
+	// Check if workflow was cancelled.
+	if err != nil {
+		ctx, cancel := workflow.NewDisconnectedContext(ctx)
+		defer cancel()
+		_ = workflow.ExecuteActivity(ctx, w.SetEntryState, entry.Name, mothershippb.Entry_CANCELLED, nil).Get(ctx, entry)
+		return err
+	}
+
+	// If the entry was chosen, the losing entry is retracted before the
+	// entry is imported.
+	if chosen == entry.Name {
+		var conflictingEntry mothershippb.Entry
+		err = workflow.ExecuteActivity(ctx, w.GetConflictingEntry, entry.Name).Get(ctx, &conflictingEntry)
+		if err != nil {
+			return err
+		}
+
+		if conflictingEntry.State == mothershippb.Entry_ARCHIVED {
+			childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+				WorkflowID:            "operations/retract/" + conflictingEntry.Name,
+				WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY,
+			})
+			err = workflow.ExecuteChildWorkflow(childCtx, RetractEntryWorkflow, conflictingEntry.Name).Get(childCtx, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return workflow.ExecuteActivity(ctx, w.SetEntryConflictResolution, entry.Name, chosen).Get(ctx, entry)
+}
+
+// reportFailure is a part of the ProcessRPM workflow.
+// An entry that is on hold or failed gets a ticket, if the bug tracker
+// creates tickets for failed entries. Reporting is best effort.
+func reportFailure(ctx workflow.Context, entry *mothershippb.Entry) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 40 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 2,
+		},
+	})
+	err := workflow.ExecuteActivity(ctx, w.CreateEntryTicket, entry.Name).Get(ctx, nil)
+	if err != nil {
+		workflow.GetLogger(ctx).Warn("Failed to report failed entry", "error", err)
+	}
+}
+
+// QuorumSignal is the signal a ProcessRPM workflow receives for every
+// submission of its RPM if a quorum is required.
+const QuorumSignal = "quorum"
+
+// awaitQuorum is a part of the ProcessRPM workflow.
+// If a quorum is required, the import waits until workers of enough distinct
+// organizations submitted the same RPM for the same OS release.
+// If the quorum isn't reached before the timeout, the entry is put on hold.
+// The remaining submissions still count while on hold, but an admin can also
+// rescue the entry to import it without the quorum.
+func awaitQuorum(ctx workflow.Context, entry *mothershippb.Entry, args *mothershippb.ProcessRPMArgs) error {
+	internalReq := args.InternalRequest
+	if internalReq.Quorum < 2 {
+		return nil
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 25 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 0,
+		},
+	})
+	err := workflow.ExecuteActivity(ctx, w.SetEntryState, entry.Name, mothershippb.Entry_AWAITING_QUORUM, nil).Get(ctx, entry)
+	if err != nil {
+		return err
+	}
+
+	timerCtx, cancelTimer := workflow.WithCancel(ctx)
+	defer cancelTimer()
+	timer := workflow.NewTimer(timerCtx, internalReq.QuorumTimeout.AsDuration())
+
+	organizations := map[string]bool{internalReq.Organization: true}
+	votesChan := workflow.GetSignalChannel(ctx, QuorumSignal)
+	rescueChan := workflow.GetSignalChannel(ctx, "rescue")
+	timedOut := false
+	rescued := false
+	for len(organizations) < int(internalReq.Quorum) && !rescued {
+		selector := workflow.NewSelector(ctx)
+		selector.AddReceive(ctx.Done(), func(c workflow.ReceiveChannel, more bool) {
+			err = ctx.Err()
+		})
+		selector.AddReceive(votesChan, func(c workflow.ReceiveChannel, more bool) {
+			var vote mothershippb.QuorumVote
+			c.Receive(ctx, &vote)
+			if vote.OsRelease != args.Request.OsRelease {
+				workflow.GetLogger(ctx).Warn("Ignoring quorum vote for another OS release", "worker", vote.WorkerId, "osRelease", vote.OsRelease)
+				return
+			}
+			organizations[vote.Organization] = true
+		})
+		if timedOut {
+			selector.AddReceive(rescueChan, func(c workflow.ReceiveChannel, more bool) {
+				c.Receive(ctx, nil)
+				rescued = true
+			})
+		} else {
+			selector.AddFuture(timer, func(f workflow.Future) {
+				timedOut = true
+			})
+		}
+		selector.Select(ctx)
+
+		// Check if workflow was cancelled.
+		if err != nil {
+			ctx, cancel := workflow.NewDisconnectedContext(ctx)
+			defer cancel()
+			ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+				StartToCloseTimeout: 25 * time.Second,
+				RetryPolicy: &temporal.RetryPolicy{
+					MaximumAttempts: 0,
+				},
+			})
+			_ = workflow.ExecuteActivity(ctx, w.SetEntryState, entry.Name, mothershippb.Entry_CANCELLED, nil).Get(ctx, entry)
+			return err
+		}
+
+		if timedOut && entry.State == mothershippb.Entry_AWAITING_QUORUM {
+			// Rescue signals from before the entry was put on hold are stale.
+			drainRescueSignals(rescueChan)
+
+			workflow.GetLogger(ctx).Info("Quorum not reached, putting workflow on hold")
+			err = workflow.ExecuteActivity(ctx, w.SetEntryState, entry.Name, mothershippb.Entry_ON_HOLD, nil).Get(ctx, entry)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// A rescue signal that raced the quorum must not rescue a later hold
+	// of the import.
+	drainRescueSignals(rescueChan)
+
+	// Set the entry state to archiving
+	return workflow.ExecuteActivity(ctx, w.SetEntryState, entry.Name, mothershippb.Entry_ARCHIVING, nil).Get(ctx, entry)
+}
+
+// drainRescueSignals discards all pending rescue signals.
+func drainRescueSignals(rescueChan workflow.ReceiveChannel) {
+	for rescueChan.ReceiveAsync(nil) {
+	}
+}
+
 // processRPMPostHold is a part of the ProcessRPM workflow.
 // This part executes the import part, and retries if it fails.
 // After the first failure, the workflow is put on hold.
@@ -29,7 +223,7 @@ func processRPMPostHold(ctx workflow.Context, entry *mothershippb.Entry, args *m
 		},
 	})
 	var importRpmRes mothershippb.ImportRPMResponse
-	err := workflow.ExecuteActivity(ctx, w.ImportRPM, args.Request.RpmUri, args.Request.Checksum, args.Request.OsRelease).Get(ctx, &importRpmRes)
+	err := workflow.ExecuteActivity(ctx, w.ImportRPM, args.Request.RpmUri, args.Request.Checksum, args.Request.OsRelease, entry).Get(ctx, &importRpmRes)
 	if err != nil {
 		// If the import fails, we'll put the workflow on hold.
 		// If the workflow is put on hold, an admin can rescue the workflow.
@@ -57,6 +251,9 @@ func processRPMPostHold(ctx workflow.Context, entry *mothershippb.Entry, args *m
 			return nil, err
 		}
 
+		// Reporting the failure is best effort, the entry is on hold either way.
+		reportFailure(ctx, entry)
+
 		// Wait until a rescue signal is received. Otherwise, an admin can also
 		// cancel the workflow.
 		selector.Select(ctx)
@@ -103,7 +300,27 @@ func processRPMPostHold(ctx workflow.Context, entry *mothershippb.Entry, args *m
 		return nil, err
 	}
 
+	// Attest to the import
+	err = workflow.ExecuteActivity(ctx, w.AttestEntry, entry, args, &importRpmRes).Get(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	// If num > 0, this means the import failed at least once.
+	// The ticket of the failure can be closed.
+	if num > 0 {
+		ticketCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+			StartToCloseTimeout: 40 * time.Second,
+			RetryPolicy: &temporal.RetryPolicy{
+				MaximumAttempts: 2,
+			},
+		})
+		err = workflow.ExecuteActivity(ticketCtx, w.CloseEntryTicket, entry.Name).Get(ticketCtx, nil)
+		if err != nil {
+			workflow.GetLogger(ctx).Warn("Failed to close ticket of entry", "error", err)
+		}
+	}
+
 	// Let's check if the entry was part of a batch, if so we'll update the ticket
 	// with the new status.
 	if num > 0 && entry.Batch != nil && entry.Batch.Value != "" {
@@ -193,7 +410,10 @@ func ProcessRPMWorkflow(ctx workflow.Context, args *mothershippb.ProcessRPMArgs)
 			_ = workflow.ExecuteActivity(ctx, w.DeleteEntry, entry.Name).Get(ctx, nil)
 			return
 		}
-		_ = workflow.ExecuteActivity(ctx, w.SetEntryState, entry.Name, mothershippb.Entry_FAILED, nil).Get(ctx, nil)
+		err := workflow.ExecuteActivity(ctx, w.SetEntryState, entry.Name, mothershippb.Entry_FAILED, nil).Get(ctx, nil)
+		if err == nil {
+			reportFailure(ctx, &entry)
+		}
 	}()
 
 	// Set the entry name to the RPM NVR
@@ -205,6 +425,27 @@ func ProcessRPMWorkflow(ctx workflow.Context, args *mothershippb.ProcessRPMArgs)
 		return nil, err
 	}
 
+	// Wait for an admin to choose between the conflicting entries.
+	if entry.State == mothershippb.Entry_CONFLICT {
+		err = awaitConflictResolution(ctx, &entry)
+		if err != nil {
+			return nil, err
+		}
+
+		// The conflicting entry was chosen
+		if entry.State == mothershippb.Entry_CANCELLED {
+			return &mothershippb.ProcessRPMResponse{
+				Entry: &entry,
+			}, nil
+		}
+	}
+
+	// Wait for other organizations to submit the same RPM, if required.
+	err = awaitQuorum(ctx, &entry, args)
+	if err != nil {
+		return nil, err
+	}
+
 	// Process the RPM.
 	return processRPMPostHold(ctx, &entry, args, 0)
 }
@@ -304,3 +545,110 @@ func SealBatchWorkflow(ctx workflow.Context, req *mothershippb.SealBatchRequest)
 		Batch: &batch,
 	}, nil
 }
+
+// GarbageCollectWorkflow removes objects that are no longer referenced by
+// any entry or lookaside metadata file.
+// Unreachable objects are first moved to the trash, and only deleted once
+// they've been in the trash for the grace period.
+// Usually started by a Temporal schedule, see EnsureGarbageCollectSchedule.
+func GarbageCollectWorkflow(ctx workflow.Context, args *mothershippb.GarbageCollectArgs) (*mothershippb.GarbageCollectResponse, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Hour,
+		// Cloning a large repository can take a while
+		HeartbeatTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 2,
+		},
+	})
+	var res mothershippb.GarbageCollectResponse
+	err := workflow.ExecuteActivity(ctx, w.GarbageCollect, args).Get(ctx, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// RepairReplicasWorkflow copies objects to the storage replicas that are
+// missing them.
+// Usually started by a Temporal schedule, see EnsureRepairReplicasSchedule.
+func RepairReplicasWorkflow(ctx workflow.Context) (*mothershippb.RepairReplicasResponse, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Hour,
+		HeartbeatTimeout:    5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 2,
+		},
+	})
+	var res mothershippb.RepairReplicasResponse
+	err := workflow.ExecuteActivity(ctx, w.RepairReplicas).Get(ctx, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// RewrapKeysWorkflow re-wraps the data keys of encrypted objects with the
+// primary storage encryption key.
+// Started by a Temporal schedule, see EnsureRewrapKeysSchedule, or by an
+// operator after rotating keys.
+func RewrapKeysWorkflow(ctx workflow.Context) (*mothershippb.RewrapKeysResponse, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Hour,
+		HeartbeatTimeout:    5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 2,
+		},
+	})
+	var res mothershippb.RewrapKeysResponse
+	err := workflow.ExecuteActivity(ctx, w.RewrapKeys).Get(ctx, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// RepairMirrorsWorkflow pushes repositories to the mirror forges that fell
+// behind.
+// Usually started by a Temporal schedule, see EnsureRepairMirrorsSchedule.
+func RepairMirrorsWorkflow(ctx workflow.Context) (*mothershippb.RepairMirrorsResponse, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Hour,
+		// Cloning a large repository can take a while
+		HeartbeatTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 2,
+		},
+	})
+	var res mothershippb.RepairMirrorsResponse
+	err := workflow.ExecuteActivity(ctx, w.RepairMirrors).Get(ctx, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// BackfillLookasideBlobsWorkflow records the lookaside blobs of entries
+// archived before lookaside blobs were recorded, so the lookaside endpoint
+// serves them.
+// Started once by the worker server, see EnsureLookasideBlobsBackfill.
+func BackfillLookasideBlobsWorkflow(ctx workflow.Context) (*mothershippb.BackfillLookasideBlobsResponse, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Hour,
+		// Cloning a large repository can take a while
+		HeartbeatTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 2,
+		},
+	})
+	var res mothershippb.BackfillLookasideBlobsResponse
+	err := workflow.ExecuteActivity(ctx, w.BackfillLookasideBlobs).Get(ctx, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
@@ -12,6 +12,8 @@ import (
 	mothershippb "github.com/openela/mothership/proto/v1"
 	"github.com/stretchr/testify/mock"
 	"go.temporal.io/sdk/temporal"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"time"
 )
 
@@ -45,9 +47,10 @@ func (s *UnitTestSuite) TestProcessRPMWorkflow_FullSuccess1() {
 		Nevra:        "efi-rpm-macros-0:3-3.el8.aarch64",
 		Pkg:          "efi-rpm-macros",
 	}
-	s.env.OnActivity(testW.ImportRPM, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum, entry.OsRelease).Return(importRpmRes, nil)
+	s.env.OnActivity(testW.ImportRPM, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum, entry.OsRelease, mock.Anything).Return(importRpmRes, nil)
 
 	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_ARCHIVED, importRpmRes).Return(entry, nil)
+	s.env.OnActivity(testW.AttestEntry, mock.Anything, mock.Anything, importRpmRes).Return(nil)
 
 	args := &mothershippb.ProcessRPMArgs{
 		Request: &mothershippb.ProcessRPMRequest{
@@ -93,9 +96,11 @@ func (s *UnitTestSuite) TestProcessRPMWorkflow_OnHold_Cancel() {
 	s.env.OnActivity(testW.SetEntryIDFromRPM, entry.Name, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum).Return(entry, nil)
 
 	importErr := errors.New("import error")
-	s.env.OnActivity(testW.ImportRPM, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum, entry.OsRelease).Return(nil, importErr)
+	s.env.OnActivity(testW.ImportRPM, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum, entry.OsRelease, mock.Anything).Return(nil, importErr)
 
 	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_ON_HOLD, mock.Anything).Return(entry, nil)
+	// Reporting the failure is best effort
+	s.env.OnActivity(testW.CreateEntryTicket, entry.Name).Return(errors.New("bugtracker error"))
 	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_CANCELLED, mock.Anything).Return(entry, nil)
 
 	s.env.RegisterDelayedCallback(func() {
@@ -151,8 +156,8 @@ func (s *UnitTestSuite) TestProcessRPMWorkflow_OnHold_Success() {
 		Pkg:          "efi-rpm-macros",
 	}
 	shouldErrImport := true
-	s.env.OnActivity(testW.ImportRPM, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum, entry.OsRelease).
-		Return(func(uri string, checksum string, osRelease string) (*mothershippb.ImportRPMResponse, error) {
+	s.env.OnActivity(testW.ImportRPM, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum, entry.OsRelease, mock.Anything).
+		Return(func(uri string, checksum string, osRelease string, entry *mothershippb.Entry) (*mothershippb.ImportRPMResponse, error) {
 			if shouldErrImport {
 				return nil, importErr
 			}
@@ -161,12 +166,16 @@ func (s *UnitTestSuite) TestProcessRPMWorkflow_OnHold_Success() {
 
 	entry.State = mothershippb.Entry_ON_HOLD
 	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_ON_HOLD, mock.Anything).Return(&*entry, nil)
+	s.env.OnActivity(testW.CreateEntryTicket, entry.Name).Return(nil).Once()
 
 	entry.State = mothershippb.Entry_ARCHIVED
 	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_ARCHIVING, mock.Anything).Return(&*entry, nil)
 
 	entry.State = mothershippb.Entry_ARCHIVED
 	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_ARCHIVED, importRpmRes).Return(&*entry, nil)
+	s.env.OnActivity(testW.AttestEntry, mock.Anything, mock.Anything, importRpmRes).Return(nil)
+	// The ticket of the failure is closed after the rescue
+	s.env.OnActivity(testW.CloseEntryTicket, entry.Name).Return(nil).Once()
 
 	s.env.RegisterDelayedCallback(func() {
 		shouldErrImport = false
@@ -218,9 +227,10 @@ func (s *UnitTestSuite) TestProcessRPMWorkflow_OnHold_Error() {
 	s.env.OnActivity(testW.SetEntryIDFromRPM, entry.Name, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum).Return(entry, nil)
 
 	importErr := errors.New("import error")
-	s.env.OnActivity(testW.ImportRPM, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum, entry.OsRelease).Return(nil, importErr)
+	s.env.OnActivity(testW.ImportRPM, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum, entry.OsRelease, mock.Anything).Return(nil, importErr)
 
 	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_ON_HOLD, mock.Anything).Return(entry, nil)
+	s.env.OnActivity(testW.CreateEntryTicket, entry.Name).Return(nil)
 
 	args := &mothershippb.ProcessRPMArgs{
 		Request: &mothershippb.ProcessRPMRequest{
@@ -239,6 +249,58 @@ func (s *UnitTestSuite) TestProcessRPMWorkflow_OnHold_Error() {
 	s.Error(s.env.GetWorkflowError())
 }
 
+func (s *UnitTestSuite) TestProcessRPMWorkflow_Failed_CreateEntryTicket() {
+	s.env.OnActivity(testW.VerifyResourceExists, "memory://efi-rpm-macros-3-3.el8.src.rpm").Return(nil)
+	s.env.OnActivity(testW.SetWorkerLastCheckinTime, mock.Anything).Return(nil)
+
+	entry := (&mothership_db.Entry{
+		Name:           base.NameGen("entries"),
+		CreateTime:     time.Now(),
+		OSRelease:      "Rocky Linux release 8.8 (Green Obsidian)",
+		Sha256Sum:      "518a9418fec1deaeb4c636615d8d81fb60146883c431ea15ab1127893d075d28",
+		RepositoryName: "BaseOS",
+		WorkerID: sql.NullString{
+			String: "test-worker",
+			Valid:  true,
+		},
+		State: mothershippb.Entry_ARCHIVING,
+	}).ToPB()
+	s.env.OnActivity(testW.CreateEntry, mock.Anything).Return(entry, nil)
+
+	entry.EntryId = "efi-rpm-macros-3-3.el8.src"
+	s.env.OnActivity(testW.SetEntryIDFromRPM, entry.Name, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum).Return(entry, nil)
+
+	importErr := errors.New("import error")
+	s.env.OnActivity(testW.ImportRPM, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum, entry.OsRelease, mock.Anything).Return(nil, importErr)
+
+	stateErr := temporal.NewNonRetryableApplicationError(
+		"entry does not exist",
+		"entryDoesNotExist",
+		errors.New("entry does not exist"),
+	)
+	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_ON_HOLD, mock.Anything).Return(nil, stateErr)
+
+	// The entry failed, so it's reported
+	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_FAILED, mock.Anything).Return(entry, nil)
+	s.env.OnActivity(testW.CreateEntryTicket, entry.Name).Return(nil).Once()
+
+	args := &mothershippb.ProcessRPMArgs{
+		Request: &mothershippb.ProcessRPMRequest{
+			RpmUri:     "memory://efi-rpm-macros-3-3.el8.src.rpm",
+			OsRelease:  "Rocky Linux release 8.8 (Green Obsidian)",
+			Checksum:   entry.Sha256Sum,
+			Repository: "BaseOS",
+		},
+		InternalRequest: &mothershippb.ProcessRPMInternalRequest{
+			WorkerId: "test-worker",
+		},
+	}
+	s.env.ExecuteWorkflow(ProcessRPMWorkflow, args)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.ErrorContains(s.env.GetWorkflowError(), "entry does not exist")
+}
+
 func (s *UnitTestSuite) TestProcessRPMWorkflow_Error_DeleteEntry() {
 	s.env.OnActivity(testW.VerifyResourceExists, "memory://efi-rpm-macros-3-3.el8.src.rpm").Return(nil)
 	s.env.OnActivity(testW.SetWorkerLastCheckinTime, mock.Anything).Return(nil)
@@ -283,6 +345,341 @@ func (s *UnitTestSuite) TestProcessRPMWorkflow_Error_DeleteEntry() {
 	s.Error(s.env.GetWorkflowError())
 }
 
+func (s *UnitTestSuite) TestProcessRPMWorkflow_Quorum() {
+	s.env.OnActivity(testW.VerifyResourceExists, "memory://efi-rpm-macros-3-3.el8.src.rpm").Return(nil)
+	s.env.OnActivity(testW.SetWorkerLastCheckinTime, mock.Anything).Return(nil)
+
+	entry := (&mothership_db.Entry{
+		Name:           base.NameGen("entries"),
+		CreateTime:     time.Now(),
+		OSRelease:      "Rocky Linux release 8.8 (Green Obsidian)",
+		Sha256Sum:      "518a9418fec1deaeb4c636615d8d81fb60146883c431ea15ab1127893d075d28",
+		RepositoryName: "BaseOS",
+		WorkerID: sql.NullString{
+			String: "test-worker",
+			Valid:  true,
+		},
+		State: mothershippb.Entry_ARCHIVING,
+	}).ToPB()
+	s.env.OnActivity(testW.CreateEntry, mock.Anything).Return(entry, nil)
+
+	entry.EntryId = "efi-rpm-macros-3-3.el8.src"
+	s.env.OnActivity(testW.SetEntryIDFromRPM, entry.Name, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum).Return(entry, nil)
+
+	importRpmRes := &mothershippb.ImportRPMResponse{
+		CommitHash: "4e1243bd22c66e76c2ba9eddc1f91394e57f9f83",
+		Pkg:        "efi-rpm-macros",
+	}
+	imported := false
+	s.env.OnActivity(testW.ImportRPM, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum, entry.OsRelease, mock.Anything).
+		Return(func(uri string, checksum string, osRelease string, entry *mothershippb.Entry) (*mothershippb.ImportRPMResponse, error) {
+			imported = true
+			return importRpmRes, nil
+		})
+
+	awaiting := proto.Clone(entry).(*mothershippb.Entry)
+	awaiting.State = mothershippb.Entry_AWAITING_QUORUM
+	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_AWAITING_QUORUM, mock.Anything).Return(awaiting, nil)
+	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_ARCHIVING, mock.Anything).Return(entry, nil)
+	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_ARCHIVED, importRpmRes).Return(entry, nil)
+	s.env.OnActivity(testW.AttestEntry, mock.Anything, mock.Anything, importRpmRes).Return(nil)
+
+	// The same organization, and another OS release don't count
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(QuorumSignal, &mothershippb.QuorumVote{WorkerId: "test-worker-2", Organization: "org-a", OsRelease: entry.OsRelease})
+		s.env.SignalWorkflow(QuorumSignal, &mothershippb.QuorumVote{WorkerId: "test-worker-3", Organization: "org-b", OsRelease: "Rocky Linux release 9.2 (Blue Onyx)"})
+	}, time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.False(imported)
+		s.env.SignalWorkflow(QuorumSignal, &mothershippb.QuorumVote{WorkerId: "test-worker-3", Organization: "org-b", OsRelease: entry.OsRelease})
+	}, 2*time.Minute)
+
+	args := &mothershippb.ProcessRPMArgs{
+		Request: &mothershippb.ProcessRPMRequest{
+			RpmUri:     "memory://efi-rpm-macros-3-3.el8.src.rpm",
+			OsRelease:  "Rocky Linux release 8.8 (Green Obsidian)",
+			Checksum:   entry.Sha256Sum,
+			Repository: "BaseOS",
+		},
+		InternalRequest: &mothershippb.ProcessRPMInternalRequest{
+			WorkerId:      "test-worker",
+			Organization:  "org-a",
+			Quorum:        2,
+			QuorumTimeout: durationpb.New(time.Hour),
+		},
+	}
+	s.env.ExecuteWorkflow(ProcessRPMWorkflow, args)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+	s.True(imported)
+}
+
+func (s *UnitTestSuite) TestProcessRPMWorkflow_Quorum_Timeout_Rescue() {
+	s.env.OnActivity(testW.VerifyResourceExists, "memory://efi-rpm-macros-3-3.el8.src.rpm").Return(nil)
+	s.env.OnActivity(testW.SetWorkerLastCheckinTime, mock.Anything).Return(nil)
+
+	entry := (&mothership_db.Entry{
+		Name:           base.NameGen("entries"),
+		CreateTime:     time.Now(),
+		OSRelease:      "Rocky Linux release 8.8 (Green Obsidian)",
+		Sha256Sum:      "518a9418fec1deaeb4c636615d8d81fb60146883c431ea15ab1127893d075d28",
+		RepositoryName: "BaseOS",
+		WorkerID: sql.NullString{
+			String: "test-worker",
+			Valid:  true,
+		},
+		State: mothershippb.Entry_ARCHIVING,
+	}).ToPB()
+	s.env.OnActivity(testW.CreateEntry, mock.Anything).Return(entry, nil)
+
+	entry.EntryId = "efi-rpm-macros-3-3.el8.src"
+	s.env.OnActivity(testW.SetEntryIDFromRPM, entry.Name, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum).Return(entry, nil)
+
+	importRpmRes := &mothershippb.ImportRPMResponse{
+		CommitHash: "4e1243bd22c66e76c2ba9eddc1f91394e57f9f83",
+		Pkg:        "efi-rpm-macros",
+	}
+	s.env.OnActivity(testW.ImportRPM, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum, entry.OsRelease, mock.Anything).Return(importRpmRes, nil)
+
+	awaiting := proto.Clone(entry).(*mothershippb.Entry)
+	awaiting.State = mothershippb.Entry_AWAITING_QUORUM
+	onHold := proto.Clone(entry).(*mothershippb.Entry)
+	onHold.State = mothershippb.Entry_ON_HOLD
+	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_AWAITING_QUORUM, mock.Anything).Return(awaiting, nil)
+	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_ON_HOLD, mock.Anything).Return(onHold, nil).Once()
+	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_ARCHIVING, mock.Anything).Return(entry, nil)
+	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_ARCHIVED, importRpmRes).Return(entry, nil)
+	s.env.OnActivity(testW.AttestEntry, mock.Anything, mock.Anything, importRpmRes).Return(nil)
+
+	// The entry is put on hold after the timeout, then rescued
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow("rescue", true)
+	}, 2*time.Hour)
+
+	args := &mothershippb.ProcessRPMArgs{
+		Request: &mothershippb.ProcessRPMRequest{
+			RpmUri:     "memory://efi-rpm-macros-3-3.el8.src.rpm",
+			OsRelease:  "Rocky Linux release 8.8 (Green Obsidian)",
+			Checksum:   entry.Sha256Sum,
+			Repository: "BaseOS",
+		},
+		InternalRequest: &mothershippb.ProcessRPMInternalRequest{
+			WorkerId:      "test-worker",
+			Organization:  "org-a",
+			Quorum:        2,
+			QuorumTimeout: durationpb.New(time.Hour),
+		},
+	}
+	s.env.ExecuteWorkflow(ProcessRPMWorkflow, args)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *UnitTestSuite) TestProcessRPMWorkflow_Quorum_StaleRescue() {
+	s.env.OnActivity(testW.VerifyResourceExists, "memory://efi-rpm-macros-3-3.el8.src.rpm").Return(nil)
+	s.env.OnActivity(testW.SetWorkerLastCheckinTime, mock.Anything).Return(nil)
+
+	entry := (&mothership_db.Entry{
+		Name:           base.NameGen("entries"),
+		CreateTime:     time.Now(),
+		OSRelease:      "Rocky Linux release 8.8 (Green Obsidian)",
+		Sha256Sum:      "518a9418fec1deaeb4c636615d8d81fb60146883c431ea15ab1127893d075d28",
+		RepositoryName: "BaseOS",
+		WorkerID: sql.NullString{
+			String: "test-worker",
+			Valid:  true,
+		},
+		State: mothershippb.Entry_ARCHIVING,
+	}).ToPB()
+	s.env.OnActivity(testW.CreateEntry, mock.Anything).Return(entry, nil)
+
+	entry.EntryId = "efi-rpm-macros-3-3.el8.src"
+	s.env.OnActivity(testW.SetEntryIDFromRPM, entry.Name, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum).Return(entry, nil)
+
+	imports := 0
+	s.env.OnActivity(testW.ImportRPM, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum, entry.OsRelease, mock.Anything).
+		Return(func(uri string, checksum string, osRelease string, entry *mothershippb.Entry) (*mothershippb.ImportRPMResponse, error) {
+			imports++
+			return nil, errors.New("import error")
+		})
+
+	awaiting := proto.Clone(entry).(*mothershippb.Entry)
+	awaiting.State = mothershippb.Entry_AWAITING_QUORUM
+	onHold := proto.Clone(entry).(*mothershippb.Entry)
+	onHold.State = mothershippb.Entry_ON_HOLD
+	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_AWAITING_QUORUM, mock.Anything).Return(awaiting, nil)
+	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_ARCHIVING, mock.Anything).Return(entry, nil)
+	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_ON_HOLD, mock.Anything).Return(onHold, nil)
+	s.env.OnActivity(testW.CreateEntryTicket, entry.Name).Return(nil)
+	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_CANCELLED, mock.Anything).Return(entry, nil)
+
+	// A rescue signal before the quorum is reached must not rescue the
+	// hold after the failed import
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow("rescue", true)
+	}, time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(QuorumSignal, &mothershippb.QuorumVote{WorkerId: "test-worker-2", Organization: "org-b", OsRelease: entry.OsRelease})
+	}, 2*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.CancelWorkflow()
+	}, 3*time.Hour)
+
+	args := &mothershippb.ProcessRPMArgs{
+		Request: &mothershippb.ProcessRPMRequest{
+			RpmUri:     "memory://efi-rpm-macros-3-3.el8.src.rpm",
+			OsRelease:  "Rocky Linux release 8.8 (Green Obsidian)",
+			Checksum:   entry.Sha256Sum,
+			Repository: "BaseOS",
+		},
+		InternalRequest: &mothershippb.ProcessRPMInternalRequest{
+			WorkerId:      "test-worker",
+			Organization:  "org-a",
+			Quorum:        2,
+			QuorumTimeout: durationpb.New(time.Hour),
+		},
+	}
+	s.env.ExecuteWorkflow(ProcessRPMWorkflow, args)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.ErrorContains(s.env.GetWorkflowError(), "canceled")
+	s.Equal(1, imports)
+}
+
+func (s *UnitTestSuite) TestProcessRPMWorkflow_Conflict_ChooseEntry() {
+	s.env.OnActivity(testW.VerifyResourceExists, "memory://efi-rpm-macros-3-3.el8.src.rpm").Return(nil)
+	s.env.OnActivity(testW.SetWorkerLastCheckinTime, mock.Anything).Return(nil)
+
+	entry := (&mothership_db.Entry{
+		Name:           base.NameGen("entries"),
+		CreateTime:     time.Now(),
+		OSRelease:      "Rocky Linux release 8.8 (Green Obsidian)",
+		Sha256Sum:      "518a9418fec1deaeb4c636615d8d81fb60146883c431ea15ab1127893d075d28",
+		RepositoryName: "BaseOS",
+		WorkerID: sql.NullString{
+			String: "test-worker",
+			Valid:  true,
+		},
+		State: mothershippb.Entry_ARCHIVING,
+	}).ToPB()
+	s.env.OnActivity(testW.CreateEntry, mock.Anything).Return(entry, nil)
+
+	conflict := proto.Clone(entry).(*mothershippb.Entry)
+	conflict.EntryId = "efi-rpm-macros-3-3.el8.src"
+	conflict.State = mothershippb.Entry_CONFLICT
+	s.env.OnActivity(testW.SetEntryIDFromRPM, entry.Name, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum).Return(conflict, nil)
+	s.env.OnActivity(testW.CreateConflictTicket, entry.Name).Return(nil)
+
+	// The conflicting entry is retracted before the entry is imported
+	s.env.RegisterWorkflow(RetractEntryWorkflow)
+	conflictingEntry := proto.Clone(conflict).(*mothershippb.Entry)
+	conflictingEntry.Name = "entries/conflicting"
+	conflictingEntry.Sha256Sum = "c1f2c0e9ab2f3c1cce0b6a2e0f4e1a3c0c7e0d8c1a7e0b9c2d3e4f5a6b7c8d9e"
+	conflictingEntry.State = mothershippb.Entry_ARCHIVED
+	s.env.OnActivity(testW.GetConflictingEntry, entry.Name).Return(conflictingEntry, nil)
+	setConflictingEntryState := func(name string, state mothershippb.Entry_State, importRpmRes *mothershippb.ImportRPMResponse) (*mothershippb.Entry, error) {
+		conflictingEntry.State = state
+		return conflictingEntry, nil
+	}
+	s.env.OnActivity(testW.SetEntryState, conflictingEntry.Name, mothershippb.Entry_RETRACTING, mock.Anything).Return(setConflictingEntryState)
+	s.env.OnActivity(testW.RetractEntry, conflictingEntry.Name).Return(&mshipadminpb.RetractEntryResponse{Name: conflictingEntry.Name}, nil)
+	s.env.OnActivity(testW.SetEntryState, conflictingEntry.Name, mothershippb.Entry_RETRACTED, mock.Anything).Return(setConflictingEntryState)
+
+	entry.EntryId = conflict.EntryId
+	s.env.OnActivity(testW.SetEntryConflictResolution, entry.Name, entry.Name).
+		Return(func(name string, chosen string) (*mothershippb.Entry, error) {
+			s.Equal(mothershippb.Entry_RETRACTED, conflictingEntry.State)
+			return entry, nil
+		})
+
+	importRpmRes := &mothershippb.ImportRPMResponse{
+		CommitHash: "4e1243bd22c66e76c2ba9eddc1f91394e57f9f83",
+		Pkg:        "efi-rpm-macros",
+	}
+	s.env.OnActivity(testW.ImportRPM, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum, entry.OsRelease, mock.Anything).
+		Return(func(uri string, checksum string, osRelease string, entry *mothershippb.Entry) (*mothershippb.ImportRPMResponse, error) {
+			s.Equal(mothershippb.Entry_RETRACTED, conflictingEntry.State)
+			return importRpmRes, nil
+		})
+	s.env.OnActivity(testW.SetEntryState, entry.Name, mothershippb.Entry_ARCHIVED, importRpmRes).Return(entry, nil)
+	s.env.OnActivity(testW.AttestEntry, mock.Anything, mock.Anything, importRpmRes).Return(nil)
+
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(ResolveConflictSignal, entry.Name)
+	}, time.Hour)
+
+	args := &mothershippb.ProcessRPMArgs{
+		Request: &mothershippb.ProcessRPMRequest{
+			RpmUri:     "memory://efi-rpm-macros-3-3.el8.src.rpm",
+			OsRelease:  "Rocky Linux release 8.8 (Green Obsidian)",
+			Checksum:   entry.Sha256Sum,
+			Repository: "BaseOS",
+		},
+		InternalRequest: &mothershippb.ProcessRPMInternalRequest{
+			WorkerId: "test-worker",
+		},
+	}
+	s.env.ExecuteWorkflow(ProcessRPMWorkflow, args)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+	s.Equal(mothershippb.Entry_RETRACTED, conflictingEntry.State)
+}
+
+func (s *UnitTestSuite) TestProcessRPMWorkflow_Conflict_ChooseConflictingEntry() {
+	s.env.OnActivity(testW.VerifyResourceExists, "memory://efi-rpm-macros-3-3.el8.src.rpm").Return(nil)
+	s.env.OnActivity(testW.SetWorkerLastCheckinTime, mock.Anything).Return(nil)
+
+	entry := (&mothership_db.Entry{
+		Name:           base.NameGen("entries"),
+		CreateTime:     time.Now(),
+		OSRelease:      "Rocky Linux release 8.8 (Green Obsidian)",
+		Sha256Sum:      "518a9418fec1deaeb4c636615d8d81fb60146883c431ea15ab1127893d075d28",
+		RepositoryName: "BaseOS",
+		WorkerID: sql.NullString{
+			String: "test-worker",
+			Valid:  true,
+		},
+		State: mothershippb.Entry_ARCHIVING,
+	}).ToPB()
+	s.env.OnActivity(testW.CreateEntry, mock.Anything).Return(entry, nil)
+
+	entry.EntryId = "efi-rpm-macros-3-3.el8.src"
+	entry.State = mothershippb.Entry_CONFLICT
+	s.env.OnActivity(testW.SetEntryIDFromRPM, entry.Name, "memory://efi-rpm-macros-3-3.el8.src.rpm", entry.Sha256Sum).Return(entry, nil)
+	s.env.OnActivity(testW.CreateConflictTicket, entry.Name).Return(errors.New("bugtracker error"))
+
+	cancelled := proto.Clone(entry).(*mothershippb.Entry)
+	cancelled.State = mothershippb.Entry_CANCELLED
+	s.env.OnActivity(testW.SetEntryConflictResolution, entry.Name, "entries/conflicting").Return(cancelled, nil)
+
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(ResolveConflictSignal, "entries/conflicting")
+	}, time.Hour)
+
+	args := &mothershippb.ProcessRPMArgs{
+		Request: &mothershippb.ProcessRPMRequest{
+			RpmUri:     "memory://efi-rpm-macros-3-3.el8.src.rpm",
+			OsRelease:  "Rocky Linux release 8.8 (Green Obsidian)",
+			Checksum:   entry.Sha256Sum,
+			Repository: "BaseOS",
+		},
+		InternalRequest: &mothershippb.ProcessRPMInternalRequest{
+			WorkerId: "test-worker",
+		},
+	}
+	s.env.ExecuteWorkflow(ProcessRPMWorkflow, args)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+
+	var res mothershippb.ProcessRPMResponse
+	s.NoError(s.env.GetWorkflowResult(&res))
+	s.Equal(mothershippb.Entry_CANCELLED, res.Entry.State)
+}
+
 func (s *UnitTestSuite) TestRetractEntryWorkflow_Success() {
 	entry := base.NameGen("entries")
 	s.env.OnActivity(testW.SetEntryState, entry, mothershippb.Entry_RETRACTING, mock.Anything).Return(nil, nil)